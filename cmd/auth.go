@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 
@@ -31,7 +32,18 @@ Examples:
 var loginCmd = &cobra.Command{
 	Use:   "login",
 	Short: "Login to Linear",
-	Long:  `Authenticate with Linear using Personal API Key.`,
+	Long: `Authenticate with Linear using Personal API Key.
+
+With --oauth, authorize through Linear's OAuth flow instead, for workspaces
+that don't allow personal API keys. Register an OAuth application in Linear
+with the redirect URI http://localhost:8484/callback (or the --port you pass)
+and give its client ID with --client-id. The access token is refreshed
+automatically when it expires.
+
+Examples:
+  linctl auth login
+  linctl auth login --oauth --client-id abc123
+  linctl auth login --oauth --client-id abc123 --scopes read`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -41,21 +53,57 @@ var loginCmd = &cobra.Command{
 			fmt.Println()
 		}
 
-		err := auth.Login(plaintext, jsonOut)
+		var config *auth.AuthConfig
+		var err error
+		if useOAuth, _ := cmd.Flags().GetBool("oauth"); useOAuth {
+			clientID, _ := cmd.Flags().GetString("client-id")
+			clientSecret, _ := cmd.Flags().GetString("client-secret")
+			scopes, _ := cmd.Flags().GetString("scopes")
+			port, _ := cmd.Flags().GetInt("port")
+			config, err = auth.LoginWithOAuth(context.Background(), auth.OAuthOptions{
+				ClientID:     clientID,
+				ClientSecret: clientSecret,
+				Scopes:       scopes,
+				Port:         port,
+				OpenBrowser:  openBrowser,
+			})
+		} else {
+			config, err = auth.Login(plaintext, jsonOut)
+		}
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
+		tokenType := "Personal API key"
+		if config.TokenType == "oauth" {
+			tokenType = "OAuth token"
+		}
+		readOnly := config.WriteAccess != nil && !*config.WriteAccess
 		if !plaintext && !jsonOut {
 			fmt.Println(color.New(color.FgGreen).Sprint("✅ Successfully authenticated with Linear!"))
+			fmt.Printf("Token: %s\n", tokenType)
 		} else if jsonOut {
 			output.JSON(map[string]interface{}{
-				"status":  "success",
-				"message": "Successfully authenticated with Linear",
+				"status":       "success",
+				"message":      "Successfully authenticated with Linear",
+				"token_type":   config.TokenType,
+				"write_access": config.WriteAccess,
+				"note":         config.Note,
 			})
 		} else {
 			fmt.Println("Successfully authenticated with Linear")
+			fmt.Printf("Token: %s\n", tokenType)
+		}
+		if !jsonOut {
+			switch {
+			case readOnly:
+				fmt.Fprintf(os.Stderr, "Warning: this key appears to be read-only; creating or updating issues will fail. Create a key with write access at https://linear.app/settings/api\n")
+			case config.WriteAccess == nil:
+				output.Hint("Could not check whether this key has write access.")
+			default:
+				fmt.Println("Access: read and write")
+			}
 		}
 	},
 }
@@ -109,7 +157,7 @@ var logoutCmd = &cobra.Command{
 		err := auth.Logout()
 		if err != nil {
 			output.Error(fmt.Sprintf("Logout failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -140,6 +188,12 @@ func init() {
 	authCmd.AddCommand(statusCmd)
 	authCmd.AddCommand(logoutCmd)
 
+	loginCmd.Flags().Bool("oauth", false, "Authorize with Linear's OAuth flow instead of a personal API key")
+	loginCmd.Flags().String("client-id", "", "OAuth application client ID (with --oauth)")
+	loginCmd.Flags().String("client-secret", "", "OAuth application client secret, if the application requires one (with --oauth)")
+	loginCmd.Flags().String("scopes", auth.DefaultOAuthScopes, "Comma-separated OAuth scopes to request (with --oauth)")
+	loginCmd.Flags().Int("port", auth.DefaultOAuthPort, "Local port for the OAuth callback; must match the application's redirect URI (with --oauth)")
+
 	// Add whoami as a top-level command too
 	rootCmd.AddCommand(whoamiCmd)
 }
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/spf13/cobra"
+)
+
+// bodyStdin is where `--body -` reads from; tests replace it.
+var bodyStdin io.Reader = os.Stdin
+
+// runEditor opens path in $VISUAL or $EDITOR (vi when neither is set) on the
+// current terminal. It is an injection point for tests.
+var runEditor = func(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+	}
+	// $EDITOR may carry arguments, e.g. "code --wait".
+	parts := strings.Fields(editor)
+	c := exec.Command(parts[0], append(parts[1:], path)...)
+	c.Stdin, c.Stdout, c.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("editor %q failed: %w", editor, err)
+	}
+	return nil
+}
+
+// addBodyInputFlags registers --<name>, --<name>-file and --edit on cmd.
+func addBodyInputFlags(cmd *cobra.Command, name, usage string) {
+	cmd.Flags().String(name, "", usage+" (use - to read stdin)")
+	cmd.Flags().String(name+"-file", "", "Read the "+name+" from this file")
+	cmd.Flags().Bool("edit", false, "Write the "+name+" in $EDITOR")
+}
+
+// readBodyInput returns long-form text from whichever of --<name> ("-" reads
+// stdin), --<name>-file or --edit was given. given is false when none was;
+// giving more than one is an error.
+func readBodyInput(cmd *cobra.Command, name string) (text string, given bool, err error) {
+	inline, _ := cmd.Flags().GetString(name)
+	file, _ := cmd.Flags().GetString(name + "-file")
+	edit, _ := cmd.Flags().GetBool("edit")
+
+	var sources []string
+	if cmd.Flags().Changed(name) {
+		sources = append(sources, "--"+name)
+	}
+	if file != "" {
+		sources = append(sources, "--"+name+"-file")
+	}
+	if edit {
+		sources = append(sources, "--edit")
+	}
+	switch {
+	case len(sources) == 0:
+		return "", false, nil
+	case len(sources) > 1:
+		return "", true, fmt.Errorf("use only one of %s", strings.Join(sources, ", "))
+	}
+
+	switch {
+	case file != "":
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return "", true, fmt.Errorf("failed to read --%s-file: %w", name, err)
+		}
+		text = string(data)
+	case edit:
+		text, err = editBody(name)
+		if err != nil {
+			return "", true, err
+		}
+	case inline == "-":
+		data, err := io.ReadAll(bodyStdin)
+		if err != nil {
+			return "", true, fmt.Errorf("failed to read %s from stdin: %w", name, err)
+		}
+		text = string(data)
+	default:
+		text = inline
+	}
+	return strings.TrimRight(text, "\n"), true, nil
+}
+
+// editBody opens an empty Markdown file in the editor and returns what was
+// saved. An empty file aborts, as with git commit.
+func editBody(name string) (string, error) {
+	f, err := os.CreateTemp("", "linctl-"+name+"-*.md")
+	if err != nil {
+		return "", fmt.Errorf("failed to create temp file: %w", err)
+	}
+	path := f.Name()
+	_ = f.Close()
+	defer os.Remove(path)
+
+	if err := runEditor(path); err != nil {
+		return "", err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read edited %s: %w", name, err)
+	}
+	if strings.TrimSpace(string(data)) == "" {
+		return "", fmt.Errorf("aborted: the %s is empty", name)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,78 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func newBodyInputCmd(t *testing.T, args ...string) *cobra.Command {
+	t.Helper()
+	cmd := &cobra.Command{Use: "test"}
+	addBodyInputFlags(cmd, "body", "Body")
+	if err := cmd.Flags().Parse(args); err != nil {
+		t.Fatal(err)
+	}
+	return cmd
+}
+
+func TestReadBodyInput_Sources(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update.md")
+	if err := os.WriteFile(path, []byte("# March\n\nShipped SSO.\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	origStdin, origEditor := bodyStdin, runEditor
+	t.Cleanup(func() { bodyStdin, runEditor = origStdin, origEditor })
+	bodyStdin = strings.NewReader("from stdin\n")
+	runEditor = func(p string) error { return os.WriteFile(p, []byte("from editor\n"), 0o600) }
+
+	cases := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"--body", "inline"}, "inline"},
+		{[]string{"--body-file", path}, "# March\n\nShipped SSO."},
+		{[]string{"--body", "-"}, "from stdin"},
+		{[]string{"--edit"}, "from editor"},
+	}
+	for _, tc := range cases {
+		got, given, err := readBodyInput(newBodyInputCmd(t, tc.args...), "body")
+		if err != nil || !given || got != tc.want {
+			t.Fatalf("%v: got %q, %v, %v; want %q", tc.args, got, given, err, tc.want)
+		}
+	}
+
+	if _, given, err := readBodyInput(newBodyInputCmd(t), "body"); given || err != nil {
+		t.Fatalf("no source should report given=false, got %v, %v", given, err)
+	}
+	_, _, err := readBodyInput(newBodyInputCmd(t, "--body", "x", "--body-file", path), "body")
+	if err == nil || !strings.Contains(err.Error(), "--body, --body-file") {
+		t.Fatalf("expected conflicting sources to be rejected, got %v", err)
+	}
+
+	runEditor = func(string) error { return nil }
+	if _, _, err := readBodyInput(newBodyInputCmd(t, "--edit"), "body"); err == nil || !strings.Contains(err.Error(), "empty") {
+		t.Fatalf("expected an empty editor buffer to abort, got %v", err)
+	}
+}
+
+func TestProjectUpdatePostCreate_BodyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "update.md")
+	if err := os.WriteFile(path, []byte("Long-form update\n\n- item\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	resetFlags(t, projectUpdatePostCreateCmd)
+	t.Cleanup(func() { resetFlags(t, projectUpdatePostCreateCmd) })
+	_ = projectUpdatePostCreateCmd.Flags().Set("body-file", path)
+
+	mc := &mockProjectClient{}
+	withInjectedProjectClient(t, mc, func() {
+		captureStdout(t, func() { projectUpdatePostCreateCmd.Run(projectUpdatePostCreateCmd, []string{"proj-1"}) })
+	})
+	if got := mc.projectUpdates["update-1"]; got == nil || got.Body != "Long-form update\n\n- item" {
+		t.Fatalf("unexpected update: %+v", got)
+	}
+}
@@ -0,0 +1,108 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+// gitBranch returns the current git branch; tests replace it.
+var gitBranch = func() (string, error) {
+	out, err := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD").Output()
+	if err != nil {
+		return "", fmt.Errorf("cannot read the current git branch (not inside a git repository?)")
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// branchIssuePattern finds a TEAM-123 identifier in a branch name such as
+// Linear's suggested "ann/eng-123-fix-login".
+var branchIssuePattern = regexp.MustCompile(`(?i)(?:^|[^a-z0-9])([a-z][a-z0-9]{0,9}-[0-9]+)(?:$|[^0-9])`)
+
+// issueIdentifierFromBranch returns the first issue identifier in branch,
+// upper-cased, or "" when there is none.
+func issueIdentifierFromBranch(branch string) string {
+	m := branchIssuePattern.FindStringSubmatch(branch)
+	if m == nil {
+		return ""
+	}
+	return strings.ToUpper(m[1])
+}
+
+// currentBranchIssue returns the issue identifier in the current git branch.
+func currentBranchIssue() (string, error) {
+	branch, err := gitBranch()
+	if err != nil {
+		return "", err
+	}
+	id := issueIdentifierFromBranch(branch)
+	if id == "" {
+		return "", fmt.Errorf("no issue identifier (like ENG-123) in branch '%s'", branch)
+	}
+	return id, nil
+}
+
+// fromBranchRequested reports whether --from-branch was given.
+func fromBranchRequested(cmd *cobra.Command) bool {
+	fromBranch, _ := cmd.Flags().GetBool("from-branch")
+	return fromBranch
+}
+
+// branchFallbackAllowed reports whether a missing issue ID may be taken
+// from the git branch without --from-branch: only in an interactive
+// terminal outside CI, so scripts never act on whatever branch is checked out.
+func branchFallbackAllowed() bool {
+	return stdinIsTTY() && os.Getenv("CI") == ""
+}
+
+// issueArgOrBranch returns the normalized issue ID argument or, when it is
+// omitted, the identifier in the current git branch. Without --from-branch
+// the branch is only consulted interactively (see branchFallbackAllowed), and
+// the chosen issue is announced on stderr.
+func issueArgOrBranch(cmd *cobra.Command, args []string) (string, error) {
+	if len(args) > 0 {
+		return normalizeIdentifier(args[0])
+	}
+	if fromBranchRequested(cmd) {
+		return currentBranchIssue()
+	}
+	if !branchFallbackAllowed() {
+		return "", fmt.Errorf("an issue ID is required (or use --from-branch to take it from the current git branch)")
+	}
+	id, err := currentBranchIssue()
+	if err != nil {
+		return "", fmt.Errorf("an issue ID is required: %w", err)
+	}
+	output.Hint(fmt.Sprintf("Using %s from the current git branch", id))
+	return id, nil
+}
+
+// confirmBranchIssue asks before a change is made to id when it was picked
+// from the git branch without --from-branch, so a stale checkout can't
+// silently redirect an update. --yes skips the question.
+func confirmBranchIssue(cmd *cobra.Command, args []string, id string) (bool, error) {
+	if len(args) > 0 || fromBranchRequested(cmd) {
+		return true, nil
+	}
+	return confirm(fmt.Sprintf("Update %s from the current git branch?", id), false)
+}
+
+// applyBranchTeamDefault fills an unset --team from the team key of the
+// issue in the current branch, when --from-branch is given. Branches without
+// an identifier leave --team alone.
+func applyBranchTeamDefault(cmd *cobra.Command) {
+	f := cmd.Flags().Lookup("team")
+	if f == nil || f.Changed || !fromBranchRequested(cmd) {
+		return
+	}
+	id, err := currentBranchIssue()
+	if err != nil {
+		return
+	}
+	_ = f.Value.Set(id[:strings.Index(id, "-")])
+}
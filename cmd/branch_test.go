@@ -0,0 +1,167 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func withGitBranch(t *testing.T, branch string) {
+	t.Helper()
+	orig := gitBranch
+	gitBranch = func() (string, error) { return branch, nil }
+	t.Cleanup(func() { gitBranch = orig })
+}
+
+func withStdinTTY(t *testing.T, tty bool) {
+	t.Helper()
+	orig := stdinIsTTY
+	stdinIsTTY = func() bool { return tty }
+	t.Cleanup(func() { stdinIsTTY = orig })
+}
+
+func TestIssueIdentifierFromBranch(t *testing.T) {
+	cases := map[string]string{
+		"ann/eng-42-fix-login-redirect": "ENG-42",
+		"ENG-7":                         "ENG-7",
+		"feature/OPS-1234_retry":        "OPS-1234",
+		"fix-login":                     "",
+		"main":                          "",
+		"v2-hotfix":                     "",
+	}
+	for branch, want := range cases {
+		if got := issueIdentifierFromBranch(branch); got != want {
+			t.Errorf("issueIdentifierFromBranch(%q) = %q, want %q", branch, got, want)
+		}
+	}
+}
+
+func TestIssueArgOrBranch(t *testing.T) {
+	withGitBranch(t, "ann/eng-42-fix-login")
+	withStdinTTY(t, false)
+	issueGetCmd.InheritedFlags() // merges --from-branch as cobra does when parsing
+	resetFlags(t, issueGetCmd)
+
+	if id, err := issueArgOrBranch(issueGetCmd, []string{"ENG-1"}); err != nil || id != "ENG-1" {
+		t.Fatalf("an explicit ID must win, got %q, %v", id, err)
+	}
+	if _, err := issueArgOrBranch(issueGetCmd, nil); err == nil || !strings.Contains(err.Error(), "--from-branch") {
+		t.Fatalf("expected a missing ID to point at --from-branch, got %v", err)
+	}
+	_ = issueGetCmd.Flags().Set("from-branch", "true")
+	t.Cleanup(func() { resetFlags(t, issueGetCmd) })
+	if id, err := issueArgOrBranch(issueGetCmd, nil); err != nil || id != "ENG-42" {
+		t.Fatalf("got %q, %v; want ENG-42 from the branch", id, err)
+	}
+
+	withGitBranch(t, "main")
+	if _, err := issueArgOrBranch(issueGetCmd, nil); err == nil || !strings.Contains(err.Error(), "'main'") {
+		t.Fatalf("expected a helpful error for a branch without an identifier, got %v", err)
+	}
+}
+
+func TestIssueArgOrBranch_InteractiveFallback(t *testing.T) {
+	withGitBranch(t, "ann/eng-42-fix-login")
+	withStdinTTY(t, true)
+	t.Setenv("CI", "")
+	issueGetCmd.InheritedFlags()
+	resetFlags(t, issueGetCmd)
+
+	var id string
+	var err error
+	stderr := captureStderr(t, func() { id, err = issueArgOrBranch(issueGetCmd, nil) })
+	if err != nil || id != "ENG-42" {
+		t.Fatalf("got %q, %v; want ENG-42 from the branch in a terminal", id, err)
+	}
+	if !strings.Contains(stderr, "Using ENG-42 from the current git branch") {
+		t.Fatalf("expected the branch issue to be announced, got %q", stderr)
+	}
+
+	t.Setenv("CI", "true")
+	if _, err := issueArgOrBranch(issueGetCmd, nil); err == nil || !strings.Contains(err.Error(), "--from-branch") {
+		t.Fatalf("CI must require --from-branch, got %v", err)
+	}
+
+	t.Setenv("CI", "")
+	withGitBranch(t, "main")
+	if _, err := issueArgOrBranch(issueGetCmd, nil); err == nil || !strings.Contains(err.Error(), "'main'") {
+		t.Fatalf("expected a helpful error for a branch without an identifier, got %v", err)
+	}
+}
+
+func TestApplyBranchTeamDefault(t *testing.T) {
+	withGitBranch(t, "ann/ops-9-rotate-keys")
+	issueListCmd.InheritedFlags()
+	resetFlags(t, issueListCmd)
+	t.Cleanup(func() { resetFlags(t, issueListCmd) })
+
+	applyBranchTeamDefault(issueListCmd)
+	if team, _ := issueListCmd.Flags().GetString("team"); team != "" {
+		t.Fatalf("without --from-branch --team must stay unset, got %q", team)
+	}
+
+	_ = issueListCmd.Flags().Set("from-branch", "true")
+	applyBranchTeamDefault(issueListCmd)
+	if team, _ := issueListCmd.Flags().GetString("team"); team != "OPS" {
+		t.Fatalf("--team = %q, want OPS from the branch", team)
+	}
+
+	_ = issueListCmd.Flags().Set("team", "ENG")
+	applyBranchTeamDefault(issueListCmd)
+	if team, _ := issueListCmd.Flags().GetString("team"); team != "ENG" {
+		t.Fatalf("an explicit --team must win, got %q", team)
+	}
+}
+
+func TestIssueCreate_TeamFromBranch(t *testing.T) {
+	withGitBranch(t, "ann/ops-9-rotate-keys")
+	var teamKey any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamByKey"):
+			teamKey = vars["key"]
+			return map[string]any{"teams": map[string]any{"nodes": []any{
+				map[string]any{"id": "team-ops", "key": "OPS", "name": "Operations"},
+			}}}
+		case strings.Contains(query, "issueCreate"):
+			return map[string]any{"issueCreate": map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "OPS-10", "title": "Follow-up",
+			}}}
+		}
+		return map[string]any{}
+	})
+
+	out, err := executeCommand(t, "issue", "create", "--title", "Follow-up", "--from-branch", "--plaintext")
+	if err != nil {
+		t.Fatalf("issue create --from-branch without --team failed: %v", err)
+	}
+	if teamKey != "OPS" || !strings.Contains(out, "OPS-10") {
+		t.Fatalf("expected the issue in the branch's team, got %v\n%s", teamKey, out)
+	}
+}
+
+func TestConfirmBranchIssue(t *testing.T) {
+	issueUpdateCmd.InheritedFlags()
+	resetFlags(t, issueUpdateCmd)
+	t.Cleanup(func() { resetFlags(t, issueUpdateCmd) })
+
+	prompt := withConfirmInput(t, true, "n\n")
+	if ok, err := confirmBranchIssue(issueUpdateCmd, nil, "ENG-42"); err != nil || ok {
+		t.Fatalf("got %v, %v; want the update declined", ok, err)
+	}
+	if !strings.Contains(prompt.String(), "Update ENG-42 from the current git branch?") {
+		t.Fatalf("expected a prompt naming the branch issue, got %q", prompt.String())
+	}
+
+	// An explicit ID or --from-branch needs no confirmation
+	prompt = withConfirmInput(t, true, "")
+	if ok, err := confirmBranchIssue(issueUpdateCmd, []string{"ENG-42"}, "ENG-42"); err != nil || !ok {
+		t.Fatalf("an explicit ID must not prompt, got %v, %v", ok, err)
+	}
+	_ = issueUpdateCmd.Flags().Set("from-branch", "true")
+	if ok, err := confirmBranchIssue(issueUpdateCmd, nil, "ENG-42"); err != nil || !ok {
+		t.Fatalf("--from-branch must not prompt, got %v, %v", ok, err)
+	}
+	if prompt.Len() != 0 {
+		t.Fatalf("unexpected prompt %q", prompt.String())
+	}
+}
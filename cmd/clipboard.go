@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/raegislabs/linctl/pkg/utils"
+	"github.com/spf13/cobra"
+)
+
+// copyToClipboard is an injection point for tests.
+var copyToClipboard = utils.CopyToClipboard
+
+// copyTarget is the value of --copy: "url" or "id". It is validated while
+// flags are parsed so a typo fails before anything is created.
+type copyTarget string
+
+func (c *copyTarget) String() string { return string(*c) }
+func (c *copyTarget) Type() string   { return "id|url" }
+
+func (c *copyTarget) Set(v string) error {
+	switch v {
+	case "", "url", "id":
+		*c = copyTarget(v)
+		return nil
+	}
+	return fmt.Errorf("must be id or url")
+}
+
+// addCopyFlag registers --copy[=id|url] on cmd. A bare --copy copies the URL.
+func addCopyFlag(cmd *cobra.Command, what string) {
+	var target copyTarget
+	cmd.Flags().Var(&target, "copy", fmt.Sprintf("Copy the %s's URL (or --copy=id for its identifier) to the clipboard", what))
+	cmd.Flags().Lookup("copy").NoOptDefVal = "url"
+}
+
+// copyFlagResult copies id or url to the clipboard as selected by --copy.
+// A notice goes to stderr, or the value is printed there when no clipboard
+// is available; under --json nothing is printed.
+func copyFlagResult(cmd *cobra.Command, id, url string, jsonOut bool) {
+	if !cmd.Flags().Changed("copy") {
+		return
+	}
+	what := cmd.Flags().Lookup("copy").Value.String()
+	text := url
+	if what == "id" {
+		text = id
+	}
+	if text == "" {
+		return
+	}
+
+	if err := copyToClipboard(text); err != nil {
+		if !jsonOut {
+			fmt.Fprintf(os.Stderr, "Clipboard unavailable (%v); %s: %s\n", err, what, text)
+		}
+		return
+	}
+	if !jsonOut {
+		fmt.Fprintf(os.Stderr, "Copied %s to clipboard\n", text)
+	}
+}
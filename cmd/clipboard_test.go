@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/spf13/cobra"
+)
+
+func TestCopyFlag(t *testing.T) {
+	var copied []string
+	var copyErr error
+	orig := copyToClipboard
+	copyToClipboard = func(s string) error {
+		copied = append(copied, s)
+		return copyErr
+	}
+	t.Cleanup(func() { copyToClipboard = orig })
+
+	newCmd := func(args ...string) (*cobra.Command, error) {
+		c := &cobra.Command{Use: "x"}
+		addCopyFlag(c, "issue")
+		return c, c.Flags().Parse(args)
+	}
+
+	c, _ := newCmd()
+	copyFlagResult(c, "ENG-1", "https://linear.app/acme/issue/ENG-1", false)
+	if len(copied) != 0 {
+		t.Fatalf("nothing should be copied without --copy, got %v", copied)
+	}
+
+	c, _ = newCmd("--copy")
+	copyFlagResult(c, "ENG-1", "https://linear.app/acme/issue/ENG-1", false)
+	c, _ = newCmd("--copy=id")
+	copyFlagResult(c, "ENG-1", "https://linear.app/acme/issue/ENG-1", false)
+	if len(copied) != 2 || copied[0] != "https://linear.app/acme/issue/ENG-1" || copied[1] != "ENG-1" {
+		t.Fatalf("unexpected clipboard contents: %v", copied)
+	}
+
+	copyErr = errors.New("no clipboard")
+	c, _ = newCmd("--copy")
+	out := captureStdout(t, func() { copyFlagResult(c, "ENG-1", "u", true) })
+	if out != "" {
+		t.Fatalf("--json output must stay clean, got %q", out)
+	}
+
+	if _, err := newCmd("--copy=title"); err == nil {
+		t.Fatalf("expected an invalid --copy value to be rejected")
+	}
+}
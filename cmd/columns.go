@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/spf13/cobra"
+)
+
+// columnWidths maps lower-cased table column names to the width cells are
+// truncated to. Zero means unlimited.
+type columnWidths map[string]int
+
+// issueColumnWidths and projectColumnWidths are the default widths of the
+// rich issue and project tables; their keys are the columns --max-width
+// accepts.
+var issueColumnWidths = columnWidths{
+	"title": 40, "state": 0, "assignee": 0, "team": 0, "project": 25,
+	"parent": 0, "labels": 25, "created": 0, "url": 0,
+}
+
+var projectColumnWidths = columnWidths{
+	"name": 25, "state": 0, "priority": 0, "lead": 0, "teams": 0,
+	"created": 0, "updated": 0, "url": 0,
+}
+
+// parseMaxWidths applies a --max-width spec such as "title=60,url=0" on top of
+// defaults. Column names are case-insensitive and must be keys of defaults.
+func parseMaxWidths(spec string, defaults columnWidths) (columnWidths, error) {
+	widths := make(columnWidths, len(defaults))
+	for k, v := range defaults {
+		widths[k] = v
+	}
+	for _, part := range splitCSV(spec) {
+		name, value, ok := strings.Cut(part, "=")
+		name = strings.ToLower(strings.TrimSpace(name))
+		if !ok || name == "" {
+			return nil, fmt.Errorf("invalid --max-width entry '%s' (expected COLUMN=WIDTH)", part)
+		}
+		if _, known := defaults[name]; !known {
+			return nil, fmt.Errorf("unknown column '%s' in --max-width (valid columns: %s)", name, strings.Join(defaults.columns(), ", "))
+		}
+		n, err := strconv.Atoi(strings.TrimSpace(value))
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid width '%s' for column '%s' (use a non-negative number; 0 is unlimited)", value, name)
+		}
+		widths[name] = n
+	}
+	return widths, nil
+}
+
+// truncate shortens s to the width configured for column, counted in runes.
+func (w columnWidths) truncate(column, s string) string {
+	n := w[column]
+	if n <= 0 || utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	if n <= 3 {
+		return string([]rune(s)[:n])
+	}
+	return truncateString(s, n)
+}
+
+func (w columnWidths) columns() []string {
+	names := make([]string, 0, len(w))
+	for name := range w {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// addMaxWidthFlag registers --max-width listing the columns of defaults.
+func addMaxWidthFlag(cmd *cobra.Command, defaults columnWidths) {
+	cmd.Flags().String("max-width", "", fmt.Sprintf("Per-column width limits for the table, e.g. title=60,url=0 (0 = unlimited; columns: %s)", strings.Join(defaults.columns(), ", ")))
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseMaxWidths(t *testing.T) {
+	widths, err := parseMaxWidths("Title=60, url=0,labels=10", issueColumnWidths)
+	if err != nil {
+		t.Fatalf("parseMaxWidths: %v", err)
+	}
+	if widths["title"] != 60 || widths["url"] != 0 || widths["labels"] != 10 || widths["project"] != 25 {
+		t.Fatalf("unexpected widths: %v", widths)
+	}
+	if issueColumnWidths["title"] != 40 {
+		t.Fatal("parseMaxWidths must not modify the defaults")
+	}
+
+	_, err = parseMaxWidths("summary=20", issueColumnWidths)
+	if err == nil || !strings.Contains(err.Error(), "valid columns: assignee, created,") {
+		t.Fatalf("expected unknown column error listing valid columns, got %v", err)
+	}
+	for _, bad := range []string{"title", "title=-1", "title=wide", "=5"} {
+		if _, err := parseMaxWidths(bad, issueColumnWidths); err == nil {
+			t.Errorf("expected an error for %q", bad)
+		}
+	}
+}
+
+func TestColumnWidthsTruncate(t *testing.T) {
+	w := columnWidths{"title": 8, "url": 0, "id": 2}
+	if got := w.truncate("title", "A long issue title"); got != "A lon..." {
+		t.Fatalf("truncate title = %q", got)
+	}
+	if got := w.truncate("url", "https://linear.app/acme/issue/ENG-1"); got != "https://linear.app/acme/issue/ENG-1" {
+		t.Fatalf("0 should be unlimited, got %q", got)
+	}
+	if got := w.truncate("id", "ENG-1"); got != "EN" {
+		t.Fatalf("narrow widths should cut without an ellipsis, got %q", got)
+	}
+
+	// Widths count characters, and multi-byte ones are never split
+	if got := w.truncate("title", "Überprüfung fehlt"); got != "Überp..." {
+		t.Fatalf("truncate multi-byte title = %q", got)
+	}
+	if got := w.truncate("title", "Ärger ü"); got != "Ärger ü" {
+		t.Fatalf("a title within the width must be kept, got %q", got)
+	}
+	if got := w.truncate("id", "日本語"); got != "日本" {
+		t.Fatalf("narrow multi-byte cut = %q", got)
+	}
+}
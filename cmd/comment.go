@@ -41,7 +41,7 @@ var commentListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -69,10 +69,19 @@ var commentListCmd = &cobra.Command{
 		}
 
 		// Get comments
-		comments, err := client.GetIssueComments(context.Background(), issueID, limit, "", orderBy)
+		progress := output.NewProgress(plaintext, jsonOut)
+		nodes, hasMore, err := fetchPages(progress, limit, func(first int, after string) ([]api.Comment, api.PageInfo, error) {
+			page, err := client.GetIssueComments(context.Background(), issueID, first, after, orderBy)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		progress.Stop()
+		comments := &api.Comments{Nodes: nodes, PageInfo: api.PageInfo{HasNextPage: hasMore}}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list comments: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -135,7 +144,7 @@ var commentCreateCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -152,7 +161,7 @@ var commentCreateCmd = &cobra.Command{
 		comment, err := client.CreateComment(context.Background(), issueID, body)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create comment: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -0,0 +1,327 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// configKeys documents every key linctl reads from its config file.
+var configKeys = map[string]string{
+	"output":         "Default output format: table, json, plaintext, csv or markdown",
+	"theme":          "Color theme: default, colorblind or mono",
+	"empty_cell":     "Placeholder for empty table cells",
+	"defaults.team":  "Team key used when a command's --team flag is not given",
+	"defaults.limit": "Result limit used when a command's --limit flag is not given",
+}
+
+// configTemplate is the commented starter file written by `config init`.
+const configTemplate = `# linctl configuration. Command-line flags and environment variables
+# (LINCTL_OUTPUT, LINCTL_THEME, LINCTL_EMPTY_CELL) take precedence.
+# Edit with 'linctl config set KEY VALUE' or by hand.
+
+# Default output format: table, json, plaintext, csv or markdown
+output: table
+
+# Color theme: default, colorblind or mono
+theme: default
+
+# Placeholder for empty table cells (team, project, parent, labels...)
+empty_cell: "-"
+
+# Fallbacks for flags that are not given on the command line
+defaults:
+  # Team key, e.g. ENG (applies to every command with a --team flag)
+  team: ""
+  # Result limit for list commands (0 fetches everything)
+  # limit: 50
+`
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Create, read and change the linctl config file",
+	Long: `Manage the linctl config file (default ~/.linctl.yaml, or --config).
+
+Supported keys:
+` + configKeyHelp(),
+}
+
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write a commented starter config file",
+	Long: `Write a commented starter config file listing every supported key.
+
+An existing file is left untouched unless --force is given.`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		force, _ := cmd.Flags().GetBool("force")
+
+		path, err := configFilePath()
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if _, err := os.Stat(path); err == nil && !force {
+			output.Error(fmt.Sprintf("%s already exists; use --force to overwrite it", path), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if err := os.WriteFile(path, []byte(configTemplate), 0o600); err != nil {
+			output.Error(fmt.Sprintf("Failed to write config: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		output.Success(fmt.Sprintf("Wrote %s", path), plaintext, jsonOut)
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get KEY",
+	Short: "Print a value from the config file",
+	Long: `Print a value from the config file. Exits with status 1 when the key is not set.
+
+Examples:
+  linctl config get output
+  linctl config get defaults.team`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		key := args[0]
+
+		if err := validateConfigKey(key); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		path, err := configFilePath()
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		doc, err := readConfigDocument(path)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		value, ok := configValue(doc, key)
+		if !ok {
+			output.Error(fmt.Sprintf("%s is not set in %s", key, path), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if jsonOut {
+			output.JSON(map[string]string{"key": key, "value": value})
+			return
+		}
+		fmt.Println(value)
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set KEY VALUE",
+	Short: "Set a value in the config file",
+	Long: `Set a value in the config file, creating the file if needed.
+Comments and other keys in the file are preserved.
+
+Examples:
+  linctl config set output json
+  linctl config set defaults.team ENG`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		key, value := args[0], args[1]
+
+		if err := validateConfigKey(key); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if err := validateConfigValue(key, value); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		path, err := configFilePath()
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		doc, err := readConfigDocument(path)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		setConfigValue(doc, key, value)
+
+		var buf bytes.Buffer
+		enc := yaml.NewEncoder(&buf)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			output.Error(fmt.Sprintf("Failed to encode config: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if err := os.WriteFile(path, buf.Bytes(), 0o600); err != nil {
+			output.Error(fmt.Sprintf("Failed to write config: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		output.Success(fmt.Sprintf("Set %s = %s in %s", key, value, path), plaintext, jsonOut)
+	},
+}
+
+// configFilePath returns the file named by --config, or ~/.linctl.yaml.
+func configFilePath() (string, error) {
+	if cfgFile != "" {
+		return cfgFile, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot locate home directory: %w", err)
+	}
+	return filepath.Join(home, ".linctl.yaml"), nil
+}
+
+func configKeyHelp() string {
+	keys := make([]string, 0, len(configKeys))
+	for k := range configKeys {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var b strings.Builder
+	for _, k := range keys {
+		fmt.Fprintf(&b, "  %-15s %s\n", k, configKeys[k])
+	}
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func validateConfigKey(key string) error {
+	if _, ok := configKeys[key]; ok {
+		return nil
+	}
+	return fmt.Errorf("unknown config key %q; supported keys:\n%s", key, configKeyHelp())
+}
+
+// validateConfigValue rejects values that would make every later command
+// fail at startup, including the `config set` needed to fix them.
+func validateConfigValue(key, value string) error {
+	switch key {
+	case "output":
+		for _, valid := range outputFormats {
+			if strings.EqualFold(value, valid) {
+				return nil
+			}
+		}
+		return fmt.Errorf("invalid output format '%s' (valid: %s)", value, strings.Join(outputFormats, ", "))
+	case "theme":
+		if _, ok := output.Themes[strings.ToLower(value)]; !ok {
+			return fmt.Errorf("unknown theme '%s' (use %s)", value, strings.Join(output.ThemeNames(), ", "))
+		}
+	case "defaults.limit":
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("defaults.limit must be a number, got '%s'", value)
+		}
+	}
+	return nil
+}
+
+// readConfigDocument parses the config file into a YAML node tree so edits
+// keep its comments. A missing or empty file yields an empty mapping.
+func readConfigDocument(path string) (*yaml.Node, error) {
+	doc := &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{{Kind: yaml.MappingNode}}}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return doc, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config: %w", err)
+	}
+	if len(bytes.TrimSpace(data)) == 0 {
+		return doc, nil
+	}
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s is not a YAML mapping", path)
+	}
+	return doc, nil
+}
+
+// configValue looks up a dotted key such as defaults.team.
+func configValue(doc *yaml.Node, key string) (string, bool) {
+	node := doc.Content[0]
+	for _, part := range strings.Split(key, ".") {
+		node = mappingValue(node, part)
+		if node == nil {
+			return "", false
+		}
+	}
+	if node.Kind != yaml.ScalarNode || node.Tag == "!!null" {
+		return "", false
+	}
+	return node.Value, true
+}
+
+// setConfigValue sets a dotted key, creating intermediate mappings.
+func setConfigValue(doc *yaml.Node, key, value string) {
+	node := doc.Content[0]
+	parts := strings.Split(key, ".")
+	for i, part := range parts {
+		child := mappingValue(node, part)
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode}
+			if i == len(parts)-1 {
+				child = &yaml.Node{Kind: yaml.ScalarNode}
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: part}, child)
+		}
+		node = child
+	}
+	// Clearing the tag lets YAML infer it, so numbers and booleans stay typed.
+	node.Kind, node.Tag, node.Value, node.Style = yaml.ScalarNode, "", value, 0
+	node.Content = nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	if node.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
+
+// applyConfigDefaults fills --team and --limit from defaults.team and
+// defaults.limit when the command has the flag and it was not given. The
+// flag is not marked as changed, so commands still treat the value as a
+// default rather than something the user typed.
+func applyConfigDefaults(cmd *cobra.Command) {
+	for flag, key := range map[string]string{"team": "defaults.team", "limit": "defaults.limit"} {
+		f := cmd.Flags().Lookup(flag)
+		if f == nil || f.Changed || !viper.IsSet(key) {
+			continue
+		}
+		if value := viper.GetString(key); value != "" {
+			_ = f.Value.Set(value)
+		}
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(configCmd)
+	configCmd.AddCommand(configInitCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+
+	configInitCmd.Flags().Bool("force", false, "Overwrite an existing config file")
+}
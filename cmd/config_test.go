@@ -0,0 +1,157 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func withConfigFile(t *testing.T) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "linctl.yaml")
+	orig := cfgFile
+	cfgFile = path
+	t.Cleanup(func() { cfgFile = orig })
+	return path
+}
+
+func TestConfigInit_WritesStarterAndRefusesOverwrite(t *testing.T) {
+	path := withConfigFile(t)
+	viper.Set("plaintext", true)
+	t.Cleanup(func() { viper.Set("plaintext", false) })
+	resetFlags(t, configInitCmd)
+
+	captureStdout(t, func() { configInitCmd.Run(configInitCmd, nil) })
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, key := range []string{"output:", "defaults:", "team:", "limit:"} {
+		if !strings.Contains(string(data), key) {
+			t.Fatalf("starter config missing %q:\n%s", key, data)
+		}
+	}
+
+	// Starter keys must all be documented config keys.
+	doc, err := readConfigDocument(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v, ok := configValue(doc, "defaults.limit"); ok {
+		t.Fatalf("defaults.limit should be commented out, got %q", v)
+	}
+	for _, key := range configDocumentKeys(doc.Content[0], "") {
+		if err := validateConfigKey(key); err != nil {
+			t.Fatalf("starter config sets unsupported key: %v", err)
+		}
+	}
+}
+
+func TestConfigSet_PreservesCommentsAndTypes(t *testing.T) {
+	path := withConfigFile(t)
+	if err := os.WriteFile(path, []byte(configTemplate), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	viper.Set("plaintext", true)
+	t.Cleanup(func() { viper.Set("plaintext", false) })
+
+	captureStdout(t, func() { configSetCmd.Run(configSetCmd, []string{"defaults.team", "ENG"}) })
+	captureStdout(t, func() { configSetCmd.Run(configSetCmd, []string{"defaults.limit", "25"}) })
+	captureStdout(t, func() { configSetCmd.Run(configSetCmd, []string{"theme", "colorblind"}) })
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := string(data)
+	if !strings.Contains(text, "# Color theme: default, colorblind or mono") {
+		t.Fatalf("comments were lost:\n%s", text)
+	}
+	if !strings.Contains(text, "limit: 25\n") || !strings.Contains(text, "theme: colorblind") {
+		t.Fatalf("values not written as expected:\n%s", text)
+	}
+	if !strings.Contains(text, `empty_cell: "-"`) {
+		t.Fatalf("untouched values should keep their quoting:\n%s", text)
+	}
+
+	out := captureStdout(t, func() { configGetCmd.Run(configGetCmd, []string{"defaults.team"}) })
+	if out != "ENG\n" {
+		t.Fatalf("config get defaults.team = %q", out)
+	}
+}
+
+func TestSetConfigValue_CreatesNestedKeys(t *testing.T) {
+	doc, err := readConfigDocument(filepath.Join(t.TempDir(), "missing.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	setConfigValue(doc, "defaults.team", "ENG")
+	if v, ok := configValue(doc, "defaults.team"); !ok || v != "ENG" {
+		t.Fatalf("defaults.team = %q, %v", v, ok)
+	}
+	if err := validateConfigKey("defaults.colour"); err == nil {
+		t.Fatalf("expected unknown keys to be rejected")
+	}
+	if err := validateConfigValue("output", "yes"); err == nil {
+		t.Fatalf("expected an invalid output format to be rejected")
+	}
+	if err := validateConfigValue("output", "JSON"); err != nil {
+		t.Fatalf("output json should be accepted: %v", err)
+	}
+}
+
+func TestApplyConfigDefaults_OnlyFillsUnsetFlags(t *testing.T) {
+	resetFlags(t, issueListCmd)
+	viper.Set("defaults.team", "ENG")
+	viper.Set("defaults.limit", 7)
+	t.Cleanup(func() {
+		viper.Set("defaults.team", nil)
+		viper.Set("defaults.limit", nil)
+		resetFlags(t, issueListCmd)
+	})
+
+	_ = issueListCmd.Flags().Set("team", "OPS")
+	applyConfigDefaults(issueListCmd)
+
+	team, _ := issueListCmd.Flags().GetString("team")
+	limit, _ := issueListCmd.Flags().GetInt("limit")
+	if team != "OPS" || limit != 7 {
+		t.Fatalf("team=%q limit=%d; want the explicit team and the configured limit", team, limit)
+	}
+}
+
+func TestIssueCreate_UsesConfiguredDefaultTeam(t *testing.T) {
+	var createdTeamID any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamByKey"):
+			return map[string]any{"teams": map[string]any{"nodes": []any{
+				map[string]any{"id": "team-eng", "key": vars["key"], "name": "Engineering"},
+			}}}
+		case strings.Contains(query, "issueCreate"):
+			input, _ := vars["input"].(map[string]any)
+			createdTeamID = input["teamId"]
+			return map[string]any{"issueCreate": map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-1", "title": "hi",
+			}}}
+		}
+		return map[string]any{}
+	})
+	configPath := withConfigFile(t)
+	if err := os.WriteFile(configPath, []byte("defaults:\n  team: ENG\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// Drop the values read from the file so later tests don't inherit them
+	t.Cleanup(func() { _ = viper.ReadConfig(strings.NewReader("")) })
+
+	out, err := executeCommand(t, "issue", "create", "--title", "hi", "--plaintext")
+	if err != nil {
+		t.Fatalf("issue create with defaults.team failed: %v", err)
+	}
+	if createdTeamID != "team-eng" || !strings.Contains(out, "ENG-1") {
+		t.Fatalf("expected the issue in the configured team, got %v\n%s", createdTeamID, out)
+	}
+}
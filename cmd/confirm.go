@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/mattn/go-isatty"
+	"github.com/spf13/viper"
+)
+
+// Injection points for tests
+var (
+	confirmIn  io.Reader = os.Stdin
+	confirmOut io.Writer = os.Stderr
+	stdinIsTTY           = func() bool {
+		fd := os.Stdin.Fd()
+		return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+	}
+)
+
+// errConfirmationRequired is returned by confirm when a prompt is needed but
+// stdin is not a terminal, so scripts fail instead of hanging.
+var errConfirmationRequired = fmt.Errorf("confirmation required but stdin is not a terminal; pass --yes (or set LINCTL_ASSUME_YES=1) to proceed")
+
+// assumeYesRequested reports whether --yes/-y or LINCTL_ASSUME_YES asked to
+// auto-confirm every prompt.
+func assumeYesRequested() bool {
+	return viper.GetBool("yes")
+}
+
+// confirm prints question to stderr and reports whether the user answered
+// yes. It is the single prompt helper for all commands: the global --yes
+// flag, or a command's own force flag passed as assumeYes, skips the prompt.
+func confirm(question string, assumeYes bool) (bool, error) {
+	if assumeYes || assumeYesRequested() {
+		return true, nil
+	}
+	if !stdinIsTTY() {
+		return false, errConfirmationRequired
+	}
+
+	fmt.Fprintf(confirmOut, "%s [y/N]: ", question)
+	answer, err := bufio.NewReader(confirmIn).ReadString('\n')
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return true, nil
+	}
+	return false, nil
+}
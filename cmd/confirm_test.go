@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+// withConfirmInput makes confirm read answer from a fake terminal (or a
+// non-terminal stdin when tty is false) and returns the prompt output.
+func withConfirmInput(t *testing.T, tty bool, answer string) *bytes.Buffer {
+	t.Helper()
+	var out bytes.Buffer
+	origIn, origOut, origTTY := confirmIn, confirmOut, stdinIsTTY
+	confirmIn, confirmOut = strings.NewReader(answer), &out
+	stdinIsTTY = func() bool { return tty }
+	t.Cleanup(func() { confirmIn, confirmOut, stdinIsTTY = origIn, origOut, origTTY })
+	return &out
+}
+
+func TestConfirm(t *testing.T) {
+	cases := []struct {
+		name      string
+		tty       bool
+		answer    string
+		assumeYes bool
+		want      bool
+		wantErr   error
+	}{
+		{name: "assume yes", assumeYes: true, want: true},
+		{name: "yes", tty: true, answer: "y\n", want: true},
+		{name: "YES", tty: true, answer: "YES\n", want: true},
+		{name: "no", tty: true, answer: "n\n"},
+		{name: "empty defaults to no", tty: true, answer: "\n"},
+		{name: "eof defaults to no", tty: true},
+		{name: "not a terminal", answer: "y\n", wantErr: errConfirmationRequired},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			out := withConfirmInput(t, tc.tty, tc.answer)
+			got, err := confirm("Proceed?", tc.assumeYes)
+			if !errors.Is(err, tc.wantErr) || got != tc.want {
+				t.Fatalf("confirm = (%v, %v), want (%v, %v)", got, err, tc.want, tc.wantErr)
+			}
+			if tc.tty && !tc.assumeYes && !strings.Contains(out.String(), "Proceed? [y/N]") {
+				t.Fatalf("expected the prompt on stderr, got %q", out.String())
+			}
+		})
+	}
+}
+
+func TestConfirm_GlobalYes(t *testing.T) {
+	withConfirmInput(t, false, "")
+	viper.Set("yes", true)
+	t.Cleanup(func() { viper.Set("yes", false) })
+
+	if ok, err := confirm("Proceed?", false); !ok || err != nil {
+		t.Fatalf("expected --yes to auto-confirm without a terminal, got (%v, %v)", ok, err)
+	}
+}
@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// cycleCmd represents the cycle command
+var cycleCmd = &cobra.Command{
+	Use:     "cycle",
+	Aliases: []string{"cycles"},
+	Short:   "Work with team cycles",
+	Long: `Inspect a team's cycles.
+
+Examples:
+  linctl cycle progress --team ENG              # Progress of the active cycle
+  linctl cycle progress --team ENG --number 12  # Progress of cycle 12`,
+}
+
+var cycleProgressCmd = &cobra.Command{
+	Use:   "progress",
+	Short: "Show completed vs total work for a cycle",
+	Long: `Summarise a cycle's progress: completed vs total issues and estimate points,
+how far through the cycle's dates you are, and how many points an even
+burndown would have left by now.
+
+Canceled issues are reported but excluded from the totals. Issues without an
+estimate count towards the issue totals but contribute no points.
+
+Defaults to the team's active cycle; use --number to pick another one.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		if teamKey == "" {
+			output.Error("Team is required (--team)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		authHeader, err := getIssueAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		client := newIssueClient(authHeader)
+		ctx := context.Background()
+
+		var cycle *api.Cycle
+		if cmd.Flags().Changed("number") {
+			number, _ := cmd.Flags().GetInt("number")
+			cycle, err = client.GetTeamCycleByNumber(ctx, teamKey, number)
+			if err == nil && cycle == nil {
+				err = fmt.Errorf("team %s has no cycle %d", teamKey, number)
+			}
+		} else {
+			cycle, err = client.GetTeamActiveCycle(ctx, teamKey)
+			if err == nil && cycle == nil {
+				err = fmt.Errorf("team %s has no active cycle (use --number)", teamKey)
+			}
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to resolve cycle: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		fetching := output.NewProgress(plaintext, jsonOut)
+		issues, hasMore, err := fetchAllPages(fetching, func(first int, after string) ([]api.Issue, api.PageInfo, error) {
+			page, err := client.GetCycleIssues(ctx, cycle.ID, first, after)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		fetching.Stop()
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch cycle issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if hasMore {
+			fmt.Fprintf(os.Stderr, "Warning: cycle has more than %d issues; progress covers the first %d\n", fetchAllCap, fetchAllCap)
+		}
+
+		progress := computeCycleProgress(teamKey, cycle, issues, time.Now())
+
+		if jsonOut {
+			output.JSON(progress)
+			return
+		}
+		if plaintext {
+			renderCycleProgressPlaintext(progress)
+			return
+		}
+		renderCycleProgress(progress)
+	},
+}
+
+// cycleProgress is the summary printed by `cycle progress`.
+type cycleProgress struct {
+	Team                 string  `json:"team"`
+	Number               int     `json:"number"`
+	Name                 string  `json:"name,omitempty"`
+	StartsAt             string  `json:"startsAt"`
+	EndsAt               string  `json:"endsAt"`
+	DaysElapsed          int     `json:"daysElapsed"`
+	DaysTotal            int     `json:"daysTotal"`
+	TotalIssues          int     `json:"totalIssues"`
+	CompletedIssues      int     `json:"completedIssues"`
+	StartedIssues        int     `json:"startedIssues"`
+	UnstartedIssues      int     `json:"unstartedIssues"`
+	CanceledIssues       int     `json:"canceledIssues"`
+	ScopePoints          float64 `json:"scopePoints"`
+	CompletedPoints      float64 `json:"completedPoints"`
+	RemainingPoints      float64 `json:"remainingPoints"`
+	IdealRemainingPoints float64 `json:"idealRemainingPoints"`
+	IssuePercent         float64 `json:"issuePercent"`
+	PointPercent         float64 `json:"pointPercent"`
+}
+
+// computeCycleProgress tallies issues by state type and measures elapsed time
+// against the cycle's dates as of now.
+func computeCycleProgress(teamKey string, cycle *api.Cycle, issues []api.Issue, now time.Time) cycleProgress {
+	p := cycleProgress{
+		Team:     teamKey,
+		Number:   cycle.Number,
+		Name:     cycle.Name,
+		StartsAt: cycle.StartsAt,
+		EndsAt:   cycle.EndsAt,
+	}
+
+	for _, issue := range issues {
+		stateType := ""
+		if issue.State != nil {
+			stateType = issue.State.Type
+		}
+		if stateType == "canceled" {
+			p.CanceledIssues++
+			continue
+		}
+
+		points := 0.0
+		if issue.Estimate != nil {
+			points = *issue.Estimate
+		}
+		p.TotalIssues++
+		p.ScopePoints += points
+
+		switch stateType {
+		case "completed":
+			p.CompletedIssues++
+			p.CompletedPoints += points
+		case "started":
+			p.StartedIssues++
+		default:
+			p.UnstartedIssues++
+		}
+	}
+	p.RemainingPoints = p.ScopePoints - p.CompletedPoints
+	p.IssuePercent = percentOf(p.CompletedIssues, p.TotalIssues)
+	p.PointPercent = percentOfPoints(p.CompletedPoints, p.ScopePoints)
+
+	start, errStart := time.Parse(time.RFC3339, cycle.StartsAt)
+	end, errEnd := time.Parse(time.RFC3339, cycle.EndsAt)
+	if errStart == nil && errEnd == nil && end.After(start) {
+		length := end.Sub(start)
+		elapsed := now.Sub(start)
+		if elapsed < 0 {
+			elapsed = 0
+		}
+		if elapsed > length {
+			elapsed = length
+		}
+		p.DaysTotal = int(math.Ceil(length.Hours() / 24))
+		p.DaysElapsed = int(math.Ceil(elapsed.Hours() / 24))
+		fraction := float64(elapsed) / float64(length)
+		p.IdealRemainingPoints = math.Round(p.ScopePoints*(1-fraction)*10) / 10
+	}
+
+	return p
+}
+
+func percentOf(n, total int) float64 {
+	return percentOfPoints(float64(n), float64(total))
+}
+
+// percentOfPoints returns n as a percentage of total, rounded to one decimal.
+func percentOfPoints(n, total float64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return math.Round(n/total*1000) / 10
+}
+
+// progressBar draws a fixed-width bar filled to percent.
+func progressBar(percent float64, width int) string {
+	filled := int(math.Round(percent / 100 * float64(width)))
+	if filled > width {
+		filled = width
+	}
+	if filled < 0 {
+		filled = 0
+	}
+	return output.Color(output.RoleSuccess).Sprint(strings.Repeat("█", filled)) +
+		output.Color(output.RoleMuted).Sprint(strings.Repeat("░", width-filled))
+}
+
+func cycleProgressTitle(p cycleProgress) string {
+	title := fmt.Sprintf("Cycle %d", p.Number)
+	if p.Name != "" {
+		title += " · " + p.Name
+	}
+	return title
+}
+
+func renderCycleProgressPlaintext(p cycleProgress) {
+	fmt.Printf("# %s (%s)\n\n", cycleProgressTitle(p), p.Team)
+	fmt.Printf("- **Dates**: %s → %s\n", formatCycleDate(p.StartsAt), formatCycleDate(p.EndsAt))
+	fmt.Printf("- **Day**: %d of %d\n", p.DaysElapsed, p.DaysTotal)
+	fmt.Printf("- **Issues**: %d/%d completed (%.1f%%)\n", p.CompletedIssues, p.TotalIssues, p.IssuePercent)
+	fmt.Printf("- **In Progress**: %d\n", p.StartedIssues)
+	fmt.Printf("- **Not Started**: %d\n", p.UnstartedIssues)
+	fmt.Printf("- **Canceled**: %d\n", p.CanceledIssues)
+	fmt.Printf("- **Points**: %s/%s completed (%.1f%%)\n", formatPoints(p.CompletedPoints), formatPoints(p.ScopePoints), p.PointPercent)
+	fmt.Printf("- **Remaining Points**: %s (ideal %s)\n", formatPoints(p.RemainingPoints), formatPoints(p.IdealRemainingPoints))
+}
+
+func renderCycleProgress(p cycleProgress) {
+	fmt.Println()
+	fmt.Printf("%s %s\n",
+		output.Color(output.RoleIdentifier).Sprint("🔄 "+cycleProgressTitle(p)),
+		output.Color(output.RoleName).Sprintf("(%s)", p.Team))
+	fmt.Printf("%s → %s · day %d of %d\n",
+		formatCycleDate(p.StartsAt), formatCycleDate(p.EndsAt), p.DaysElapsed, p.DaysTotal)
+	fmt.Println(strings.Repeat("─", 50))
+
+	fmt.Printf("%s %s %d/%d (%.0f%%)\n",
+		output.Color(output.RoleLabel).Sprint("Issues:"),
+		progressBar(p.IssuePercent, 20), p.CompletedIssues, p.TotalIssues, p.IssuePercent)
+	fmt.Printf("%s %s %s/%s (%.0f%%)\n",
+		output.Color(output.RoleLabel).Sprint("Points:"),
+		progressBar(p.PointPercent, 20), formatPoints(p.CompletedPoints), formatPoints(p.ScopePoints), p.PointPercent)
+
+	status := output.Color(output.RoleSuccess).Sprint("on track")
+	if p.RemainingPoints > p.IdealRemainingPoints {
+		status = output.Color(output.RoleWarning).Sprint("behind")
+	}
+	fmt.Printf("\n%s %s pts (ideal %s) · %s\n",
+		output.Color(output.RoleLabel).Sprint("Remaining:"),
+		formatPoints(p.RemainingPoints), formatPoints(p.IdealRemainingPoints), status)
+	fmt.Printf("%s %d in progress · %d not started · %d canceled\n",
+		output.Color(output.RoleLabel).Sprint("Open:"),
+		p.StartedIssues, p.UnstartedIssues, p.CanceledIssues)
+	fmt.Println()
+}
+
+// formatCycleDate shortens an RFC3339 cycle boundary to its date.
+func formatCycleDate(s string) string {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t.Format("2006-01-02")
+	}
+	return s
+}
+
+// formatPoints prints whole estimates without a trailing ".0".
+func formatPoints(v float64) string {
+	if v == math.Trunc(v) {
+		return fmt.Sprintf("%.0f", v)
+	}
+	return fmt.Sprintf("%.1f", v)
+}
+
+func init() {
+	rootCmd.AddCommand(cycleCmd)
+	cycleCmd.AddCommand(cycleProgressCmd)
+
+	cycleProgressCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	cycleProgressCmd.Flags().IntP("number", "n", 0, "Cycle number (default: the team's active cycle)")
+}
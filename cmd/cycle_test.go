@@ -0,0 +1,102 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+func cycleTestIssue(stateType string, estimate float64) api.Issue {
+	issue := api.Issue{State: &api.State{Type: stateType}}
+	if estimate > 0 {
+		issue.Estimate = &estimate
+	}
+	return issue
+}
+
+func TestComputeCycleProgress(t *testing.T) {
+	cycle := &api.Cycle{
+		Number:   12,
+		StartsAt: "2025-06-01T00:00:00Z",
+		EndsAt:   "2025-06-11T00:00:00Z",
+	}
+	issues := []api.Issue{
+		cycleTestIssue("completed", 3),
+		cycleTestIssue("completed", 2),
+		cycleTestIssue("started", 5),
+		cycleTestIssue("unstarted", 0),
+		cycleTestIssue("backlog", 0),
+		cycleTestIssue("canceled", 8),
+	}
+	now := time.Date(2025, 6, 5, 0, 0, 0, 0, time.UTC)
+
+	p := computeCycleProgress("ENG", cycle, issues, now)
+
+	if p.TotalIssues != 5 || p.CompletedIssues != 2 || p.StartedIssues != 1 || p.UnstartedIssues != 2 || p.CanceledIssues != 1 {
+		t.Fatalf("unexpected issue tallies: %+v", p)
+	}
+	if p.ScopePoints != 10 || p.CompletedPoints != 5 || p.RemainingPoints != 5 {
+		t.Fatalf("unexpected points: %+v", p)
+	}
+	if p.IssuePercent != 40 || p.PointPercent != 50 {
+		t.Fatalf("unexpected percentages: issues %v, points %v", p.IssuePercent, p.PointPercent)
+	}
+	if p.DaysElapsed != 4 || p.DaysTotal != 10 {
+		t.Fatalf("expected day 4 of 10, got %d of %d", p.DaysElapsed, p.DaysTotal)
+	}
+	if p.IdealRemainingPoints != 6 {
+		t.Fatalf("expected ideal remaining 6, got %v", p.IdealRemainingPoints)
+	}
+
+	after := computeCycleProgress("ENG", cycle, issues, now.AddDate(0, 1, 0))
+	if after.DaysElapsed != 10 || after.IdealRemainingPoints != 0 {
+		t.Fatalf("finished cycle should clamp to its end, got %+v", after)
+	}
+}
+
+func TestCycleProgress_JSON(t *testing.T) {
+	var sawNumber any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamCycleByNumber"):
+			sawNumber = vars["number"]
+			return map[string]any{"team": map[string]any{"cycles": map[string]any{"nodes": []any{
+				map[string]any{"id": "cyc-1", "number": 7, "startsAt": "2025-06-01T00:00:00Z", "endsAt": "2025-06-15T00:00:00Z"},
+			}}}}
+		case strings.Contains(query, "CycleIssues"):
+			if vars["id"] != "cyc-1" {
+				t.Fatalf("expected issues of cyc-1, got %v", vars["id"])
+			}
+			return map[string]any{"cycle": map[string]any{"issues": map[string]any{
+				"nodes": []any{
+					map[string]any{"id": "1", "estimate": 2, "state": map[string]any{"type": "completed"}},
+					map[string]any{"id": "2", "estimate": 3, "state": map[string]any{"type": "started"}},
+				},
+				"pageInfo": map[string]any{"hasNextPage": false},
+			}}}
+		}
+		t.Fatalf("unexpected query: %s", query)
+		return nil
+	})
+	resetFlags(t, cycleProgressCmd)
+	viper.Set("json", true)
+
+	_ = cycleProgressCmd.Flags().Set("team", "ENG")
+	_ = cycleProgressCmd.Flags().Set("number", "7")
+	out := captureStdout(t, func() { cycleProgressCmd.Run(cycleProgressCmd, nil) })
+
+	if sawNumber != float64(7) {
+		t.Fatalf("expected cycle number 7 in query, got %v", sawNumber)
+	}
+	var got cycleProgress
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON output %q: %v", out, err)
+	}
+	if got.Number != 7 || got.TotalIssues != 2 || got.CompletedIssues != 1 || got.ScopePoints != 5 || got.CompletedPoints != 2 {
+		t.Fatalf("unexpected progress: %+v", got)
+	}
+}
@@ -0,0 +1,296 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/auth"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+)
+
+// doctorCheck is one line of the doctor checklist. Status is pass, warn,
+// fail or skip; Hint says how to fix anything but a pass.
+type doctorCheck struct {
+	Name   string `json:"name"`
+	Status string `json:"status"`
+	Detail string `json:"detail"`
+	Hint   string `json:"hint,omitempty"`
+}
+
+// doctorReport is what doctor prints, and with --json is meant to be pasted
+// into support requests as is.
+type doctorReport struct {
+	Version   string            `json:"version"`
+	GoVersion string            `json:"goVersion"`
+	Platform  string            `json:"platform"`
+	Paths     map[string]string `json:"paths"`
+	APIURL    string            `json:"apiUrl"`
+	Proxy     string            `json:"proxy,omitempty"`
+	Checks    []doctorCheck     `json:"checks"`
+	OK        bool              `json:"ok"`
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check authentication, API access and configuration",
+	Long: `Run a checklist of the usual reasons linctl does not work:
+
+  - the auth file exists, parses and is private to you
+  - the config file parses and holds only known keys with valid values
+  - the Linear API is reachable
+  - the stored token is accepted by Linear
+
+Each failed check comes with a hint. Exits with status 1 when any check
+fails. --json prints the checklist with versions and paths, ready to attach
+to a bug report; tokens are never included.
+
+Examples:
+  linctl doctor
+  linctl doctor --json > linctl-doctor.json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		report := runDoctor(context.Background())
+		renderDoctorReport(report, plaintext, jsonOut)
+		if !report.OK {
+			os.Exit(1)
+		}
+	},
+}
+
+// runDoctor gathers the environment details and runs every check.
+func runDoctor(ctx context.Context) doctorReport {
+	authPath, _ := auth.ConfigPath()
+	configPath, _ := configFilePath()
+	report := doctorReport{
+		Version:   version,
+		GoVersion: runtime.Version(),
+		Platform:  runtime.GOOS + "/" + runtime.GOARCH,
+		Paths:     map[string]string{"auth": authPath, "config": configPath},
+		APIURL:    api.BaseURL,
+		Proxy:     viper.GetString("proxy"),
+	}
+	if statePath, err := sinceStatePath(); err == nil {
+		report.Paths["state"] = statePath
+	}
+
+	report.Checks = append(report.Checks, checkAuthFile(authPath), checkConfigFile(configPath))
+	report.Checks = append(report.Checks, checkAPI(ctx)...)
+
+	report.OK = true
+	for _, c := range report.Checks {
+		if c.Status == "fail" {
+			report.OK = false
+		}
+	}
+	return report
+}
+
+// checkAuthFile checks that the credentials file exists, parses and is not
+// readable by other users.
+func checkAuthFile(path string) doctorCheck {
+	check := doctorCheck{Name: "Auth file"}
+	info, err := os.Stat(path)
+	if err != nil {
+		check.Status, check.Detail = "fail", fmt.Sprintf("%s not found", path)
+		check.Hint = "Run 'linctl auth login' to store an API key"
+		return check
+	}
+	config, err := auth.LoadConfig()
+	if err != nil {
+		check.Status, check.Detail = "fail", fmt.Sprintf("cannot parse %s: %v", path, err)
+		check.Hint = "Run 'linctl auth logout' and then 'linctl auth login'"
+		return check
+	}
+	if config.APIKey == "" && config.AccessToken == "" {
+		check.Status, check.Detail = "fail", fmt.Sprintf("%s holds no API key or token", path)
+		check.Hint = "Run 'linctl auth login'"
+		return check
+	}
+
+	kind := "personal API key"
+	if config.AccessToken != "" {
+		kind = "OAuth token"
+		if config.ExpiresAt != "" {
+			kind += ", expires " + config.ExpiresAt
+		}
+	}
+	check.Status, check.Detail = "pass", fmt.Sprintf("%s (%s)", path, kind)
+	if runtime.GOOS != "windows" && info.Mode().Perm()&0o077 != 0 {
+		check.Status = "warn"
+		check.Detail = fmt.Sprintf("%s is readable by other users (mode %04o)", path, info.Mode().Perm())
+		check.Hint = fmt.Sprintf("Run 'chmod 600 %s'", path)
+	}
+	return check
+}
+
+// checkConfigFile validates the config file the way 'config set' would. A
+// missing file is fine: every key has a default.
+func checkConfigFile(path string) doctorCheck {
+	check := doctorCheck{Name: "Config file"}
+	if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+		check.Status, check.Detail = "pass", fmt.Sprintf("%s not found; defaults apply", path)
+		return check
+	}
+	doc, err := readConfigDocument(path)
+	if err != nil {
+		check.Status, check.Detail = "fail", err.Error()
+		check.Hint = "Fix the YAML by hand, or replace the file with 'linctl config init --force'"
+		return check
+	}
+
+	var unknown, invalid []string
+	for _, key := range configDocumentKeys(doc.Content[0], "") {
+		if validateConfigKey(key) != nil {
+			unknown = append(unknown, key)
+			continue
+		}
+		if value, ok := configValue(doc, key); ok {
+			if err := validateConfigValue(key, value); err != nil {
+				invalid = append(invalid, err.Error())
+			}
+		}
+	}
+	switch {
+	case len(invalid) > 0:
+		check.Status, check.Detail = "fail", strings.Join(invalid, "; ")
+		check.Hint = "Correct the value with 'linctl config set KEY VALUE'"
+	case len(unknown) > 0:
+		check.Status, check.Detail = "warn", fmt.Sprintf("unknown keys ignored: %s", strings.Join(unknown, ", "))
+		check.Hint = "See 'linctl config --help' for the supported keys"
+	default:
+		check.Status, check.Detail = "pass", path
+	}
+	return check
+}
+
+// configDocumentKeys lists the dotted keys of every scalar in a config
+// mapping, e.g. defaults.team.
+func configDocumentKeys(node *yaml.Node, prefix string) []string {
+	var keys []string
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := prefix + node.Content[i].Value
+		if value := node.Content[i+1]; value.Kind == yaml.MappingNode {
+			keys = append(keys, configDocumentKeys(value, key+".")...)
+		} else {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// checkAPI makes one viewer query and reports on two things: whether Linear
+// answered at all, and whether it accepted the stored token. The query is
+// sent even without credentials, since any answer shows the API is reachable.
+func checkAPI(ctx context.Context) []doctorCheck {
+	reach := doctorCheck{Name: "API reachable"}
+	token := doctorCheck{Name: "Token valid"}
+
+	authHeader, authErr := getIssueAuthHeader()
+	start := time.Now()
+	viewer, err := newIssueClient(authHeader).GetViewer(ctx)
+	elapsed := time.Since(start).Round(time.Millisecond)
+
+	var netErr *api.NetworkError
+	if errors.As(err, &netErr) {
+		reach.Status, reach.Detail = "fail", netErr.Error()
+		reach.Hint = "Check your connection, VPN and proxy settings (--proxy or HTTPS_PROXY)"
+		token.Status, token.Detail = "skip", "the API could not be reached"
+		return []doctorCheck{reach, token}
+	}
+	reach.Status, reach.Detail = "pass", fmt.Sprintf("answered in %s", elapsed)
+
+	switch {
+	case authErr != nil:
+		token.Status, token.Detail = "fail", fmt.Sprintf("no usable credentials: %v", authErr)
+		token.Hint = "Run 'linctl auth login'"
+	case err != nil:
+		token.Status, token.Detail = "fail", fmt.Sprintf("Linear rejected the token: %v", err)
+		token.Hint = "The key may have been revoked; run 'linctl auth login' with a new one"
+	default:
+		token.Status, token.Detail = "pass", fmt.Sprintf("authenticated as %s (%s)", viewer.Name, viewer.Email)
+	}
+	return []doctorCheck{reach, token}
+}
+
+func renderDoctorReport(report doctorReport, plaintext, jsonOut bool) {
+	if jsonOut {
+		output.JSON(report)
+		return
+	}
+
+	failed := 0
+	for _, c := range report.Checks {
+		if c.Status == "fail" {
+			failed++
+		}
+	}
+
+	if plaintext {
+		fmt.Printf("linctl %s (%s, %s)\n", report.Version, report.GoVersion, report.Platform)
+		fmt.Printf("Auth file: %s\nConfig file: %s\nAPI: %s\n", report.Paths["auth"], report.Paths["config"], report.APIURL)
+		if report.Proxy != "" {
+			fmt.Printf("Proxy: %s\n", report.Proxy)
+		}
+		fmt.Println()
+		for _, c := range report.Checks {
+			fmt.Printf("[%s] %s: %s\n", strings.ToUpper(c.Status), c.Name, c.Detail)
+			if c.Hint != "" {
+				fmt.Printf("       %s\n", c.Hint)
+			}
+		}
+		fmt.Printf("\n%d of %d checks failed\n", failed, len(report.Checks))
+		return
+	}
+
+	muted := output.Color(output.RoleMuted)
+	fmt.Printf("%s %s %s\n", output.Color(output.RoleTitle).Sprint("linctl"), report.Version,
+		muted.Sprintf("(%s, %s)", report.GoVersion, report.Platform))
+	fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Auth file:  "), report.Paths["auth"])
+	fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Config file:"), report.Paths["config"])
+	fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("API:        "), report.APIURL)
+	if report.Proxy != "" {
+		fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Proxy:      "), report.Proxy)
+	}
+	fmt.Println()
+	for _, c := range report.Checks {
+		var mark string
+		switch c.Status {
+		case "pass":
+			mark = output.Color(output.RoleSuccess).Sprint("✓")
+		case "warn":
+			mark = output.Color(output.RoleWarning).Sprint("!")
+		case "fail":
+			mark = output.Color(output.RoleError).Sprint("✗")
+		default:
+			mark = muted.Sprint("-")
+		}
+		fmt.Printf("%s %s %s\n", mark, output.Color(output.RoleLabel).Sprint(c.Name+":"), c.Detail)
+		if c.Hint != "" {
+			fmt.Printf("  %s\n", muted.Sprint("→ "+c.Hint))
+		}
+	}
+	fmt.Println()
+	if failed == 0 {
+		output.Success("All checks passed", plaintext, jsonOut)
+	} else {
+		output.Error(fmt.Sprintf("%d of %d checks failed", failed, len(report.Checks)), plaintext, jsonOut)
+	}
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -0,0 +1,117 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// withDoctorHome points the auth file at a temporary home directory holding
+// authJSON, with the given file mode.
+func withDoctorHome(t *testing.T, authJSON string, mode os.FileMode) string {
+	t.Helper()
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	path := filepath.Join(home, ".linctl-auth.json")
+	if err := os.WriteFile(path, []byte(authJSON), mode); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(path, mode); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func doctorStatuses(report doctorReport) map[string]string {
+	statuses := map[string]string{}
+	for _, c := range report.Checks {
+		statuses[c.Name] = c.Status
+	}
+	return statuses
+}
+
+func TestDoctor_AllChecksPass(t *testing.T) {
+	withDoctorHome(t, `{"api_key": "lin_api_test"}`, 0o600)
+	withConfigFile(t)
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		return map[string]any{"viewer": map[string]any{"id": "u1", "name": "Ada", "email": "ada@example.com"}}
+	})
+	viper.Set("json", true)
+
+	out := captureStdout(t, func() { doctorCmd.Run(doctorCmd, nil) })
+
+	var report doctorReport
+	if err := json.Unmarshal([]byte(out), &report); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if !report.OK || report.Version == "" || report.Paths["auth"] == "" {
+		t.Fatalf("unexpected report: %+v", report)
+	}
+	want := map[string]string{"Auth file": "pass", "Config file": "pass", "API reachable": "pass", "Token valid": "pass"}
+	if got := doctorStatuses(report); !maps.Equal(got, want) {
+		t.Fatalf("statuses = %v, want %v", got, want)
+	}
+	if strings.Contains(out, "lin_api_test") {
+		t.Fatal("the report must not include the token")
+	}
+}
+
+func TestDoctor_ReportsProblems(t *testing.T) {
+	withDoctorHome(t, `{"api_key": `, 0o644)
+	configPath := withConfigFile(t)
+	if err := os.WriteFile(configPath, []byte("theme: neon\ncolour: red\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	// A server that answers but rejects the token
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"errors":[{"message":"Authentication required"}]}`))
+	}))
+	defer srv.Close()
+	origClient, origAuth := newIssueClient, getIssueAuthHeader
+	newIssueClient = func(h string) *api.Client { return api.NewClientWithURL(srv.URL, h) }
+	getIssueAuthHeader = func() (string, error) { return "lin_api_revoked", nil }
+	t.Cleanup(func() { newIssueClient, getIssueAuthHeader = origClient, origAuth })
+
+	report := runDoctor(context.Background())
+
+	want := map[string]string{"Auth file": "fail", "Config file": "fail", "API reachable": "pass", "Token valid": "fail"}
+	if got := doctorStatuses(report); !maps.Equal(got, want) {
+		t.Fatalf("statuses = %v, want %v", got, want)
+	}
+	if report.OK {
+		t.Fatal("expected the report to fail")
+	}
+	for _, c := range report.Checks {
+		if c.Status != "pass" && c.Hint == "" {
+			t.Errorf("%s failed without a hint", c.Name)
+		}
+	}
+}
+
+func TestCheckAuthFile_WarnsWhenReadableByOthers(t *testing.T) {
+	path := withDoctorHome(t, `{"api_key": "lin_api_test"}`, 0o644)
+	if check := checkAuthFile(path); check.Status != "warn" || !strings.Contains(check.Hint, "chmod 600") {
+		t.Fatalf("unexpected check: %+v", check)
+	}
+}
+
+func TestCheckConfigFile_UnknownKeysWarn(t *testing.T) {
+	path := withConfigFile(t)
+	if err := os.WriteFile(path, []byte("output: json\ndefaults:\n  team: ENG\n  colour: red\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	check := checkConfigFile(path)
+	if check.Status != "warn" || !strings.Contains(check.Detail, "defaults.colour") {
+		t.Fatalf("unexpected check: %+v", check)
+	}
+}
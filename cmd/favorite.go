@@ -0,0 +1,283 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/auth"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// favoritesCmd represents the favorites command
+var favoritesCmd = &cobra.Command{
+	Use:     "favorites",
+	Aliases: []string{"favorite", "fav"},
+	Short:   "Manage your Linear favorites",
+	Long: `Manage the favorites shown in your Linear sidebar.
+
+Examples:
+  linctl favorites list                 # List all favorites
+  linctl issue favorite LIN-123         # Favorite an issue
+  linctl issue unfavorite LIN-123       # Remove an issue from favorites
+  linctl project favorite PROJECT-ID    # Favorite a project
+  linctl project unfavorite PROJECT-ID  # Remove a project from favorites`,
+}
+
+var favoritesListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List favorites",
+	Long:    `List all of your favorites across issues, projects, cycles and labels.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := api.NewClient(authHeader)
+
+		favorites, err := getAllFavorites(context.Background(), client)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list favorites: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(favorites)
+			return
+		}
+
+		if len(favorites) == 0 {
+			output.Info("No favorites found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("Type\tName\tID")
+			for _, fav := range favorites {
+				name, id := favoriteDisplay(fav)
+				fmt.Printf("%s\t%s\t%s\n", fav.Type, name, id)
+			}
+			return
+		}
+
+		headers := []string{"Type", "Name", "ID"}
+		rows := [][]string{}
+		for _, fav := range favorites {
+			name, id := favoriteDisplay(fav)
+			rows = append(rows, []string{
+				color.New(color.FgYellow).Sprint(fav.Type),
+				truncateString(name, 60),
+				color.New(color.FgCyan).Sprint(id),
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		output.Summary("%d favorites", len(favorites))
+	},
+}
+
+var issueFavoriteCmd = &cobra.Command{
+	Use:   "favorite ISSUE-ID",
+	Short: "Add an issue to your favorites",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		issue, err := client.GetIssue(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Issue '%s' not found", args[0]), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		fav, err := client.CreateFavorite(context.Background(), map[string]interface{}{"issueId": issue.ID})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to favorite issue: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(fav)
+			return
+		}
+		output.Success(fmt.Sprintf("Added %s to favorites", issue.Identifier), plaintext, jsonOut)
+	},
+}
+
+var issueUnfavoriteCmd = &cobra.Command{
+	Use:   "unfavorite ISSUE-ID",
+	Short: "Remove an issue from your favorites",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		issue, err := client.GetIssue(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Issue '%s' not found", args[0]), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		removeFavorite(client, issue.Identifier, func(f api.Favorite) bool {
+			return f.Issue != nil && f.Issue.ID == issue.ID
+		}, plaintext, jsonOut)
+	},
+}
+
+var projectFavoriteCmd = &cobra.Command{
+	Use:   "favorite PROJECT-ID",
+	Short: "Add a project to your favorites",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		fav, err := client.CreateFavorite(context.Background(), map[string]interface{}{"projectId": args[0]})
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to favorite project: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(fav)
+			return
+		}
+		name := args[0]
+		if fav.Project != nil && fav.Project.Name != "" {
+			name = fav.Project.Name
+		}
+		output.Success(fmt.Sprintf("Added project %s to favorites", name), plaintext, jsonOut)
+	},
+}
+
+var projectUnfavoriteCmd = &cobra.Command{
+	Use:   "unfavorite PROJECT-ID",
+	Short: "Remove a project from your favorites",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := api.NewClient(authHeader)
+
+		projectID := args[0]
+		removeFavorite(client, "project "+projectID, func(f api.Favorite) bool {
+			return f.Project != nil && f.Project.ID == projectID
+		}, plaintext, jsonOut)
+	},
+}
+
+// getAllFavorites pages through the viewer's favorites.
+func getAllFavorites(ctx context.Context, client *api.Client) ([]api.Favorite, error) {
+	var all []api.Favorite
+	after := ""
+	for {
+		page, err := client.GetFavorites(ctx, 100, after)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			break
+		}
+		after = page.PageInfo.EndCursor
+	}
+	return all, nil
+}
+
+// removeFavorite finds the favorite matching match and deletes it.
+func removeFavorite(client *api.Client, label string, match func(api.Favorite) bool, plaintext, jsonOut bool) {
+	favorites, err := getAllFavorites(context.Background(), client)
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to list favorites: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+
+	for _, fav := range favorites {
+		if !match(fav) {
+			continue
+		}
+		if err := client.DeleteFavorite(context.Background(), fav.ID); err != nil {
+			output.Error(fmt.Sprintf("Failed to remove favorite: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		output.Success(fmt.Sprintf("Removed %s from favorites", label), plaintext, jsonOut)
+		return
+	}
+
+	output.Error(fmt.Sprintf("%s is not in your favorites", label), plaintext, jsonOut)
+	os.Exit(1)
+}
+
+// favoriteDisplay returns a human-readable name and identifier for a favorite.
+func favoriteDisplay(fav api.Favorite) (string, string) {
+	switch {
+	case fav.Issue != nil:
+		return fav.Issue.Title, fav.Issue.Identifier
+	case fav.Project != nil:
+		return fav.Project.Name, fav.Project.ID
+	case fav.Cycle != nil:
+		name := fav.Cycle.Name
+		if name == "" {
+			name = fmt.Sprintf("Cycle %d", fav.Cycle.Number)
+		}
+		return name, fav.Cycle.ID
+	case fav.Label != nil:
+		return fav.Label.Name, fav.Label.ID
+	}
+	return "", fav.ID
+}
+
+func init() {
+	rootCmd.AddCommand(favoritesCmd)
+	favoritesCmd.AddCommand(favoritesListCmd)
+
+	issueCmd.AddCommand(issueFavoriteCmd)
+	issueCmd.AddCommand(issueUnfavoriteCmd)
+	projectCmd.AddCommand(projectFavoriteCmd)
+	projectCmd.AddCommand(projectUnfavoriteCmd)
+}
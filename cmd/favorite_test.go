@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func TestFavoriteDisplay(t *testing.T) {
+	cases := []struct {
+		name     string
+		fav      api.Favorite
+		wantName string
+		wantID   string
+	}{
+		{
+			name:     "issue",
+			fav:      api.Favorite{ID: "f1", Type: "issue", Issue: &api.Issue{Identifier: "LIN-1", Title: "Fix login"}},
+			wantName: "Fix login",
+			wantID:   "LIN-1",
+		},
+		{
+			name:     "project",
+			fav:      api.Favorite{ID: "f2", Type: "project", Project: &api.Project{ID: "p1", Name: "Roadmap"}},
+			wantName: "Roadmap",
+			wantID:   "p1",
+		},
+		{
+			name:     "unnamed cycle",
+			fav:      api.Favorite{ID: "f3", Type: "cycle", Cycle: &api.Cycle{ID: "c1", Number: 7}},
+			wantName: "Cycle 7",
+			wantID:   "c1",
+		},
+		{
+			name:     "unknown type",
+			fav:      api.Favorite{ID: "f4", Type: "customView"},
+			wantName: "",
+			wantID:   "f4",
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			name, id := favoriteDisplay(tc.fav)
+			if name != tc.wantName || id != tc.wantID {
+				t.Errorf("favoriteDisplay() = (%q, %q), want (%q, %q)", name, id, tc.wantName, tc.wantID)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+)
+
+// stdoutIsTTY reports whether stdout is a terminal; tests override it.
+var stdoutIsTTY = func() bool {
+	fd := os.Stdout.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// hyperlinksEnabled resolves --hyperlinks (auto, always or never). Links are
+// never emitted for plaintext, JSON or uncolored output; auto also requires
+// stdout to be a terminal that is known to render them.
+func hyperlinksEnabled(cmd *cobra.Command, plaintext, jsonOut bool) (bool, error) {
+	mode, _ := cmd.Flags().GetString("hyperlinks")
+	switch strings.ToLower(mode) {
+	case "", "auto":
+		return !plaintext && !jsonOut && !color.NoColor && stdoutIsTTY() && output.TerminalSupportsHyperlinks(), nil
+	case "always":
+		return !plaintext && !jsonOut && !color.NoColor, nil
+	case "never":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid --hyperlinks value '%s' (use auto, always or never)", mode)
+}
+
+// maybeHyperlink links text to url when enabled.
+func maybeHyperlink(text, url string, enabled bool) string {
+	if !enabled {
+		return text
+	}
+	return output.Hyperlink(text, url)
+}
@@ -0,0 +1,46 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+)
+
+// idempotencyNamespace prefixes keys before hashing so linctl's derived IDs
+// don't collide with other tools hashing the same strings.
+const idempotencyNamespace = "linctl-issue-create:"
+
+// newUUID returns a random version 4 UUID.
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("crypto/rand failed: %v", err))
+	}
+	return formatUUIDv4(b)
+}
+
+// idempotentIssueID returns the ID to create an issue with. An empty key gets
+// a fresh random UUID, a key that already is a UUID is used as-is, and any
+// other key is hashed so the same key always maps to the same ID.
+func idempotentIssueID(key string) string {
+	key = strings.TrimSpace(key)
+	switch {
+	case key == "":
+		return newUUID()
+	case isValidUUID(key):
+		return strings.ToLower(key)
+	}
+	sum := sha256.Sum256([]byte(idempotencyNamespace + key))
+	var b [16]byte
+	copy(b[:], sum[:16])
+	return formatUUIDv4(b)
+}
+
+// formatUUIDv4 sets the version and variant bits and formats b as a UUID.
+// Linear expects client-supplied IDs in UUID v4 format.
+func formatUUIDv4(b [16]byte) string {
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
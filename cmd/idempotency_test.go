@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func TestIdempotentIssueID(t *testing.T) {
+	a, b := idempotentIssueID(""), idempotentIssueID("")
+	if a == b || !isValidUUID(a) {
+		t.Fatalf("expected distinct random UUIDs, got %s and %s", a, b)
+	}
+
+	key := idempotentIssueID("deploy-2025-06-01")
+	if key != idempotentIssueID("deploy-2025-06-01") {
+		t.Fatal("the same key should always map to the same ID")
+	}
+	if key == idempotentIssueID("deploy-2025-06-02") {
+		t.Fatal("different keys should map to different IDs")
+	}
+	if !isValidUUID(key) || key[14] != '4' || !strings.ContainsRune("89ab", rune(key[19])) {
+		t.Fatalf("derived ID %s is not in UUID v4 format", key)
+	}
+
+	const uuid = "0B7C2F0E-4A7D-4A8E-9D8A-1F2E3D4C5B6A"
+	if got := idempotentIssueID(uuid); got != strings.ToLower(uuid) {
+		t.Fatalf("UUID keys should be used as-is, got %s", got)
+	}
+}
+
+func TestCreateIssueFromSpec_ReturnsIssueCreatedByEarlierAttempt(t *testing.T) {
+	created := map[string]bool{}
+	var creates int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case strings.Contains(body.Query, "TeamByKey"):
+			_, _ = w.Write([]byte(`{"data":{"teams":{"nodes":[{"id":"team-1","key":"ENG"}]}}}`))
+		case strings.Contains(body.Query, "issueCreate"):
+			creates++
+			id := body.Variables["input"].(map[string]any)["id"].(string)
+			if created[id] {
+				_, _ = w.Write([]byte(`{"errors":[{"message":"Entity already exists"}]}`))
+				return
+			}
+			created[id] = true
+			_, _ = w.Write([]byte(`{"data":{"issueCreate":{"issue":{"id":"` + id + `","identifier":"ENG-1"}}}}`))
+		case strings.Contains(body.Query, "query Issue("):
+			id := body.Variables["id"].(string)
+			if !created[id] {
+				_, _ = w.Write([]byte(`{"errors":[{"message":"Entity not found"}]}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"issue":{"id":"` + id + `","identifier":"ENG-1"}}}`))
+		default:
+			_, _ = w.Write([]byte(`{"data":{}}`))
+		}
+	}))
+	defer srv.Close()
+	client := api.NewClientWithURL(srv.URL, "Bearer test")
+
+	spec := issueCreateSpec{Title: "Deploy", Team: "ENG", IdempotencyKey: "deploy-1"}
+	first, existing, err := createIssueFromSpec(context.Background(), client, spec, false, nil)
+	if err != nil || existing {
+		t.Fatalf("first create: %v (existing %v)", err, existing)
+	}
+	again, existing, err := createIssueFromSpec(context.Background(), client, spec, false, nil)
+	if err != nil {
+		t.Fatalf("retried create should return the existing issue, got %v", err)
+	}
+	if !existing {
+		t.Fatal("the retried create must report the issue as existing, not created")
+	}
+	if again.ID != first.ID || again.Identifier != "ENG-1" || creates != 2 || len(created) != 1 {
+		t.Fatalf("expected one issue created once, got %d issues after %d creates (%+v)", len(created), creates, again)
+	}
+
+	// Without a key each run is a new issue, and real failures still surface
+	if _, _, err := createIssueFromSpec(context.Background(), client, issueCreateSpec{Title: "Other", Team: "ENG"}, false, nil); err != nil {
+		t.Fatalf("unkeyed create: %v", err)
+	}
+	if len(created) != 2 {
+		t.Fatalf("expected a second issue without a key, got %d", len(created))
+	}
+}
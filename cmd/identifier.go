@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// identifierPattern accepts the ways people write issue identifiers:
+// "LIN-123", "lin-123", "LIN 123" and "#LIN-123".
+var identifierPattern = regexp.MustCompile(`^#?\s*([A-Za-z][A-Za-z0-9]*)\s*(?:-|\s)\s*([0-9]+)$`)
+
+// normalizeIdentifier turns an issue reference into the canonical TEAM-123
+// form before it is looked up, so formatting differences do not surface as
+// "not found" errors. UUIDs are returned unchanged.
+func normalizeIdentifier(ref string) (string, error) {
+	ref = strings.TrimSpace(ref)
+	if isValidUUID(ref) {
+		return ref, nil
+	}
+	m := identifierPattern.FindStringSubmatch(ref)
+	if m == nil {
+		return "", fmt.Errorf("invalid issue identifier '%s' (expected e.g. ENG-123 or an issue UUID)", ref)
+	}
+	return strings.ToUpper(m[1]) + "-" + m[2], nil
+}
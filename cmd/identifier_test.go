@@ -0,0 +1,27 @@
+package cmd
+
+import "testing"
+
+func TestNormalizeIdentifier(t *testing.T) {
+	valid := map[string]string{
+		"LIN-123":                              "LIN-123",
+		"lin-123":                              "LIN-123",
+		"LIN 123":                              "LIN-123",
+		"#LIN-123":                             "LIN-123",
+		"  #lin 7  ":                           "LIN-7",
+		"Eng2 - 45":                            "ENG2-45",
+		"123e4567-e89b-12d3-a456-426614174000": "123e4567-e89b-12d3-a456-426614174000",
+	}
+	for in, want := range valid {
+		got, err := normalizeIdentifier(in)
+		if err != nil || got != want {
+			t.Errorf("normalizeIdentifier(%q) = %q, %v; want %q", in, got, err, want)
+		}
+	}
+
+	for _, in := range []string{"", "LIN", "123", "LIN-", "LIN-12a", "LIN--123", "fix login"} {
+		if got, err := normalizeIdentifier(in); err == nil {
+			t.Errorf("normalizeIdentifier(%q) = %q; want an error", in, got)
+		}
+	}
+}
@@ -2,11 +2,16 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"regexp"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/raegislabs/linctl/pkg/api"
 	"github.com/raegislabs/linctl/pkg/auth"
@@ -15,8 +20,13 @@ import (
 	"github.com/fatih/color"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
 )
 
+// Injection points for tests
+var newIssueClient = func(authHeader string) *api.Client { return api.NewClient(authHeader) }
+var getIssueAuthHeader = auth.GetAuthHeader
+
 var uuidRegexp = regexp.MustCompile(`^[a-fA-F0-9]{8}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{4}-[a-fA-F0-9]{12}$`)
 
 func isValidUUID(s string) bool { return uuidRegexp.MatchString(s) }
@@ -40,22 +50,106 @@ func isIssueNotFoundErr(err error) bool {
 }
 
 // buildProjectInput normalizes a --project flag value to a GraphQL input value.
+// Values that aren't UUIDs are treated as project names and resolved via
+// resolveProjectByName, scoped to teamKey when it is non-empty.
 // Returns (value, ok, err):
 // - ok=false means no input should be set (flag empty / not provided)
 // - value=nil with ok=true means explicitly unset (unassigned)
 // - value=string (uuid) with ok=true means assign to that project
-func buildProjectInput(projectFlag string) (interface{}, bool, error) {
-	switch strings.TrimSpace(projectFlag) {
+func buildProjectInput(ctx context.Context, client *api.Client, projectFlag, teamKey string) (interface{}, bool, error) {
+	projectFlag = strings.TrimSpace(projectFlag)
+	switch projectFlag {
 	case "":
 		return nil, false, nil
 	case "unassigned":
 		return nil, true, nil
 	default:
-		if !isValidUUID(projectFlag) {
-			return nil, false, fmt.Errorf("Invalid project ID format: %s", projectFlag)
+		if isValidUUID(projectFlag) {
+			return projectFlag, true, nil
+		}
+		id, err := resolveProjectByName(ctx, client, projectFlag, teamKey)
+		if err != nil {
+			return nil, false, err
+		}
+		return id, true, nil
+	}
+}
+
+// isProjectName reports whether a --project value names a project rather than
+// giving its UUID or clearing it.
+func isProjectName(projectFlag string) bool {
+	projectFlag = strings.TrimSpace(projectFlag)
+	return projectFlag != "" && projectFlag != "unassigned" && !isValidUUID(projectFlag)
+}
+
+// teamKeyPattern matches a team key such as ENG or WEB2. Any other --team
+// value is looked up as a team name.
+var teamKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9]*$`)
+
+// resolveTeamKey returns the key of the team ref names, so filters accept
+// "--team Engineering" as well as "--team ENG". Keys are used as given; a
+// name is looked up, and one shared by several teams is an error listing
+// their keys rather than a filter matching all of them.
+func resolveTeamKey(ctx context.Context, client *api.Client, ref string) (string, error) {
+	if teamKeyPattern.MatchString(ref) {
+		return ref, nil
+	}
+	team, err := client.GetTeam(ctx, ref)
+	if err != nil {
+		return "", fmt.Errorf("Failed to find team '%s': %w", ref, err)
+	}
+	return team.Key, nil
+}
+
+// projectLister is the part of the API needed to look projects up by name.
+type projectLister interface {
+	GetProjects(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*api.Projects, error)
+}
+
+// resolveProjectByName returns the ID of the project named name (compared
+// case-insensitively), limited to projects accessible to teamKey when given.
+// It errors with the candidates when the name is ambiguous or only partially
+// matches.
+func resolveProjectByName(ctx context.Context, client projectLister, name, teamKey string) (string, error) {
+	filter := map[string]interface{}{
+		"name": map[string]interface{}{"containsIgnoreCase": name},
+	}
+	if teamKey != "" {
+		filter["accessibleTeams"] = map[string]interface{}{
+			"some": map[string]interface{}{"key": map[string]interface{}{"eq": teamKey}},
+		}
+	}
+	projects, err := client.GetProjects(ctx, filter, 50, "", "")
+	if err != nil {
+		return "", fmt.Errorf("Failed to look up project '%s': %w", name, err)
+	}
+
+	var exact []api.Project
+	var candidates []string
+	for _, p := range projects.Nodes {
+		if strings.EqualFold(p.Name, name) {
+			exact = append(exact, p)
 		}
-		return projectFlag, true, nil
+		candidates = append(candidates, fmt.Sprintf("%s (%s)", p.Name, p.ID))
+	}
+
+	scope := ""
+	if teamKey != "" {
+		scope = fmt.Sprintf(" in team %s", teamKey)
 	}
+	switch {
+	case len(exact) == 1:
+		return exact[0].ID, nil
+	case len(exact) > 1:
+		var ambiguous []string
+		for _, p := range exact {
+			ambiguous = append(ambiguous, fmt.Sprintf("%s (%s)", p.Name, p.ID))
+		}
+		return "", fmt.Errorf("Project name '%s' is ambiguous%s; use one of the IDs: %s", name, scope, strings.Join(ambiguous, ", "))
+	case len(candidates) > 0:
+		return "", fmt.Errorf("Project '%s' not found%s. Did you mean: %s", name, scope, strings.Join(candidates, ", "))
+	}
+	return "", fmt.Errorf("Project '%s' not found%s", name, scope)
 }
 
 // levenshtein computes the Levenshtein distance between two strings.
@@ -126,10 +220,14 @@ func closestMatches(target string, candidates []string, k int) []string {
 	return out
 }
 
-// lookupIssueLabelIDsByNames looks up issue label IDs from comma-separated names.
+// labelSource fetches every label of one kind, e.g. client.GetIssueLabels.
+type labelSource func(ctx context.Context) (*api.Labels, error)
+
+// lookupLabelIDsByNames looks up label IDs from comma-separated names, using
+// source for the labels and kind ("issue" or "project") in messages.
 // - Trims whitespace, deduplicates case-insensitively
 // - Returns helpful error with up to 3 closest matches for unknown labels
-func lookupIssueLabelIDsByNames(ctx context.Context, client *api.Client, names string) ([]string, error) {
+func lookupLabelIDsByNames(ctx context.Context, kind string, source labelSource, names string) ([]string, error) {
 	if strings.TrimSpace(names) == "" {
 		return []string{}, nil
 	}
@@ -151,9 +249,9 @@ func lookupIssueLabelIDsByNames(ctx context.Context, client *api.Client, names s
 		cleaned = append(cleaned, t)
 	}
 
-	labels, err := client.GetIssueLabels(ctx)
+	labels, err := source(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get issue labels: %v", err)
+		return nil, fmt.Errorf("failed to get %s labels: %w", kind, err)
 	}
 	nameToID := make(map[string]string, len(labels.Nodes))
 	allNames := make([]string, 0, len(labels.Nodes))
@@ -170,9 +268,9 @@ func lookupIssueLabelIDsByNames(ctx context.Context, client *api.Client, names s
 			// Build suggestions list
 			sug := closestMatches(n, allNames, 3)
 			if len(sug) > 0 {
-				return nil, fmt.Errorf("issue label not found: '%s' (did you mean: %s)", n, strings.Join(sug, ", "))
+				return nil, fmt.Errorf("%s label not found: '%s' (did you mean: %s)", kind, n, strings.Join(sug, ", "))
 			}
-			return nil, fmt.Errorf("issue label not found: '%s'", n)
+			return nil, fmt.Errorf("%s label not found: '%s'", kind, n)
 		}
 		ids = append(ids, id)
 	}
@@ -190,6 +288,7 @@ Examples:
   linctl issue ls -a me -s "In Progress"
   linctl issue list --include-completed  # Show all issues including completed
   linctl issue list --newer-than 3_weeks_ago  # Show issues from last 3 weeks
+  linctl issue list --actor me --newer-than 1_week_ago  # Issues you changed this week
   linctl issue search "login bug" --team ENG
   linctl issue get LIN-123
   linctl issue create --title "Bug fix" --team ENG`,
@@ -199,80 +298,270 @@ var issueListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List issues",
-	Long:    `List Linear issues with optional filtering.`,
+	Long: `List Linear issues with optional filtering.
+
+--actor keeps the issues whose history has a change by that user (me or an
+email) within the --newer-than window; the window then applies to when issues
+were last updated rather than created. Every candidate issue's history is
+fetched, one request per issue and four at a time, so on large workspaces
+narrow the window or combine --actor with other filters.
+
+--watch 30s refreshes the list every 30 seconds until interrupted. The output
+is only redrawn when the issues changed since the last refresh. A failed
+refresh is reported on stderr and retried at the next interval; only a
+failure of the first fetch ends the command.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		maxWidth, _ := cmd.Flags().GetString("max-width")
+		widths, err := parseMaxWidths(maxWidth, issueColumnWidths)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		watch, _ := cmd.Flags().GetDuration("watch")
+		if err := validateWatchInterval(watch); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		withTotals, _ := cmd.Flags().GetBool("with-totals")
+		if withTotals && !jsonOut {
+			output.Error("--with-totals requires --json", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if sinceLastRun, _ := cmd.Flags().GetBool("since-last-run"); sinceLastRun && watch > 0 {
+			output.Error("Cannot combine --since-last-run with --watch", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		authHeader, err := getIssueAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-    client := api.NewClient(authHeader)
+    client := newIssueClient(authHeader)
 
     // Build filter from flags (includes optional label/project, label operators)
     filter, requiredAllIDs, anyIDs, notIDs, wantUnlabeled, parentID, wantHasParent, wantNoParent := buildIssueFilter(cmd, client)
+    wantHasComments, wantNoComments := issuePresenceFlags(cmd, "comments")
+    wantHasAttachments, wantNoAttachments := issuePresenceFlags(cmd, "attachments")
+    wantBlocked, _ := cmd.Flags().GetBool("blocked")
+    wantBlocking, _ := cmd.Flags().GetBool("blocking")
+    activeAssigneesOnly, _ := cmd.Flags().GetBool("active-assignees-only")
+    listOpts := api.IssueListOptions{
+        Presence:  wantHasComments || wantNoComments || wantHasAttachments || wantNoAttachments,
+        Relations: wantBlocked || wantBlocking,
+    }
+
+    since, err := startSinceRun(cmd)
+    if err != nil {
+        output.Error(fmt.Sprintf("Failed to read --since-last-run state: %v", err), plaintext, jsonOut)
+        os.Exit(exitCode(err))
+    }
+    if since != nil {
+        since.applyTo(filter)
+    }
 
 		limit, _ := cmd.Flags().GetInt("limit")
-		if limit == 0 {
-			limit = 50
-		}
 
-		// Get sort option
+		// Get sort option. Priority and state aren't API orderings, so
+		// they're applied to the fetched issues.
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				// Use empty string for Linear's default sort
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
-		}
+		orderBy, clientSort, err := parseIssueSort(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+    includeArchived, _ := cmd.Flags().GetBool("include-archived")
+
+    // --actor checks each candidate's history, so the window applies to when
+    // issues were last updated rather than created
+    actorFlag, _ := cmd.Flags().GetString("actor")
+    var actor actorMatcher
+    var actorSince time.Time
+    if actorFlag != "" {
+        actor, err = resolveHistoryActor(context.Background(), client, actorFlag)
+        if err != nil {
+            output.Error(err.Error(), plaintext, jsonOut)
+            os.Exit(exitCode(err))
+        }
+        actorSince = actorWindow(filter)
+    }
 
-    issues, err := client.GetIssues(context.Background(), filter, limit, "", orderBy)
-    if err != nil {
-        output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
-        os.Exit(1)
+    // Apply post-filters for labels (AND/OR/NOT/unlabeled) and parents page by
+    // page so --limit counts matching issues.
+    load := func() (*api.Issues, error) {
+        var historyErr error
+        progress := output.NewProgress(plaintext, jsonOut)
+        defer progress.Stop()
+        issues, err := fetchMatchingIssues(progress, limit, func(first int, after string) (*api.Issues, error) {
+            if historyErr != nil {
+                return nil, historyErr
+            }
+            return client.GetIssues(context.Background(), filter, first, after, orderBy, includeArchived, listOpts)
+        }, func(page *api.Issues) *api.Issues {
+            page = filterIssuesAdvanced(page, requiredAllIDs, anyIDs, notIDs, wantUnlabeled)
+            page = filterIssuesByParent(page, parentID, wantHasParent, wantNoParent)
+            page = filterIssuesByComments(page, wantHasComments, wantNoComments)
+            page = filterIssuesByAttachments(page, wantHasAttachments, wantNoAttachments)
+            page = filterIssuesByBlocking(page, wantBlocked, wantBlocking)
+            page = filterIssuesByActiveAssignee(page, activeAssigneesOnly)
+            if actorFlag == "" {
+                return page
+            }
+            filtered, err := filterIssuesByActor(context.Background(), client, page, actor, actorSince)
+            if err != nil {
+                historyErr = fmt.Errorf("Failed to fetch issue history: %w", err)
+                return &api.Issues{}
+            }
+            return filtered
+        })
+        if historyErr != nil {
+            return nil, historyErr
+        }
+        if err != nil {
+            return nil, fmt.Errorf("Failed to fetch issues: %w", err)
+        }
+
+        sortIssues(issues.Nodes, clientSort)
+        return issues, nil
     }
 
-    // Apply post-filters for labels (AND/OR/NOT/unlabeled)
-    issues = filterIssuesAdvanced(issues, requiredAllIDs, anyIDs, notIDs, wantUnlabeled)
-    issues = filterIssuesByParent(issues, parentID, wantHasParent, wantNoParent)
+    plaintextTable, _ := cmd.Flags().GetBool("plaintext-table")
+    showAge, _ := cmd.Flags().GetBool("show-age")
+    enrich, _ := cmd.Flags().GetBool("enrich")
+    render := func(issues *api.Issues) {
+        renderIssueCollection(issues, plaintext, jsonOut, issueCollectionOptions{
+            emptyMessage:   "No issues found",
+            summaryLabel:   "issues",
+            plaintextTitle: "# Issues",
+            plaintextTable: plaintextTable,
+            showAge:        showAge,
+            enrich:         enrich,
+            withTotals:     withTotals,
+            widths:         widths,
+        })
+    }
 
-    renderIssueCollection(issues, plaintext, jsonOut, "No issues found", "issues", "# Issues")
+    if watch == 0 {
+        issues, err := load()
+        if err != nil {
+            output.Error(err.Error(), plaintext, jsonOut)
+            os.Exit(exitCode(err))
+        }
+        render(issues)
+        if since != nil {
+            updated := make([]time.Time, 0, len(issues.Nodes))
+            for _, issue := range issues.Nodes {
+                updated = append(updated, issue.UpdatedAt)
+            }
+            finishSinceRun(since, updated, issues.PageInfo.HasNextPage)
+        }
+        return
+    }
+    err = watchIssues(watch, nil, load, func(issues *api.Issues) {
+        // Redraw in place on a terminal; elsewhere each change is appended.
+        if stdoutIsTTY() && !plaintext && !jsonOut {
+            fmt.Print("\x1b[H\x1b[2J")
+        }
+        render(issues)
+        output.Hint(fmt.Sprintf("Refreshing every %s; updated %s. Press Ctrl+C to stop.", watch, time.Now().Format("15:04:05")))
+    }, func(err error) {
+        fmt.Fprintf(os.Stderr, "Warning: refresh at %s failed, retrying in %s: %v\n", time.Now().Format("15:04:05"), watch, err)
+    })
+    if err != nil {
+        output.Error(err.Error(), plaintext, jsonOut)
+        os.Exit(exitCode(err))
+    }
 },
 }
 
-func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMessage, summaryLabel, plaintextTitle string) {
-	if len(issues.Nodes) == 0 {
-		output.Info(emptyMessage, plaintext, jsonOut)
-		return
+// parseIssueSort maps a --sort value to an API orderBy or, for orderings the
+// API lacks, a client-side sort for sortIssues.
+func parseIssueSort(sortBy string) (orderBy, clientSort string, err error) {
+	switch sortBy {
+	case "", "linear":
+		// Use empty string for Linear's default sort
+		return "", "", nil
+	case "created", "createdAt":
+		return "createdAt", "", nil
+	case "updated", "updatedAt":
+		return "updatedAt", "", nil
+	case "priority", "state":
+		return "", sortBy, nil
 	}
+	return "", "", fmt.Errorf("Invalid sort option: %s. Valid options are: linear, created, updated, priority, state", sortBy)
+}
+
+// issueCollectionOptions controls how renderIssueCollection presents a list.
+type issueCollectionOptions struct {
+	emptyMessage   string
+	summaryLabel   string
+	plaintextTitle string
+	plaintextTable bool         // plaintext as a Markdown table instead of blocks
+	highlight      string       // search query whose terms are highlighted in titles
+	showAge        bool         // add an Age column next to Created
+	enrich         bool         // add derived fields to --json output
+	withTotals     bool         // wrap --json output as {nodes, totals}
+	widths         columnWidths // rich table column limits; nil uses issueColumnWidths
+}
 
+// renderIssueCollection prints issues as JSON, Markdown, CSV, plaintext or a
+// rich table.
+func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, opts issueCollectionOptions) {
+	// JSON consumers always get an array, even when nothing matched.
 	if jsonOut {
-		output.JSON(issues.Nodes)
+		if issues.Nodes == nil {
+			issues.Nodes = []api.Issue{}
+		}
+		var nodes interface{} = issues.Nodes
+		if opts.enrich {
+			nodes = enrichIssues(issues.Nodes, time.Now())
+		}
+		if opts.withTotals {
+			nodes = issueListWithTotals{Nodes: nodes, Totals: summarizeIssues(issues.Nodes)}
+		}
+		output.JSON(nodes)
+		return
+	}
+
+	if output.MarkdownMode() {
+		output.Markdown(issueExportData(issues.Nodes, opts))
+		return
+	}
+
+	if output.CSVMode() {
+		output.CSV(issueExportData(issues.Nodes, opts))
+		return
+	}
+
+	if len(issues.Nodes) == 0 {
+		output.Info(opts.emptyMessage, plaintext, jsonOut)
 		return
 	}
 
+    if plaintext && opts.plaintextTable {
+        output.Markdown(issueExportData(issues.Nodes, opts))
+        fmt.Printf("\nTotal: %d %s\n", len(issues.Nodes), opts.summaryLabel)
+        return
+    }
+
     if plaintext {
-        fmt.Println(plaintextTitle)
+        // User text is escaped so it cannot break the Markdown structure
+        esc := output.EscapeMarkdownInline
+        fmt.Println(opts.plaintextTitle)
         for _, issue := range issues.Nodes {
-            fmt.Printf("## %s\n", issue.Title)
+            fmt.Printf("## %s\n", esc(issue.Title))
             fmt.Printf("- **ID**: %s\n", issue.Identifier)
             if issue.State != nil {
                 fmt.Printf("- **State**: %s\n", issue.State.Name)
             }
             if issue.Assignee != nil {
-                fmt.Printf("- **Assignee**: %s\n", issue.Assignee.Name)
+                fmt.Printf("- **Assignee**: %s\n", esc(issue.Assignee.Name))
             } else {
                 fmt.Printf("- **Assignee**: Unassigned\n")
             }
@@ -280,7 +569,7 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
                 fmt.Printf("- **Team**: %s\n", issue.Team.Key)
             }
             if issue.Project != nil {
-                fmt.Printf("- **Project**: %s\n", issue.Project.Name)
+                fmt.Printf("- **Project**: %s\n", esc(issue.Project.Name))
             }
             if issue.Parent != nil && issue.Parent.Identifier != "" {
                 fmt.Printf("- **Parent**: %s\n", issue.Parent.Identifier)
@@ -289,44 +578,52 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
             if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
                 names := make([]string, 0, len(issue.Labels.Nodes))
                 for _, l := range issue.Labels.Nodes {
-                    names = append(names, l.Name)
+                    names = append(names, esc(l.Name))
                 }
                 fmt.Printf("- **Labels**: %s\n", strings.Join(names, ", "))
             } else {
                 fmt.Printf("- **Labels**: None\n")
             }
             fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02"))
+            if opts.showAge {
+                fmt.Printf("- **Age**: %s\n", utils.FormatAge(issue.CreatedAt, time.Now()))
+            }
             fmt.Printf("- **URL**: %s\n", issue.URL)
             if issue.Description != "" {
-                fmt.Printf("- **Description**: %s\n", issue.Description)
+                fmt.Printf("- **Description**: %s\n", indentListContinuation(output.NestMarkdown(issue.Description, 2)))
             }
             fmt.Println()
         }
-        fmt.Printf("\nTotal: %d %s\n", len(issues.Nodes), summaryLabel)
+        fmt.Printf("\nTotal: %d %s\n", len(issues.Nodes), opts.summaryLabel)
         return
     }
 
     headers := []string{"Title", "State", "Assignee", "Team", "Project", "Parent", "Labels", "Created", "URL"}
 	rows := make([][]string, len(issues.Nodes))
+	widths := opts.widths
+	if widths == nil {
+		widths = issueColumnWidths
+	}
 
 	for i, issue := range issues.Nodes {
 		assignee := "Unassigned"
 		if issue.Assignee != nil {
 			assignee = issue.Assignee.Name
 		}
+		assignee = widths.truncate("assignee", assignee)
 
 		team := ""
 		if issue.Team != nil {
-			team = issue.Team.Key
+			team = widths.truncate("team", issue.Team.Key)
 		}
 
         project := ""
         if issue.Project != nil {
-            project = truncateString(issue.Project.Name, 25)
+            project = widths.truncate("project", issue.Project.Name)
         }
 
         // Build labels string: up to 3 labels, comma-separated
-        labels := "-"
+        labels := ""
         if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
             count := len(issue.Labels.Nodes)
             max := 3
@@ -342,52 +639,34 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
                 // Indicate more labels exist; still truncate to fit table
                 labels = labels + fmt.Sprintf(" +%d", count-max)
             }
-            labels = truncateString(labels, 25)
+            labels = widths.truncate("labels", labels)
         }
 
         // Parent identifier (if any)
         parent := ""
         if issue.Parent != nil && issue.Parent.Identifier != "" {
-            parent = issue.Parent.Identifier
+            parent = widths.truncate("parent", issue.Parent.Identifier)
         }
 
         state := ""
         if issue.State != nil {
-            state = issue.State.Name
-            var stateColor *color.Color
-			switch issue.State.Type {
-			case "triage":
-				stateColor = color.New(color.FgMagenta)
-			case "backlog":
-				stateColor = color.New(color.FgCyan)
-			case "unstarted":
-				stateColor = color.New(color.FgWhite)
-			case "started":
-				stateColor = color.New(color.FgBlue)
-			case "completed":
-				stateColor = color.New(color.FgGreen)
-			case "canceled":
-				stateColor = color.New(color.FgRed)
-			default:
-				stateColor = color.New(color.FgWhite)
-			}
-			state = stateColor.Sprint(state)
+            state = output.StateLabel(issue.State.Type, widths.truncate("state", issue.State.Name))
 		}
 
 		if issue.Assignee == nil {
-			assignee = color.New(color.FgYellow).Sprint(assignee)
+			assignee = output.Color(output.RoleWarning).Sprint(assignee)
 		}
 
         rows[i] = []string{
-            truncateString(issue.Title, 40),
-            state,
+            highlightTerms(widths.truncate("title", issue.Title), opts.highlight),
+            output.Cell(state),
             assignee,
-            team,
-            project,
-            parent,
-            labels,
-            issue.CreatedAt.Format("2006-01-02"),
-            issue.URL,
+            output.Cell(team),
+            output.Cell(project),
+            output.Cell(parent),
+            output.Cell(labels),
+            widths.truncate("created", issue.CreatedAt.Format("2006-01-02")),
+            widths.truncate("url", issue.URL),
         }
 	}
 
@@ -395,18 +674,306 @@ func renderIssueCollection(issues *api.Issues, plaintext, jsonOut bool, emptyMes
 		Headers: headers,
 		Rows:    rows,
 	}
+	if opts.showAge {
+		tableData = withIssueAgeColumn(tableData, issues.Nodes, time.Now())
+	}
 
 	output.Table(tableData, false, false)
 
-	fmt.Printf("\n%s %d %s\n",
-		color.New(color.FgGreen).Sprint("✓"),
-		len(issues.Nodes),
-		summaryLabel)
+	output.Summary("%d %s", len(issues.Nodes), opts.summaryLabel)
 
 	if issues.PageInfo.HasNextPage {
-		fmt.Printf("%s Use --limit to see more results\n",
-			color.New(color.FgYellow).Sprint("ℹ️"))
+		output.Hint("Use --limit to see more results")
+	}
+}
+
+// highlightTerms emphasises each word and quoted phrase of query found in s,
+// case-insensitively. Colors are skipped automatically when disabled.
+func highlightTerms(s, query string) string {
+	if query == "" || color.NoColor {
+		return s
+	}
+	var terms []string
+	for _, term := range parseSearchTerms(query) {
+		if !term.qualifier {
+			terms = append(terms, regexp.QuoteMeta(term.text))
+		}
+	}
+	if len(terms) == 0 {
+		return s
+	}
+	// Longest first so "login" wins over "log" when both are terms.
+	sort.Slice(terms, func(i, j int) bool { return len(terms[i]) > len(terms[j]) })
+	re := regexp.MustCompile("(?i)" + strings.Join(terms, "|"))
+	emphasis := color.New(color.Bold, color.Underline)
+	return re.ReplaceAllStringFunc(s, func(m string) string { return emphasis.Sprint(m) })
+}
+
+// searchQueryFromArgs joins the arguments of issue search into the query sent
+// to Linear, keeping whitespace as typed. The shell has already removed the
+// quotes around each argument, so when there are several, the ones with
+// spaces are quoted again to keep them together as phrases.
+func searchQueryFromArgs(args []string) (string, error) {
+	query := ""
+	if len(args) == 1 {
+		query = strings.TrimSpace(args[0])
+	} else {
+		parts := make([]string, 0, len(args))
+		for _, arg := range args {
+			arg = strings.TrimSpace(arg)
+			if strings.ContainsAny(arg, " \t") && !strings.Contains(arg, `"`) {
+				arg = `"` + arg + `"`
+			}
+			if arg != "" {
+				parts = append(parts, arg)
+			}
+		}
+		query = strings.Join(parts, " ")
+	}
+	if query == "" {
+		return "", fmt.Errorf("search query is required")
+	}
+	if strings.Count(query, `"`)%2 != 0 {
+		return "", fmt.Errorf("unbalanced quote in search query: %s", query)
+	}
+	return query, nil
+}
+
+// searchTerm is one part of a search query: a word, a "quoted phrase" or a
+// field:value qualifier.
+type searchTerm struct {
+	text      string
+	phrase    bool
+	qualifier bool
+}
+
+// searchQualifierRegexp matches field:value terms, optionally negated and
+// with a quoted value, e.g. label:bug, -state:done or title:"a b". Only those
+// naming one of searchQualifierFields are qualifiers; anything else with a
+// colon (a URL, TypeError:foo, ENG:123) is an ordinary word.
+var searchQualifierRegexp = regexp.MustCompile(`^-?([A-Za-z][\w-]*):\S.*$`)
+
+// searchQualifierFields are the field names Linear's search accepts in
+// qualifiers. Keep the list in the issue search help in sync.
+var searchQualifierFields = map[string]bool{
+	"assignee": true, "creator": true, "cycle": true, "description": true,
+	"estimate": true, "has": true, "is": true, "label": true, "no": true,
+	"parent": true, "priority": true, "project": true, "state": true,
+	"status": true, "team": true, "title": true,
+}
+
+// isSearchQualifier reports whether raw is a field:value qualifier.
+func isSearchQualifier(raw string) bool {
+	m := searchQualifierRegexp.FindStringSubmatch(raw)
+	return m != nil && searchQualifierFields[strings.ToLower(m[1])]
+}
+
+// parseSearchTerms splits query into words, quoted phrases (whitespace
+// inside the quotes kept) and qualifiers. Qualifiers go to Linear as typed;
+// linctl only uses the words and phrases to highlight and filter by --in.
+func parseSearchTerms(query string) []searchTerm {
+	var terms []string
+	var current strings.Builder
+	inQuotes := false
+	flush := func() {
+		if current.Len() > 0 {
+			terms = append(terms, current.String())
+			current.Reset()
+		}
+	}
+	for _, r := range query {
+		switch {
+		case r == '"':
+			inQuotes = !inQuotes
+			current.WriteRune(r)
+		case !inQuotes && unicode.IsSpace(r):
+			flush()
+		default:
+			current.WriteRune(r)
+		}
+	}
+	flush()
+
+	out := make([]searchTerm, 0, len(terms))
+	for _, raw := range terms {
+		switch {
+		case isSearchQualifier(raw):
+			out = append(out, searchTerm{text: raw, qualifier: true})
+		case strings.HasPrefix(raw, `"`):
+			if text := strings.Trim(raw, `"`); strings.TrimSpace(text) != "" {
+				out = append(out, searchTerm{text: text, phrase: true})
+			}
+		default:
+			if text := strings.Trim(raw, `"'`); text != "" {
+				out = append(out, searchTerm{text: text})
+			}
+		}
 	}
+	return out
+}
+
+// searchScope records which issue fields `issue search --in` should match.
+type searchScope struct {
+	title       bool
+	description bool
+	comments    bool
+}
+
+// defaultSearchScope mirrors what Linear's search matches without options.
+const defaultSearchScope = "title,description"
+
+// parseSearchScope parses a comma-separated --in value. An empty value means
+// defaultSearchScope.
+func parseSearchScope(in string) (searchScope, error) {
+	if strings.TrimSpace(in) == "" {
+		in = defaultSearchScope
+	}
+	var scope searchScope
+	for _, field := range strings.Split(in, ",") {
+		switch strings.ToLower(strings.TrimSpace(field)) {
+		case "title":
+			scope.title = true
+		case "description":
+			scope.description = true
+		case "comments":
+			scope.comments = true
+		case "":
+		default:
+			return scope, fmt.Errorf("invalid --in field '%s' (valid: title, description, comments)", strings.TrimSpace(field))
+		}
+	}
+	if !scope.title && !scope.description && !scope.comments {
+		return scope, fmt.Errorf("--in needs at least one of title, description, comments")
+	}
+	return scope, nil
+}
+
+// filterIssuesBySearchScope drops results that only matched outside the
+// requested scope. Linear always matches titles and descriptions, so when one
+// of them is excluded every query term must appear in an included field.
+// With comments in scope, the comments of an issue whose fields don't match
+// are fetched with comments and searched too.
+func filterIssuesBySearchScope(issues *api.Issues, query string, scope searchScope, comments func(issueID string) ([]api.Comment, error)) (*api.Issues, error) {
+	if issues == nil || (scope.title && scope.description) {
+		return issues, nil
+	}
+	terms := parseSearchTerms(strings.ToLower(query))
+	out := make([]api.Issue, 0, len(issues.Nodes))
+	for _, is := range issues.Nodes {
+		var text string
+		if scope.title {
+			text += strings.ToLower(is.Title) + "\n"
+		}
+		if scope.description {
+			text += strings.ToLower(is.Description) + "\n"
+		}
+		matched := containsSearchTerms(text, terms)
+		if !matched && scope.comments {
+			issueComments, err := comments(is.ID)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", is.Identifier, err)
+			}
+			for _, c := range issueComments {
+				text += strings.ToLower(c.Body) + "\n"
+			}
+			matched = containsSearchTerms(text, terms)
+		}
+		if matched {
+			out = append(out, is)
+		}
+	}
+	filtered := *issues
+	filtered.Nodes = out
+	return &filtered, nil
+}
+
+// containsSearchTerms reports whether text holds every word and phrase of
+// terms; qualifiers are left to Linear.
+func containsSearchTerms(text string, terms []searchTerm) bool {
+	for _, term := range terms {
+		if !term.qualifier && !strings.Contains(text, term.text) {
+			return false
+		}
+	}
+	return true
+}
+
+// issueExportData is issueExportTableData with the optional columns selected
+// by opts.
+func issueExportData(issues []api.Issue, opts issueCollectionOptions) output.TableData {
+	data := issueExportTableData(issues)
+	if opts.showAge {
+		data = withIssueAgeColumn(data, issues, time.Now())
+	}
+	return data
+}
+
+// withIssueAgeColumn inserts an Age column after Created. Rows must be in the
+// same order as issues.
+func withIssueAgeColumn(data output.TableData, issues []api.Issue, now time.Time) output.TableData {
+	at := len(data.Headers)
+	for i, h := range data.Headers {
+		if h == "Created" {
+			at = i + 1
+			break
+		}
+	}
+	insert := func(row []string, cell string) []string {
+		out := make([]string, 0, len(row)+1)
+		out = append(out, row[:at]...)
+		out = append(out, cell)
+		return append(out, row[at:]...)
+	}
+	data.Headers = insert(data.Headers, "Age")
+	for i := range data.Rows {
+		data.Rows[i] = insert(data.Rows[i], utils.FormatAge(issues[i].CreatedAt, now))
+	}
+	return data
+}
+
+// issueExportTableData builds an uncolored, untruncated table of issues for
+// Markdown and CSV output.
+func issueExportTableData(issues []api.Issue) output.TableData {
+	data := output.TableData{
+		Headers: []string{"ID", "Title", "State", "Assignee", "Team", "Project", "Parent", "Labels", "Created", "URL"},
+	}
+	for _, issue := range issues {
+		state, assignee, team, project, parent := "", "Unassigned", "", "", ""
+		if issue.State != nil {
+			state = issue.State.Name
+		}
+		if issue.Assignee != nil {
+			assignee = issue.Assignee.Name
+		}
+		if issue.Team != nil {
+			team = issue.Team.Key
+		}
+		if issue.Project != nil {
+			project = issue.Project.Name
+		}
+		if issue.Parent != nil {
+			parent = issue.Parent.Identifier
+		}
+		var labels []string
+		if issue.Labels != nil {
+			for _, l := range issue.Labels.Nodes {
+				labels = append(labels, l.Name)
+			}
+		}
+		data.Rows = append(data.Rows, []string{
+			issue.Identifier,
+			issue.Title,
+			state,
+			assignee,
+			team,
+			project,
+			parent,
+			strings.Join(labels, ", "),
+			issue.CreatedAt.Format("2006-01-02"),
+			issue.URL,
+		})
+	}
+	return data
 }
 
 var issueSearchCmd = &cobra.Command{
@@ -415,8 +982,28 @@ var issueSearchCmd = &cobra.Command{
 	Short:   "Search issues by keyword",
 	Long: `Perform a full-text search across Linear issues.
 
+By default titles and descriptions are searched. Use --in to narrow the scope
+(e.g. --in title) or to also match comment bodies (--in title,description,comments).
+When a scope with comments leaves out a field, the comments of issues that
+only matched in that field are fetched to check them.
+
+The query is sent to Linear as typed:
+  - "exact phrase" in double quotes stays one phrase, spaces included; quote
+    it for the shell too: '"exact phrase"'. When the query is given as several
+    arguments, an argument containing spaces is treated as a phrase.
+  - field:value qualifiers (e.g. label:bug, -state:done) are passed through
+    unchanged; which ones match anything is up to Linear's search. --in and
+    result highlighting ignore them. The fields are assignee, creator, cycle,
+    description, estimate, has, is, label, no, parent, priority, project,
+    state, status, team and title; any other word with a colon, such as a
+    URL or ENG:123, is searched for as text.
+  - Quotes must be balanced.
+
 Examples:
   linctl issue search "payment outage"
+  linctl issue search '"exact phrase"' --team ENG
+  linctl issue search "timeout" --in title
+  linctl issue search "repro steps" --in title,description,comments
   linctl issue search "auth token" --team ENG --include-completed
   linctl issue search "customer:" --json`,
 	Args: cobra.MinimumNArgs(1),
@@ -424,21 +1011,31 @@ Examples:
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		query := strings.TrimSpace(strings.Join(args, " "))
-		if query == "" {
-			output.Error("Search query is required", plaintext, jsonOut)
-			os.Exit(1)
+		query, err := searchQueryFromArgs(args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		maxWidth, _ := cmd.Flags().GetString("max-width")
+		widths, err := parseMaxWidths(maxWidth, issueColumnWidths)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 
-		authHeader, err := auth.GetAuthHeader()
+		authHeader, err := getIssueAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-    client := api.NewClient(authHeader)
+    client := newIssueClient(authHeader)
 
     filter, requiredAllIDs, anyIDs, notIDs, wantUnlabeled, parentID, wantHasParent, wantNoParent := buildIssueFilter(cmd, client)
+    wantHasComments, wantNoComments := issuePresenceFlags(cmd, "comments")
+    wantHasAttachments, wantNoAttachments := issuePresenceFlags(cmd, "attachments")
+    listOpts := api.IssueListOptions{Presence: wantHasComments || wantNoComments || wantHasAttachments || wantNoAttachments}
 
 		limit, _ := cmd.Flags().GetInt("limit")
 		if limit == 0 {
@@ -446,35 +1043,75 @@ Examples:
 		}
 
 		sortBy, _ := cmd.Flags().GetString("sort")
-		orderBy := ""
-		if sortBy != "" {
-			switch sortBy {
-			case "created", "createdAt":
-				orderBy = "createdAt"
-			case "updated", "updatedAt":
-				orderBy = "updatedAt"
-			case "linear":
-				orderBy = ""
-			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
-				os.Exit(1)
-			}
+		orderBy, clientSort, err := parseIssueSort(sortBy)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 
 		includeArchived, _ := cmd.Flags().GetBool("include-archived")
 
-    issues, err := client.IssueSearch(context.Background(), query, filter, limit, "", orderBy, includeArchived)
+		in, _ := cmd.Flags().GetString("in")
+		scope, err := parseSearchScope(in)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+    // Apply post-filters for labels (AND/OR/NOT/unlabeled), parents and search
+    // scope page by page so --limit counts matching issues.
+    var commentsErr error
+    issueComments := func(issueID string) ([]api.Comment, error) {
+        comments, _, err := fetchAllPages(nil, func(first int, after string) ([]api.Comment, api.PageInfo, error) {
+            page, err := client.GetIssueComments(context.Background(), issueID, first, after, "createdAt")
+            if err != nil {
+                return nil, api.PageInfo{}, err
+            }
+            return page.Nodes, page.PageInfo, nil
+        })
+        return comments, err
+    }
+    progress := output.NewProgress(plaintext, jsonOut)
+    issues, err := fetchMatchingIssues(progress, limit, func(first int, after string) (*api.Issues, error) {
+        if commentsErr != nil {
+            return nil, commentsErr
+        }
+        return client.IssueSearch(context.Background(), query, filter, first, after, orderBy, includeArchived, scope.comments, listOpts)
+    }, func(page *api.Issues) *api.Issues {
+        page = filterIssuesAdvanced(page, requiredAllIDs, anyIDs, notIDs, wantUnlabeled)
+        page = filterIssuesByParent(page, parentID, wantHasParent, wantNoParent)
+        page = filterIssuesByComments(page, wantHasComments, wantNoComments)
+        page = filterIssuesByAttachments(page, wantHasAttachments, wantNoAttachments)
+        filtered, err := filterIssuesBySearchScope(page, query, scope, issueComments)
+        if err != nil {
+            commentsErr = fmt.Errorf("failed to fetch comments: %w", err)
+            return &api.Issues{}
+        }
+        return filtered
+    })
+    progress.Stop()
+    if commentsErr != nil {
+        err = commentsErr
+    }
     if err != nil {
         output.Error(fmt.Sprintf("Failed to search issues: %v", err), plaintext, jsonOut)
-        os.Exit(1)
+        os.Exit(exitCode(err))
     }
 
-    // Apply post-filters for labels (AND/OR/NOT/unlabeled)
-    issues = filterIssuesAdvanced(issues, requiredAllIDs, anyIDs, notIDs, wantUnlabeled)
-    issues = filterIssuesByParent(issues, parentID, wantHasParent, wantNoParent)
+    sortIssues(issues.Nodes, clientSort)
 
     emptyMsg := fmt.Sprintf("No matches found for %q", query)
-    renderIssueCollection(issues, plaintext, jsonOut, emptyMsg, "matches", "# Search Results")
+    plaintextTable, _ := cmd.Flags().GetBool("plaintext-table")
+    enrich, _ := cmd.Flags().GetBool("enrich")
+    renderIssueCollection(issues, plaintext, jsonOut, issueCollectionOptions{
+        emptyMessage:   emptyMsg,
+        summaryLabel:   "matches",
+        plaintextTitle: "# Search Results",
+        plaintextTable: plaintextTable,
+        highlight:      query,
+        enrich:         enrich,
+        widths:         widths,
+    })
 },
 }
 
@@ -482,132 +1119,205 @@ var issueGetCmd = &cobra.Command{
 	Use:     "get [issue-id]",
 	Aliases: []string{"show"},
 	Short:   "Get issue details",
-	Long:    `Get detailed information about a specific issue.`,
-	Args:    cobra.ExactArgs(1),
+	Long: `Get detailed information about a specific issue.
+
+By default only the most recent comments and history entries are shown.
+Use --comments and --history to fetch the complete thread and history.
+
+Without an issue ID, the identifier in the current git branch is used when
+running in an interactive terminal; scripts and CI must pass --from-branch.
+
+With --json, --enrich adds fields computed by linctl: isOverdue (the due
+date has passed and the issue is neither completed nor canceled), ageDays
+(whole days since creation) and assigneeEmail ("" when unassigned).
+
+Examples:
+  linctl issue get LIN-123
+  linctl issue get LIN-123 --comments --history
+  linctl issue get LIN-123 --json --enrich
+  linctl issue get                 # In a terminal: the issue named in the git branch
+  linctl issue get --from-branch   # Same, also in scripts and CI`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		issueID, err := issueArgOrBranch(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		links, err := hyperlinksEnabled(cmd, plaintext, jsonOut)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		includeSections, _ := cmd.Flags().GetString("sections")
+		excludeSections, _ := cmd.Flags().GetString("no-sections")
+		show, err := parseIssueSections(includeSections, excludeSections)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
 
-		authHeader, err := auth.GetAuthHeader()
+		authHeader, err := getIssueAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-		client := api.NewClient(authHeader)
-		issue, err := client.GetIssue(context.Background(), args[0])
+		client := newIssueClient(authHeader)
+		issue, err := client.GetIssue(context.Background(), issueID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+
+		allComments, _ := cmd.Flags().GetBool("comments")
+		renderMarkdown := markdownRenderingRequested(cmd, plaintext, jsonOut)
+		allHistory, _ := cmd.Flags().GetBool("history")
+		progress := output.NewProgress(plaintext, jsonOut)
+		if allComments {
+			comments, err := fetchAllIssueComments(context.Background(), client, issue.ID, progress)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to fetch comments: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			issue.Comments = comments
+		}
+		if allHistory {
+			history, err := fetchAllIssueHistory(context.Background(), client, issue.ID, progress)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to fetch history: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			issue.History = history
 		}
+		progress.Stop()
+
+		copyFlagResult(cmd, issue.Identifier, issue.URL, jsonOut)
 
 		if jsonOut {
+			if enrich, _ := cmd.Flags().GetBool("enrich"); enrich {
+				output.JSON(enrichIssue(*issue, time.Now()))
+				return
+			}
 			output.JSON(issue)
 			return
 		}
 
+		resolveIssueMentions(context.Background(), client, issue)
+
 		if plaintext {
-			fmt.Printf("# %s - %s\n\n", issue.Identifier, issue.Title)
+			// User text is escaped, and user Markdown nested below the
+			// section headings, so the document structure stays intact
+			esc := output.EscapeMarkdownInline
+			fmt.Printf("# %s - %s\n\n", issue.Identifier, esc(issue.Title))
 
-			if issue.Description != "" {
-				fmt.Printf("## Description\n%s\n\n", issue.Description)
+			if show("description") && issue.Description != "" {
+				fmt.Printf("## Description\n%s\n\n", output.NestMarkdown(issue.Description, 2))
 			}
 
-			fmt.Printf("## Core Details\n")
-			fmt.Printf("- **ID**: %s\n", issue.Identifier)
-			fmt.Printf("- **Number**: %d\n", issue.Number)
-			if issue.State != nil {
-				fmt.Printf("- **State**: %s (%s)\n", issue.State.Name, issue.State.Type)
-				if issue.State.Description != nil && *issue.State.Description != "" {
-					fmt.Printf("  - Description: %s\n", *issue.State.Description)
+			if show("core") {
+				fmt.Printf("## Core Details\n")
+				fmt.Printf("- **ID**: %s\n", issue.Identifier)
+				fmt.Printf("- **Number**: %d\n", issue.Number)
+				if issue.State != nil {
+					fmt.Printf("- **State**: %s (%s)\n", issue.State.Name, issue.State.Type)
+					if issue.State.Description != nil && *issue.State.Description != "" {
+						fmt.Printf("  - Description: %s\n", esc(*issue.State.Description))
+					}
 				}
-			}
-			if issue.Assignee != nil {
-				fmt.Printf("- **Assignee**: %s (%s)\n", issue.Assignee.Name, issue.Assignee.Email)
-				if issue.Assignee.DisplayName != "" && issue.Assignee.DisplayName != issue.Assignee.Name {
-					fmt.Printf("  - Display Name: %s\n", issue.Assignee.DisplayName)
+				if issue.Assignee != nil {
+					fmt.Printf("- **Assignee**: %s (%s)\n", esc(issue.Assignee.Name), issue.Assignee.Email)
+					if issue.Assignee.DisplayName != "" && issue.Assignee.DisplayName != issue.Assignee.Name {
+						fmt.Printf("  - Display Name: %s\n", esc(issue.Assignee.DisplayName))
+					}
+				} else {
+					fmt.Printf("- **Assignee**: Unassigned\n")
 				}
-			} else {
-				fmt.Printf("- **Assignee**: Unassigned\n")
-			}
-			if issue.Creator != nil {
-				fmt.Printf("- **Creator**: %s (%s)\n", issue.Creator.Name, issue.Creator.Email)
-			}
-			if issue.Team != nil {
-				fmt.Printf("- **Team**: %s (%s)\n", issue.Team.Name, issue.Team.Key)
-				if issue.Team.Description != "" {
-					fmt.Printf("  - Description: %s\n", issue.Team.Description)
+				if issue.Creator != nil {
+					fmt.Printf("- **Creator**: %s (%s)\n", esc(issue.Creator.Name), issue.Creator.Email)
+				}
+				if issue.Team != nil {
+					fmt.Printf("- **Team**: %s (%s)\n", esc(issue.Team.Name), issue.Team.Key)
+					if issue.Team.Description != "" {
+						fmt.Printf("  - Description: %s\n", esc(issue.Team.Description))
+					}
+				}
+				fmt.Printf("- **Priority**: %s (%d)\n", priorityToString(issue.Priority), issue.Priority)
+				if issue.PriorityLabel != "" {
+					fmt.Printf("- **Priority Label**: %s\n", issue.PriorityLabel)
+				}
+				if issue.Estimate != nil {
+					fmt.Printf("- **Estimate**: %.1f\n", *issue.Estimate)
 				}
-			}
-			fmt.Printf("- **Priority**: %s (%d)\n", priorityToString(issue.Priority), issue.Priority)
-			if issue.PriorityLabel != "" {
-				fmt.Printf("- **Priority Label**: %s\n", issue.PriorityLabel)
-			}
-			if issue.Estimate != nil {
-				fmt.Printf("- **Estimate**: %.1f\n", *issue.Estimate)
 			}
 
-			fmt.Printf("\n## Status & Dates\n")
-			fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("- **Updated**: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
-			if issue.TriagedAt != nil {
-				fmt.Printf("- **Triaged**: %s\n", issue.TriagedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.CompletedAt != nil {
-				fmt.Printf("- **Completed**: %s\n", issue.CompletedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.CanceledAt != nil {
-				fmt.Printf("- **Canceled**: %s\n", issue.CanceledAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.ArchivedAt != nil {
-				fmt.Printf("- **Archived**: %s\n", issue.ArchivedAt.Format("2006-01-02 15:04:05"))
-			}
-			if issue.DueDate != nil && *issue.DueDate != "" {
-				fmt.Printf("- **Due Date**: %s\n", *issue.DueDate)
-			}
-			if issue.SnoozedUntilAt != nil {
-				fmt.Printf("- **Snoozed Until**: %s\n", issue.SnoozedUntilAt.Format("2006-01-02 15:04:05"))
+			if show("dates") {
+				fmt.Printf("\n## Status & Dates\n")
+				fmt.Printf("- **Created**: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("- **Updated**: %s\n", issue.UpdatedAt.Format("2006-01-02 15:04:05"))
+				if issue.TriagedAt != nil {
+					fmt.Printf("- **Triaged**: %s\n", issue.TriagedAt.Format("2006-01-02 15:04:05"))
+				}
+				if issue.CompletedAt != nil {
+					fmt.Printf("- **Completed**: %s\n", issue.CompletedAt.Format("2006-01-02 15:04:05"))
+				}
+				if issue.CanceledAt != nil {
+					fmt.Printf("- **Canceled**: %s\n", issue.CanceledAt.Format("2006-01-02 15:04:05"))
+				}
+				if issue.ArchivedAt != nil {
+					fmt.Printf("- **Archived**: %s\n", issue.ArchivedAt.Format("2006-01-02 15:04:05"))
+				}
+				if issue.DueDate != nil && *issue.DueDate != "" {
+					fmt.Printf("- **Due Date**: %s\n", *issue.DueDate)
+				}
+				if issue.SnoozedUntilAt != nil {
+					fmt.Printf("- **Snoozed Until**: %s\n", issue.SnoozedUntilAt.Format("2006-01-02 15:04:05"))
+				}
 			}
 
-			fmt.Printf("\n## Technical Details\n")
-			fmt.Printf("- **Board Order**: %.2f\n", issue.BoardOrder)
-			fmt.Printf("- **Sub-Issue Sort Order**: %.2f\n", issue.SubIssueSortOrder)
-			if issue.BranchName != "" {
-				fmt.Printf("- **Git Branch**: %s\n", issue.BranchName)
-			}
-			if issue.CustomerTicketCount > 0 {
-				fmt.Printf("- **Customer Ticket Count**: %d\n", issue.CustomerTicketCount)
-			}
-			if len(issue.PreviousIdentifiers) > 0 {
-				fmt.Printf("- **Previous Identifiers**: %s\n", strings.Join(issue.PreviousIdentifiers, ", "))
-			}
-			if issue.IntegrationSourceType != nil && *issue.IntegrationSourceType != "" {
-				fmt.Printf("- **Integration Source**: %s\n", *issue.IntegrationSourceType)
-			}
-			if issue.ExternalUserCreator != nil {
-				fmt.Printf("- **External Creator**: %s (%s)\n", issue.ExternalUserCreator.Name, issue.ExternalUserCreator.Email)
+			if show("technical") {
+				fmt.Printf("\n## Technical Details\n")
+				fmt.Printf("- **Board Order**: %.2f\n", issue.BoardOrder)
+				fmt.Printf("- **Sub-Issue Sort Order**: %.2f\n", issue.SubIssueSortOrder)
+				if issue.BranchName != "" {
+					fmt.Printf("- **Git Branch**: %s\n", issue.BranchName)
+				}
+				if issue.CustomerTicketCount > 0 {
+					fmt.Printf("- **Customer Ticket Count**: %d\n", issue.CustomerTicketCount)
+				}
+				if len(issue.PreviousIdentifiers) > 0 {
+					fmt.Printf("- **Previous Identifiers**: %s\n", strings.Join(issue.PreviousIdentifiers, ", "))
+				}
+				if issue.IntegrationSourceType != nil && *issue.IntegrationSourceType != "" {
+					fmt.Printf("- **Integration Source**: %s\n", *issue.IntegrationSourceType)
+				}
+				if issue.ExternalUserCreator != nil {
+					fmt.Printf("- **External Creator**: %s (%s)\n", esc(issue.ExternalUserCreator.Name), issue.ExternalUserCreator.Email)
+				}
+				fmt.Printf("- **URL**: %s\n", issue.URL)
 			}
-			fmt.Printf("- **URL**: %s\n", issue.URL)
 
 			// Project and Cycle Info
-			if issue.Project != nil {
+			if show("project") && issue.Project != nil {
 				fmt.Printf("\n## Project\n")
-				fmt.Printf("- **Name**: %s\n", issue.Project.Name)
+				fmt.Printf("- **Name**: %s\n", esc(issue.Project.Name))
 				fmt.Printf("- **State**: %s\n", issue.Project.State)
 				fmt.Printf("- **Progress**: %.0f%%\n", issue.Project.Progress*100)
 				if issue.Project.Health != "" {
 					fmt.Printf("- **Health**: %s\n", issue.Project.Health)
 				}
 				if issue.Project.Description != "" {
-					fmt.Printf("- **Description**: %s\n", issue.Project.Description)
+					fmt.Printf("- **Description**: %s\n", indentListContinuation(output.NestMarkdown(issue.Project.Description, 2)))
 				}
 			}
 
-			if issue.Cycle != nil {
+			if show("cycle") && issue.Cycle != nil {
 				fmt.Printf("\n## Cycle\n")
-				fmt.Printf("- **Name**: %s (#%d)\n", issue.Cycle.Name, issue.Cycle.Number)
+				fmt.Printf("- **Name**: %s (#%d)\n", esc(issue.Cycle.Name), issue.Cycle.Number)
 				if issue.Cycle.Description != nil && *issue.Cycle.Description != "" {
-					fmt.Printf("- **Description**: %s\n", *issue.Cycle.Description)
+					fmt.Printf("- **Description**: %s\n", indentListContinuation(output.NestMarkdown(*issue.Cycle.Description, 2)))
 				}
 				fmt.Printf("- **Period**: %s to %s\n", issue.Cycle.StartsAt, issue.Cycle.EndsAt)
 				fmt.Printf("- **Progress**: %.0f%%\n", issue.Cycle.Progress*100)
@@ -617,19 +1327,19 @@ var issueGetCmd = &cobra.Command{
 			}
 
 			// Labels
-			if issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
+			if show("labels") && issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
 				fmt.Printf("\n## Labels\n")
 				for _, label := range issue.Labels.Nodes {
-					fmt.Printf("- %s", label.Name)
+					fmt.Printf("- %s", esc(label.Name))
 					if label.Description != nil && *label.Description != "" {
-						fmt.Printf(" - %s", *label.Description)
+						fmt.Printf(" - %s", esc(*label.Description))
 					}
 					fmt.Println()
 				}
 			}
 
 			// Subscribers
-			if issue.Subscribers != nil && len(issue.Subscribers.Nodes) > 0 {
+			if show("subscribers") && issue.Subscribers != nil && len(issue.Subscribers.Nodes) > 0 {
 				fmt.Printf("\n## Subscribers\n")
 				for _, subscriber := range issue.Subscribers.Nodes {
 					fmt.Printf("- %s (%s)\n", subscriber.Name, subscriber.Email)
@@ -637,22 +1347,11 @@ var issueGetCmd = &cobra.Command{
 			}
 
 			// Relations
-			if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
+			if show("relations") && issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
 				fmt.Printf("\n## Related Issues\n")
 				for _, relation := range issue.Relations.Nodes {
 					if relation.RelatedIssue != nil {
-						relationType := relation.Type
-						switch relationType {
-						case "blocks":
-							relationType = "Blocks"
-						case "blocked":
-							relationType = "Blocked by"
-						case "related":
-							relationType = "Related to"
-						case "duplicate":
-							relationType = "Duplicate of"
-						}
-						fmt.Printf("- %s: %s - %s", relationType, relation.RelatedIssue.Identifier, relation.RelatedIssue.Title)
+						fmt.Printf("- %s: %s - %s", relationLabel(relation.Type), relation.RelatedIssue.Identifier, esc(relation.RelatedIssue.Title))
 						if relation.RelatedIssue.State != nil {
 							fmt.Printf(" [%s]", relation.RelatedIssue.State.Name)
 						}
@@ -662,25 +1361,30 @@ var issueGetCmd = &cobra.Command{
 			}
 
 			// Reactions
-			if len(issue.Reactions) > 0 {
+			if show("reactions") && len(issue.Reactions) > 0 {
 				fmt.Printf("\n## Reactions\n")
+				// Group by emoji in first-seen order so the output is stable.
+				var emojis []string
 				reactionMap := make(map[string][]string)
 				for _, reaction := range issue.Reactions {
+					if _, seen := reactionMap[reaction.Emoji]; !seen {
+						emojis = append(emojis, reaction.Emoji)
+					}
 					reactionMap[reaction.Emoji] = append(reactionMap[reaction.Emoji], reaction.User.Name)
 				}
-				for emoji, users := range reactionMap {
-					fmt.Printf("- %s: %s\n", emoji, strings.Join(users, ", "))
+				for _, emoji := range emojis {
+					fmt.Printf("- %s: %s\n", emoji, strings.Join(reactionMap[emoji], ", "))
 				}
 			}
 
 			// Show parent issue if this is a sub-issue
-			if issue.Parent != nil {
+			if show("parent") && issue.Parent != nil {
 				fmt.Printf("\n## Parent Issue\n")
-				fmt.Printf("- %s: %s\n", issue.Parent.Identifier, issue.Parent.Title)
+				fmt.Printf("- %s: %s\n", issue.Parent.Identifier, esc(issue.Parent.Title))
 			}
 
 			// Show sub-issues if any
-			if issue.Children != nil && len(issue.Children.Nodes) > 0 {
+			if show("subissues") && issue.Children != nil && len(issue.Children.Nodes) > 0 {
 				fmt.Printf("\n## Sub-issues\n")
 				for _, child := range issue.Children.Nodes {
 					stateStr := ""
@@ -704,71 +1408,64 @@ var issueGetCmd = &cobra.Command{
 						assignee = child.Assignee.Name
 					}
 
-					fmt.Printf("- %s %s: %s (%s)\n", stateStr, child.Identifier, child.Title, assignee)
+					fmt.Printf("- %s %s: %s (%s)\n", stateStr, child.Identifier, esc(child.Title), esc(assignee))
+				}
+			}
+
+			// Show pull requests and commits among the attachments
+			if dev := linkedDevelopment(issue.Attachments); show("development") && len(dev) > 0 {
+				fmt.Printf("\n## Linked Development\n")
+				for _, item := range dev {
+					status := ""
+					if item.Status != "" {
+						status = fmt.Sprintf(" (%s)", item.Status)
+					}
+					fmt.Printf("- %s: [%s](%s)%s\n", item.Kind, esc(item.Title), item.URL, status)
 				}
 			}
 
 			// Show attachments if any
-			if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
+			if show("attachments") && issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
 				fmt.Printf("\n## Attachments\n")
 				for _, attachment := range issue.Attachments.Nodes {
-					fmt.Printf("- [%s](%s)\n", attachment.Title, attachment.URL)
+					fmt.Printf("- [%s](%s) (ID: %s)\n", esc(attachment.Title), attachment.URL, attachment.ID)
 				}
 			}
 
-			// Show recent comments if any
-			if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
-				fmt.Printf("\n## Recent Comments\n")
+			// Show comments if any
+			if show("comments") && issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
+				if allComments {
+					fmt.Printf("\n## Comments (%d)\n", countComments(issue.Comments.Nodes))
+				} else {
+					fmt.Printf("\n## Recent Comments\n")
+				}
 				for _, comment := range issue.Comments.Nodes {
-					fmt.Printf("\n### %s - %s\n", comment.User.Name, comment.CreatedAt.Format("2006-01-02 15:04"))
+					fmt.Printf("\n### %s - %s\n", esc(commentAuthor(comment)), comment.CreatedAt.Format("2006-01-02 15:04"))
 					if comment.EditedAt != nil {
 						fmt.Printf("*(edited %s)*\n", comment.EditedAt.Format("2006-01-02 15:04"))
 					}
-					fmt.Printf("%s\n", comment.Body)
+					fmt.Printf("%s\n", output.NestMarkdown(comment.Body, 3))
 					if comment.Children != nil && len(comment.Children.Nodes) > 0 {
 						for _, reply := range comment.Children.Nodes {
-							fmt.Printf("\n  **Reply from %s**: %s\n", reply.User.Name, reply.Body)
+							fmt.Printf("\n  **Reply from %s**: %s\n", esc(commentAuthor(reply)), indentListContinuation(output.NestMarkdown(reply.Body, 3)))
 						}
 					}
 				}
-				fmt.Printf("\n> Use `linctl comment list %s` to see all comments\n", issue.Identifier)
+				if !allComments {
+					fmt.Printf("\n> Use `linctl issue get %s --comments` to see all comments\n", issue.Identifier)
+				}
 			}
 
 			// Show history
-			if issue.History != nil && len(issue.History.Nodes) > 0 {
-				fmt.Printf("\n## Recent History\n")
+			if show("history") && issue.History != nil && len(issue.History.Nodes) > 0 {
+				if allHistory {
+					fmt.Printf("\n## History (%d)\n", len(issue.History.Nodes))
+				} else {
+					fmt.Printf("\n## Recent History\n")
+				}
 				for _, entry := range issue.History.Nodes {
-					fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), entry.Actor.Name)
-					changes := []string{}
-
-					if entry.FromState != nil && entry.ToState != nil {
-						changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
-					}
-					if entry.FromAssignee != nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
-					} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
-						changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
-					} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
-						changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
-					}
-					if entry.FromPriority != nil && entry.ToPriority != nil {
-						changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
-					}
-					if entry.FromTitle != nil && entry.ToTitle != nil {
-						changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
-					}
-					if entry.FromCycle != nil && entry.ToCycle != nil {
-						changes = append(changes, fmt.Sprintf("Cycle: %s → %s", entry.FromCycle.Name, entry.ToCycle.Name))
-					}
-					if entry.FromProject != nil && entry.ToProject != nil {
-						changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
-					}
-					if len(entry.AddedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
-					}
-					if len(entry.RemovedLabelIds) > 0 {
-						changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
-					}
+					fmt.Printf("\n- **%s** by %s", entry.CreatedAt.Format("2006-01-02 15:04"), historyActor(entry))
+					changes := describeHistoryEntry(entry)
 
 					if len(changes) > 0 {
 						fmt.Printf("\n  - %s", strings.Join(changes, "\n  - "))
@@ -782,14 +1479,14 @@ var issueGetCmd = &cobra.Command{
 
 		// Rich display
 		fmt.Printf("%s %s\n",
-			color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
-			color.New(color.FgWhite, color.Bold).Sprint(issue.Title))
+			output.Color(output.RoleIdentifier).Sprint(maybeHyperlink(issue.Identifier, issue.URL, links)),
+			output.Color(output.RoleTitle).Sprint(issue.Title))
 
 		if issue.Description != "" {
-			fmt.Printf("\n%s\n", issue.Description)
+			fmt.Printf("\n%s\n", maybeRenderMarkdown(issue.Description, renderMarkdown))
 		}
 
-		fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Details:"))
+		fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprint("Details:"))
 
 		if issue.State != nil {
 			stateStr := issue.State.Name
@@ -797,20 +1494,20 @@ var issueGetCmd = &cobra.Command{
 				stateStr += fmt.Sprintf(" (%s)", issue.CompletedAt.Format("2006-01-02"))
 			}
 			fmt.Printf("State: %s\n",
-				color.New(color.FgGreen).Sprint(stateStr))
+				output.Color(output.RoleSuccess).Sprint(stateStr))
 		}
 
 		if issue.Assignee != nil {
 			fmt.Printf("Assignee: %s\n",
-				color.New(color.FgCyan).Sprint(issue.Assignee.Name))
+				output.Color(output.RoleName).Sprint(issue.Assignee.Name))
 		} else {
 			fmt.Printf("Assignee: %s\n",
-				color.New(color.FgRed).Sprint("Unassigned"))
+				output.Color(output.RoleError).Sprint("Unassigned"))
 		}
 
 		if issue.Team != nil {
 			fmt.Printf("Team: %s\n",
-				color.New(color.FgMagenta).Sprint(issue.Team.Name))
+				output.Color(output.RoleAccent).Sprint(issue.Team.Name))
 		}
 
 		fmt.Printf("Priority: %s\n", priorityToString(issue.Priority))
@@ -818,13 +1515,13 @@ var issueGetCmd = &cobra.Command{
 		// Show project and cycle info
 		if issue.Project != nil {
 			fmt.Printf("Project: %s (%s)\n",
-				color.New(color.FgBlue).Sprint(issue.Project.Name),
-				color.New(color.FgWhite, color.Faint).Sprintf("%.0f%%", issue.Project.Progress*100))
+				output.Color(output.RoleProject).Sprint(issue.Project.Name),
+				output.Color(output.RoleMuted).Sprintf("%.0f%%", issue.Project.Progress*100))
 		}
 
 		if issue.Cycle != nil {
 			fmt.Printf("Cycle: %s\n",
-				color.New(color.FgMagenta).Sprint(issue.Cycle.Name))
+				output.Color(output.RoleAccent).Sprint(issue.Cycle.Name))
 		}
 
 		fmt.Printf("Created: %s\n", issue.CreatedAt.Format("2006-01-02 15:04:05"))
@@ -832,47 +1529,47 @@ var issueGetCmd = &cobra.Command{
 
 		if issue.DueDate != nil && *issue.DueDate != "" {
 			fmt.Printf("Due Date: %s\n",
-				color.New(color.FgYellow).Sprint(*issue.DueDate))
+				output.Color(output.RoleWarning).Sprint(*issue.DueDate))
 		}
 
 		if issue.SnoozedUntilAt != nil {
 			fmt.Printf("Snoozed Until: %s\n",
-				color.New(color.FgYellow).Sprint(issue.SnoozedUntilAt.Format("2006-01-02 15:04:05")))
+				output.Color(output.RoleWarning).Sprint(issue.SnoozedUntilAt.Format("2006-01-02 15:04:05")))
 		}
 
 		// Show git branch if available
 		if issue.BranchName != "" {
 			fmt.Printf("Git Branch: %s\n",
-				color.New(color.FgGreen).Sprint(issue.BranchName))
+				output.Color(output.RoleSuccess).Sprint(issue.BranchName))
 		}
 
 		// Show URL
 		if issue.URL != "" {
 			fmt.Printf("URL: %s\n",
-				color.New(color.FgBlue, color.Underline).Sprint(issue.URL))
+				output.Color(output.RoleLink).Sprint(maybeHyperlink(issue.URL, issue.URL, links)))
 		}
 
 		// Show parent issue if this is a sub-issue
 		if issue.Parent != nil {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Parent Issue:"))
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprint("Parent Issue:"))
 			fmt.Printf("  %s %s\n",
-				color.New(color.FgCyan).Sprint(issue.Parent.Identifier),
+				output.Color(output.RoleName).Sprint(maybeHyperlink(issue.Parent.Identifier, issue.Parent.URL, links)),
 				issue.Parent.Title)
 		}
 
 		// Show sub-issues if any
 		if issue.Children != nil && len(issue.Children.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Sub-issues:"))
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprint("Sub-issues:"))
 			for _, child := range issue.Children.Nodes {
 				stateIcon := "○"
 				if child.State != nil {
 					switch child.State.Type {
 					case "completed", "done":
-						stateIcon = color.New(color.FgGreen).Sprint("✓")
+						stateIcon = output.StateColor("completed").Sprint("✓")
 					case "started", "in_progress":
-						stateIcon = color.New(color.FgBlue).Sprint("◐")
+						stateIcon = output.StateColor("started").Sprint("◐")
 					case "canceled":
-						stateIcon = color.New(color.FgRed).Sprint("✗")
+						stateIcon = output.StateColor("canceled").Sprint("✗")
 					}
 				}
 
@@ -883,29 +1580,71 @@ var issueGetCmd = &cobra.Command{
 
 				fmt.Printf("  %s %s %s (%s)\n",
 					stateIcon,
-					color.New(color.FgCyan).Sprint(child.Identifier),
+					output.Color(output.RoleName).Sprint(maybeHyperlink(child.Identifier, child.URL, links)),
 					child.Title,
-					color.New(color.FgWhite, color.Faint).Sprint(assignee))
+					output.Color(output.RoleMuted).Sprint(assignee))
+			}
+		}
+
+		// Show related issues if any
+		if issue.Relations != nil && len(issue.Relations.Nodes) > 0 {
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprint("Related Issues:"))
+			for _, relation := range issue.Relations.Nodes {
+				if relation.RelatedIssue == nil {
+					continue
+				}
+				related := relation.RelatedIssue
+				fmt.Printf("  %s %s %s\n",
+					output.Color(output.RoleMuted).Sprintf("%s:", relationLabel(relation.Type)),
+					output.Color(output.RoleName).Sprint(maybeHyperlink(related.Identifier, related.URL, links)),
+					related.Title)
+			}
+		}
+
+		// Show pull requests and commits among the attachments
+		if dev := linkedDevelopment(issue.Attachments); len(dev) > 0 {
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprint("Linked Development:"))
+			for _, item := range dev {
+				status := ""
+				if item.Status != "" {
+					status = " " + output.Color(devStatusColor(item.Status)).Sprintf("[%s]", item.Status)
+				}
+				fmt.Printf("  %s %s%s %s\n",
+					output.Color(output.RoleLabel).Sprintf("%-6s", item.Kind),
+					item.Title,
+					status,
+					output.Color(output.RoleLink).Sprint(maybeHyperlink(item.URL, item.URL, links)))
 			}
 		}
 
 		// Show attachments if any
 		if issue.Attachments != nil && len(issue.Attachments.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Attachments:"))
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprint("Attachments:"))
 			for _, attachment := range issue.Attachments.Nodes {
-				fmt.Printf("  📎 %s - %s\n",
+				fmt.Printf("  📎 %s - %s %s\n",
 					attachment.Title,
-					color.New(color.FgBlue, color.Underline).Sprint(attachment.URL))
+					output.Color(output.RoleLink).Sprint(attachment.URL),
+					output.Color(output.RoleMuted).Sprintf("(%s)", attachment.ID))
 			}
 		}
 
-		// Show recent comments if any
-		if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
-			fmt.Printf("\n%s\n", color.New(color.FgYellow).Sprint("Recent Comments:"))
+		// Show the full comment thread when requested
+		if allComments && issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprintf("Comments (%d):", countComments(issue.Comments.Nodes)))
+			for _, comment := range issue.Comments.Nodes {
+				printRichComment(comment, "  ", renderMarkdown)
+				if comment.Children != nil {
+					for _, reply := range comment.Children.Nodes {
+						printRichComment(reply, "      ↳ ", renderMarkdown)
+					}
+				}
+			}
+		} else if issue.Comments != nil && len(issue.Comments.Nodes) > 0 {
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprint("Recent Comments:"))
 			for _, comment := range issue.Comments.Nodes {
 				fmt.Printf("  💬 %s - %s\n",
-					color.New(color.FgCyan).Sprint(comment.User.Name),
-					color.New(color.FgWhite, color.Faint).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")))
+					output.Color(output.RoleName).Sprint(commentAuthor(comment)),
+					output.Color(output.RoleMuted).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")))
 				// Show first line of comment
 				lines := strings.Split(comment.Body, "\n")
 				if len(lines) > 0 && lines[0] != "" {
@@ -916,13 +1655,262 @@ var issueGetCmd = &cobra.Command{
 					fmt.Printf("     %s\n", preview)
 				}
 			}
-			fmt.Printf("\n  %s Use 'linctl comment list %s' to see all comments\n",
-				color.New(color.FgWhite, color.Faint).Sprint("→"),
+			fmt.Printf("\n  %s Use 'linctl issue get %s --comments' to see all comments\n",
+				output.Color(output.RoleMuted).Sprint("→"),
 				issue.Identifier)
 		}
+
+		// Show the full history when requested
+		if allHistory && issue.History != nil && len(issue.History.Nodes) > 0 {
+			fmt.Printf("\n%s\n", output.Color(output.RoleHeading).Sprintf("History (%d):", len(issue.History.Nodes)))
+			for _, entry := range issue.History.Nodes {
+				fmt.Printf("  %s %s\n",
+					output.Color(output.RoleMuted).Sprint(entry.CreatedAt.Format("2006-01-02 15:04")),
+					output.Color(output.RoleName).Sprint(historyActor(entry)))
+				for _, change := range describeHistoryEntry(entry) {
+					fmt.Printf("     %s\n", change)
+				}
+			}
+		}
 	},
 }
 
+// fetchAllIssueComments pages through every comment on an issue and threads
+// replies under their parent comments.
+func fetchAllIssueComments(ctx context.Context, client *api.Client, issueID string, progress *output.Progress) (*api.Comments, error) {
+	comments, _, err := fetchAllPages(progress, func(first int, after string) ([]api.Comment, api.PageInfo, error) {
+		page, err := client.GetIssueComments(ctx, issueID, first, after, "createdAt")
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.Comments{Nodes: threadComments(comments)}, nil
+}
+
+// threadComments returns the top-level comments, oldest first, with replies
+// attached as children. Replies whose parent is missing are kept top-level.
+func threadComments(comments []api.Comment) []api.Comment {
+	sort.SliceStable(comments, func(i, j int) bool {
+		return comments[i].CreatedAt.Before(comments[j].CreatedAt)
+	})
+
+	byID := make(map[string]bool, len(comments))
+	for _, c := range comments {
+		byID[c.ID] = true
+	}
+
+	replies := map[string][]api.Comment{}
+	var roots []api.Comment
+	for _, c := range comments {
+		if c.Parent != nil && byID[c.Parent.ID] {
+			replies[c.Parent.ID] = append(replies[c.Parent.ID], c)
+			continue
+		}
+		roots = append(roots, c)
+	}
+	for i := range roots {
+		if children := replies[roots[i].ID]; len(children) > 0 {
+			roots[i].Children = &api.Comments{Nodes: children}
+		} else {
+			roots[i].Children = nil
+		}
+	}
+	return roots
+}
+
+// fetchAllIssueHistory pages through an issue's complete history.
+func fetchAllIssueHistory(ctx context.Context, client *api.Client, issueID string, progress *output.Progress) (*api.IssueHistory, error) {
+	entries, _, err := fetchAllPages(progress, func(first int, after string) ([]api.IssueHistoryEntry, api.PageInfo, error) {
+		page, err := client.GetIssueHistory(ctx, issueID, first, after)
+		if err != nil {
+			return nil, api.PageInfo{}, err
+		}
+		return page.Nodes, page.PageInfo, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &api.IssueHistory{Nodes: entries}, nil
+}
+
+// countComments counts comments including threaded replies.
+func countComments(comments []api.Comment) int {
+	n := len(comments)
+	for _, c := range comments {
+		if c.Children != nil {
+			n += len(c.Children.Nodes)
+		}
+	}
+	return n
+}
+
+func commentAuthor(comment api.Comment) string {
+	if comment.User == nil || comment.User.Name == "" {
+		return "Unknown"
+	}
+	return comment.User.Name
+}
+
+func historyActor(entry api.IssueHistoryEntry) string {
+	if entry.Actor == nil || entry.Actor.Name == "" {
+		return "Linear"
+	}
+	return entry.Actor.Name
+}
+
+// printRichComment prints a comment's author, time and full body, indenting
+// every line with prefix. The body is rendered as Markdown when render is set.
+func printRichComment(comment api.Comment, prefix string, render bool) {
+	edited := ""
+	if comment.EditedAt != nil {
+		edited = " (edited)"
+	}
+	fmt.Printf("%s💬 %s - %s%s\n",
+		prefix,
+		output.Color(output.RoleName).Sprint(commentAuthor(comment)),
+		output.Color(output.RoleMuted).Sprint(comment.CreatedAt.Format("2006-01-02 15:04")),
+		edited)
+	indent := strings.Repeat(" ", len([]rune(prefix))+3)
+	for _, line := range strings.Split(maybeRenderMarkdown(strings.TrimRight(comment.Body, "\n"), render), "\n") {
+		fmt.Printf("%s%s\n", indent, line)
+	}
+}
+
+// issueGetSections are the sections of `issue get --plaintext`, in output
+// order.
+var issueGetSections = []string{
+	"description", "core", "dates", "technical", "project", "cycle", "labels", "subscribers",
+	"relations", "reactions", "parent", "subissues", "development", "attachments", "comments",
+	"history",
+}
+
+// parseIssueSections returns a predicate for the sections to render: those
+// listed in include (all when empty) minus those in exclude. Unknown names
+// are an error.
+func parseIssueSections(include, exclude string) (func(string) bool, error) {
+	parse := func(flag, csv string) (map[string]bool, error) {
+		set := map[string]bool{}
+		for _, name := range splitCSV(strings.ToLower(csv)) {
+			if !slices.Contains(issueGetSections, name) {
+				return nil, fmt.Errorf("unknown section '%s' in --%s (valid: %s)", name, flag, strings.Join(issueGetSections, ", "))
+			}
+			set[name] = true
+		}
+		return set, nil
+	}
+	included, err := parse("sections", include)
+	if err != nil {
+		return nil, err
+	}
+	excluded, err := parse("no-sections", exclude)
+	if err != nil {
+		return nil, err
+	}
+	return func(section string) bool {
+		return (len(included) == 0 || included[section]) && !excluded[section]
+	}, nil
+}
+
+// relationLabel names an issue relation type for display.
+func relationLabel(relationType string) string {
+	switch relationType {
+	case "blocks":
+		return "Blocks"
+	case "blocked":
+		return "Blocked by"
+	case "related":
+		return "Related to"
+	case "duplicate":
+		return "Duplicate of"
+	}
+	return relationType
+}
+
+// markdownRenderingRequested reports whether --render-markdown applies:
+// rendering is for terminals, so plaintext, JSON and uncolored output stay raw.
+func markdownRenderingRequested(cmd *cobra.Command, plaintext, jsonOut bool) bool {
+	render, _ := cmd.Flags().GetBool("render-markdown")
+	return render && !plaintext && !jsonOut && !color.NoColor
+}
+
+// maybeRenderMarkdown renders text for the terminal when render is set and
+// returns it unchanged otherwise.
+func maybeRenderMarkdown(text string, render bool) string {
+	if !render {
+		return text
+	}
+	return output.RenderMarkdown(text)
+}
+
+// describeHistoryEntry lists the human-readable changes recorded in a
+// history entry.
+func describeHistoryEntry(entry api.IssueHistoryEntry) []string {
+	changes := []string{}
+
+	if entry.FromState != nil && entry.ToState != nil {
+		changes = append(changes, fmt.Sprintf("State: %s → %s", entry.FromState.Name, entry.ToState.Name))
+	}
+	if entry.FromAssignee != nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assignee: %s → %s", entry.FromAssignee.Name, entry.ToAssignee.Name))
+	} else if entry.FromAssignee != nil && entry.ToAssignee == nil {
+		changes = append(changes, fmt.Sprintf("Unassigned from %s", entry.FromAssignee.Name))
+	} else if entry.FromAssignee == nil && entry.ToAssignee != nil {
+		changes = append(changes, fmt.Sprintf("Assigned to %s", entry.ToAssignee.Name))
+	}
+	if entry.FromPriority != nil && entry.ToPriority != nil {
+		changes = append(changes, fmt.Sprintf("Priority: %s → %s", priorityToString(*entry.FromPriority), priorityToString(*entry.ToPriority)))
+	}
+	if entry.FromTitle != nil && entry.ToTitle != nil {
+		changes = append(changes, fmt.Sprintf("Title: \"%s\" → \"%s\"", *entry.FromTitle, *entry.ToTitle))
+	}
+	if entry.FromCycle != nil && entry.ToCycle != nil {
+		changes = append(changes, fmt.Sprintf("Cycle: %s → %s", entry.FromCycle.Name, entry.ToCycle.Name))
+	}
+	if entry.FromProject != nil && entry.ToProject != nil {
+		changes = append(changes, fmt.Sprintf("Project: %s → %s", entry.FromProject.Name, entry.ToProject.Name))
+	}
+	if len(entry.AddedLabelIds) > 0 {
+		changes = append(changes, fmt.Sprintf("Added %d label(s)", len(entry.AddedLabelIds)))
+	}
+	if len(entry.RemovedLabelIds) > 0 {
+		changes = append(changes, fmt.Sprintf("Removed %d label(s)", len(entry.RemovedLabelIds)))
+	}
+
+	return changes
+}
+
+// addIssueFilterFlags registers the filters read by buildIssueFilter and
+// issuePresenceFlags. list, search, count and the bulk label commands all
+// select issues with them, so they share one registration.
+func addIssueFilterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
+	cmd.Flags().Bool("mine", false, "Only issues assigned to you (shortcut for --assignee me)")
+	cmd.Flags().Bool("subscribed", false, "Only issues you are subscribed to (following), whoever the assignee or creator is")
+	cmd.Flags().StringP("state", "s", "", "Filter by state name")
+	cmd.Flags().String("exclude-state", "", "Exclude issues in these states (comma-separated names, e.g. \"Done,Canceled\")")
+	cmd.Flags().StringP("team", "t", "", "Filter by team key or name")
+	cmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	cmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
+	cmd.Flags().Bool("include-archived", false, "Include archived issues")
+	cmd.Flags().StringP("newer-than", "n", "", "Only issues created after this time: N_days_ago, N_weeks_ago, YYYY-MM-DD, ISO timestamp or all_time (default 6_months_ago; see --help-time)")
+	cmd.Flags().String("project", "", "Filter by project name or ID")
+	cmd.Flags().String("label", "", "Filter by labels (comma-separated names). AND semantics for multiple labels.")
+	cmd.Flags().String("label-any", "", "Match any of these labels (comma-separated names). OR semantics.")
+	cmd.Flags().String("label-not", "", "Exclude issues that have any of these labels (comma-separated names).")
+	cmd.Flags().Bool("unlabeled", false, "Only issues with no labels (cannot be combined with label filters)")
+	cmd.Flags().String("parent", "", "Filter by parent issue identifier (e.g., 'RAE-123') or UUID")
+	cmd.Flags().Bool("has-parent", false, "Only sub-issues (issues that have a parent)")
+	cmd.Flags().Bool("no-parent", false, "Only top-level issues (no parent)")
+	cmd.Flags().Bool("has-comments", false, "Only issues with at least one comment")
+	cmd.Flags().Bool("no-comments", false, "Only issues without comments")
+	cmd.Flags().Bool("has-attachments", false, "Only issues with at least one attachment (e.g. linked PRs or designs)")
+	cmd.Flags().Bool("no-attachments", false, "Only issues without attachments")
+}
+
 func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interface{}, []string, []string, []string, bool, string, bool, bool) {
     filter := make(map[string]interface{})
     // Label operator buckets
@@ -935,33 +1923,93 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
     hasParent := false             // --has-parent
     noParent := false              // --no-parent
 
-	if assignee, _ := cmd.Flags().GetString("assignee"); assignee != "" {
+	assignee, _ := cmd.Flags().GetString("assignee")
+	if mine, _ := cmd.Flags().GetBool("mine"); mine {
+		if assignee != "" {
+			plaintext := viper.GetBool("plaintext")
+			jsonOut := viper.GetBool("json")
+			output.Error("Cannot combine --mine with --assignee", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		assignee = "me"
+	}
+	if assignee != "" {
 		if assignee == "me" {
-			// We'll need to get the current user's ID
-			// For now, we'll use a special marker
-			filter["assignee"] = map[string]interface{}{"isMe": map[string]interface{}{"eq": true}}
+			// Resolve the viewer so the filter matches on a concrete assignee ID
+			viewer, err := client.GetViewer(context.Background())
+			if err != nil {
+				plaintext := viper.GetBool("plaintext")
+				jsonOut := viper.GetBool("json")
+				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			filter["assignee"] = map[string]interface{}{"id": map[string]interface{}{"eq": viewer.ID}}
 		} else {
 			filter["assignee"] = map[string]interface{}{"email": map[string]interface{}{"eq": assignee}}
 		}
 	}
 
+	if subscribed, _ := cmd.Flags().GetBool("subscribed"); subscribed {
+		// Issues the viewer follows, independent of assignee or creator
+		viewer, err := client.GetViewer(context.Background())
+		if err != nil {
+			plaintext := viper.GetBool("plaintext")
+			jsonOut := viper.GetBool("json")
+			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		filter["subscribers"] = map[string]interface{}{
+			"some": map[string]interface{}{"id": map[string]interface{}{"eq": viewer.ID}},
+		}
+	}
+
 	state, _ := cmd.Flags().GetString("state")
+	excludeStatesCSV, _ := cmd.Flags().GetString("exclude-state")
+	excludeStates := splitCSV(excludeStatesCSV)
+	for _, excluded := range excludeStates {
+		if state != "" && strings.EqualFold(excluded, state) {
+			plaintext := viper.GetBool("plaintext")
+			jsonOut := viper.GetBool("json")
+			output.Error(fmt.Sprintf("--state '%s' is also listed in --exclude-state", state), plaintext, jsonOut)
+			os.Exit(1)
+		}
+	}
 	if state != "" {
 		filter["state"] = map[string]interface{}{"name": map[string]interface{}{"eq": state}}
 	} else {
+		stateFilter := map[string]interface{}{}
 		// Only filter out completed issues if no specific state is requested
 		includeCompleted, _ := cmd.Flags().GetBool("include-completed")
 		if !includeCompleted {
 			// Filter out completed and canceled states
-			filter["state"] = map[string]interface{}{
-				"type": map[string]interface{}{
-					"nin": []string{"completed", "canceled"},
-				},
+			stateFilter["type"] = map[string]interface{}{
+				"nin": []string{"completed", "canceled"},
+			}
+		}
+		if len(excludeStates) > 0 {
+			// Linear has no case-insensitive nin, so each name gets its own
+			// neqIgnoreCase, matching how --state conflicts are detected above
+			var names []interface{}
+			for _, excluded := range excludeStates {
+				names = append(names, map[string]interface{}{"name": map[string]interface{}{"neqIgnoreCase": excluded}})
 			}
+			stateFilter["and"] = names
+		}
+		if len(stateFilter) > 0 {
+			filter["state"] = stateFilter
 		}
 	}
 
-	if team, _ := cmd.Flags().GetString("team"); team != "" {
+	team, _ := cmd.Flags().GetString("team")
+	if team != "" {
+		key, err := resolveTeamKey(context.Background(), client, team)
+		if err != nil {
+			plaintext := viper.GetBool("plaintext")
+			jsonOut := viper.GetBool("json")
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		team = key
 		filter["team"] = map[string]interface{}{"key": map[string]interface{}{"eq": team}}
 	}
 
@@ -975,23 +2023,27 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
 	if err != nil {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
-		output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-		os.Exit(1)
+		output.Error(fmt.Sprintf("Invalid --newer-than value: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
 	}
     if createdAt != "" {
         filter["createdAt"] = map[string]interface{}{"gte": createdAt}
     }
 
-    // Optional: project filter (by ID)
+    // Optional: project filter (by ID or name)
     if cmd.Flags().Changed("project") {
         proj, _ := cmd.Flags().GetString("project")
         proj = strings.TrimSpace(proj)
         if proj != "" {
             if !isValidUUID(proj) {
-                plaintext := viper.GetBool("plaintext")
-                jsonOut := viper.GetBool("json")
-                output.Error(fmt.Sprintf("Invalid project ID format: %s", proj), plaintext, jsonOut)
-                os.Exit(1)
+                id, err := resolveProjectByName(context.Background(), client, proj, team)
+                if err != nil {
+                    plaintext := viper.GetBool("plaintext")
+                    jsonOut := viper.GetBool("json")
+                    output.Error(err.Error(), plaintext, jsonOut)
+                    os.Exit(exitCode(err))
+                }
+                proj = id
             }
             // Prefer nested project.id equality for filtering
             filter["project"] = map[string]interface{}{
@@ -1007,12 +2059,12 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
     if cmd.Flags().Changed("label") {
         labelsCSV, _ := cmd.Flags().GetString("label")
         if strings.TrimSpace(labelsCSV) != "" {
-            ids, err := lookupIssueLabelIDsByNames(context.Background(), client, labelsCSV)
+            ids, err := lookupLabelIDsByNames(context.Background(), "issue", client.GetIssueLabels, labelsCSV)
             if err != nil {
                 plaintext := viper.GetBool("plaintext")
                 jsonOut := viper.GetBool("json")
                 output.Error(err.Error(), plaintext, jsonOut)
-                os.Exit(1)
+                os.Exit(exitCode(err))
             }
             requiredLabelIDs = ids
             labelsFilter["some"] = map[string]interface{}{
@@ -1020,7 +2072,7 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
             }
             // If other label flags are also set, warn (non-JSON) they are ignored
             if (cmd.Flags().Changed("label-any") || cmd.Flags().Changed("label-not") || cmd.Flags().Changed("unlabeled")) && !viper.GetBool("json") {
-                fmt.Println("Warning: --label specified; ignoring --label-any/--label-not/--unlabeled")
+                fmt.Fprintln(os.Stderr, "Warning: --label specified; ignoring --label-any/--label-not/--unlabeled")
             }
         } else {
             // Empty string with --label for list/search doesn't make sense; ignore silently
@@ -1030,12 +2082,12 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
         if cmd.Flags().Changed("label-any") {
             csv, _ := cmd.Flags().GetString("label-any")
             if strings.TrimSpace(csv) != "" {
-                ids, err := lookupIssueLabelIDsByNames(context.Background(), client, csv)
+                ids, err := lookupLabelIDsByNames(context.Background(), "issue", client.GetIssueLabels, csv)
                 if err != nil {
                     plaintext := viper.GetBool("plaintext")
                     jsonOut := viper.GetBool("json")
                     output.Error(err.Error(), plaintext, jsonOut)
-                    os.Exit(1)
+                    os.Exit(exitCode(err))
                 }
                 anyLabelIDs = ids
                 labelsFilter["some"] = map[string]interface{}{
@@ -1047,12 +2099,12 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
         if cmd.Flags().Changed("label-not") {
             csv, _ := cmd.Flags().GetString("label-not")
             if strings.TrimSpace(csv) != "" {
-                ids, err := lookupIssueLabelIDsByNames(context.Background(), client, csv)
+                ids, err := lookupLabelIDsByNames(context.Background(), "issue", client.GetIssueLabels, csv)
                 if err != nil {
                     plaintext := viper.GetBool("plaintext")
                     jsonOut := viper.GetBool("json")
                     output.Error(err.Error(), plaintext, jsonOut)
-                    os.Exit(1)
+                    os.Exit(exitCode(err))
                 }
                 notLabelIDs = ids
                 // Merge with existing labelsFilter if present
@@ -1067,7 +2119,7 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
             if unlabeledOnly {
                 // If combined with 'any' or 'not', warn (non-JSON) and ignore others
                 if (len(anyLabelIDs) > 0 || len(notLabelIDs) > 0) && !viper.GetBool("json") {
-                    fmt.Println("Warning: --unlabeled specified; ignoring --label-any/--label-not")
+                    fmt.Fprintln(os.Stderr, "Warning: --unlabeled specified; ignoring --label-any/--label-not")
                 }
                 // Clear server-side label filter to avoid conflicts
                 labelsFilter = map[string]interface{}{}
@@ -1097,13 +2149,13 @@ func buildIssueFilter(cmd *cobra.Command, client *api.Client) (map[string]interf
         ident, _ := cmd.Flags().GetString("parent")
         ident = strings.TrimSpace(ident)
         if ident != "" {
-            // Resolve identifier to node ID
-            p, err := client.GetIssue(context.Background(), ident)
+            // Resolve identifier to node ID (UUIDs are used as-is)
+            p, err := resolveParentIssue(context.Background(), client, ident, false)
             if err != nil {
                 plaintext := viper.GetBool("plaintext")
                 jsonOut := viper.GetBool("json")
-                output.Error(fmt.Sprintf("Parent issue '%s' not found", ident), plaintext, jsonOut)
-                os.Exit(1)
+                output.Error(err.Error(), plaintext, jsonOut)
+                os.Exit(exitCode(err))
             }
             parentNodeID = p.ID
             // Best-effort server filter on parent.id
@@ -1230,6 +2282,116 @@ func filterIssuesByParent(issues *api.Issues, parentID string, wantHas, wantNo b
     return &filtered
 }
 
+// issuePresenceFlags reads a --has-NAME/--no-NAME flag pair, exiting with an
+// error when both are given.
+func issuePresenceFlags(cmd *cobra.Command, name string) (wantHas, wantNo bool) {
+    wantHas, _ = cmd.Flags().GetBool("has-" + name)
+    wantNo, _ = cmd.Flags().GetBool("no-" + name)
+    if wantHas && wantNo {
+        output.Error(fmt.Sprintf("Cannot combine --has-%s and --no-%s", name, name), viper.GetBool("plaintext"), viper.GetBool("json"))
+        os.Exit(1)
+    }
+    return wantHas, wantNo
+}
+
+// filterIssuesByComments keeps issues that have (wantHas) or lack (wantNo)
+// comments. The list queries select one comment per issue for this when a
+// presence filter is set (see api.IssueListOptions).
+func filterIssuesByComments(issues *api.Issues, wantHas, wantNo bool) *api.Issues {
+    if issues == nil || (!wantHas && !wantNo) {
+        return issues
+    }
+    out := make([]api.Issue, 0, len(issues.Nodes))
+    for _, is := range issues.Nodes {
+        has := is.Comments != nil && len(is.Comments.Nodes) > 0
+        if (wantHas && has) || (wantNo && !has) {
+            out = append(out, is)
+        }
+    }
+    filtered := *issues
+    filtered.Nodes = out
+    return &filtered
+}
+
+// filterIssuesByAttachments keeps issues that have (wantHas) or lack (wantNo)
+// attachments. The list queries select one attachment per issue for this
+// when a presence filter is set.
+func filterIssuesByAttachments(issues *api.Issues, wantHas, wantNo bool) *api.Issues {
+    if issues == nil || (!wantHas && !wantNo) {
+        return issues
+    }
+    out := make([]api.Issue, 0, len(issues.Nodes))
+    for _, is := range issues.Nodes {
+        has := is.Attachments != nil && len(is.Attachments.Nodes) > 0
+        if (wantHas && has) || (wantNo && !has) {
+            out = append(out, is)
+        }
+    }
+    filtered := *issues
+    filtered.Nodes = out
+    return &filtered
+}
+
+// filterIssuesByBlocking keeps issues that another issue blocks (wantBlocked)
+// and/or that block another issue (wantBlocking); with both, an issue must
+// be both. The list query selects each issue's relations for this when
+// either flag is set (see api.IssueListOptions).
+func filterIssuesByBlocking(issues *api.Issues, wantBlocked, wantBlocking bool) *api.Issues {
+    if issues == nil || (!wantBlocked && !wantBlocking) {
+        return issues
+    }
+    out := make([]api.Issue, 0, len(issues.Nodes))
+    for _, is := range issues.Nodes {
+        // A "blocks" relation on the issue points at what it blocks; one on
+        // another issue that points here shows up as an inverse relation.
+        if wantBlocked && !hasRelationType(is.InverseRelations, "blocks") {
+            continue
+        }
+        if wantBlocking && !hasRelationType(is.Relations, "blocks") {
+            continue
+        }
+        out = append(out, is)
+    }
+    filtered := *issues
+    filtered.Nodes = out
+    return &filtered
+}
+
+// filterIssuesByActiveAssignee drops issues assigned to deactivated users
+// when activeOnly is set; unassigned issues are kept. The list query selects
+// each assignee's active flag for this.
+func filterIssuesByActiveAssignee(issues *api.Issues, activeOnly bool) *api.Issues {
+    if issues == nil || !activeOnly {
+        return issues
+    }
+    out := make([]api.Issue, 0, len(issues.Nodes))
+    for _, is := range issues.Nodes {
+        if is.Assignee == nil || is.Assignee.Active {
+            out = append(out, is)
+        }
+    }
+    filtered := *issues
+    filtered.Nodes = out
+    return &filtered
+}
+
+func hasRelationType(relations *api.IssueRelations, relationType string) bool {
+    if relations == nil {
+        return false
+    }
+    for _, r := range relations.Nodes {
+        if r.Type == relationType {
+            return true
+        }
+    }
+    return false
+}
+
+// stateTypeColor returns the display color for a workflow state type.
+func stateTypeColor(stateType string) *color.Color {
+	return output.StateColor(stateType)
+}
+
 func priorityToString(priority int) string {
 	switch priority {
 	case 0:
@@ -1247,11 +2409,48 @@ func priorityToString(priority int) string {
 	}
 }
 
+// resolveIssuePriority turns an update --priority value into an absolute
+// priority. Absolute values are 0-4 or a priority name. A signed value moves
+// along the urgency scale None < Low < Normal < High < Urgent: +1 makes the
+// issue one step more urgent and -1 one step less, clamped at Urgent and None.
+// current is only called for relative values.
+func resolveIssuePriority(value string, current func() int) (int, error) {
+	value = strings.TrimSpace(value)
+	if !strings.HasPrefix(value, "+") && !strings.HasPrefix(value, "-") {
+		return parseImportPriority(value)
+	}
+
+	delta, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid priority '%s' (use 0-4, a name, or +N/-N)", value)
+	}
+
+	// Map priority to urgency (None=0 … Urgent=4), shift, and map back
+	urgency := 0
+	if p := current(); p >= 1 && p <= 4 {
+		urgency = 5 - p
+	}
+	urgency += delta
+	if urgency < 0 {
+		urgency = 0
+	}
+	if urgency > 4 {
+		urgency = 4
+	}
+	if urgency == 0 {
+		return 0, nil
+	}
+	return 5 - urgency, nil
+}
+
+// truncateString shortens s to maxLen runes, ending in "..." when cut, so
+// multi-byte characters are never split.
 func truncateString(s string, maxLen int) string {
-	if len(s) <= maxLen {
+	runes := []rune(s)
+	if len(runes) <= maxLen {
 		return s
 	}
-	return s[:maxLen-3] + "..."
+	return string(runes[:maxLen-3]) + "..."
 }
 
 var issueAssignCmd = &cobra.Command{
@@ -1262,20 +2461,25 @@ var issueAssignCmd = &cobra.Command{
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		issueID, err := normalizeIdentifier(args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
 
-		authHeader, err := auth.GetAuthHeader()
+		authHeader, err := getIssueAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-		client := api.NewClient(authHeader)
+		client := newIssueClient(authHeader)
 
 		// Get current user
 		viewer, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Update issue with assignee
@@ -1283,10 +2487,10 @@ var issueAssignCmd = &cobra.Command{
 			"assigneeId": viewer.ID,
 		}
 
-		issue, err := client.UpdateIssue(context.Background(), args[0], input)
+		issue, err := client.UpdateIssue(context.Background(), issueID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to assign issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -1295,9 +2499,9 @@ var issueAssignCmd = &cobra.Command{
 			fmt.Printf("Assigned %s to %s\n", issue.Identifier, viewer.Name)
 		} else {
 			fmt.Printf("%s Assigned %s to %s\n",
-				color.New(color.FgGreen).Sprint("✓"),
-				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
-				color.New(color.FgCyan).Sprint(viewer.Name))
+				output.Color(output.RoleSuccess).Sprint("✓"),
+				output.Color(output.RoleIdentifier).Sprint(issue.Identifier),
+				output.Color(output.RoleName).Sprint(viewer.Name))
 		}
 	},
 }
@@ -1306,146 +2510,571 @@ var issueCreateCmd = &cobra.Command{
 	Use:     "create",
 	Aliases: []string{"new"},
 	Short:   "Create a new issue",
-	Long:    `Create a new issue in Linear.`,
+	Long: `Create a new issue in Linear.
+
+Use --from to create one or more issues from a YAML or JSON spec file. The file
+holds either a single issue or a list of issues with the keys: title,
+description, team, state, priority, assign_me, project, parent, labels,
+due_date, subscribers and idempotency_key. --team is used for entries that do not set a
+team.
+
+Creates are retry-safe: every issue is created with a client-generated ID, and
+if the request fails after Linear already created the issue (for example a
+timeout), that issue is looked up and reported as an existing issue instead
+of an error. Pass
+--idempotency-key to extend this across runs: re-running a create with the same
+key returns the issue created the first time, unchanged, rather than a
+duplicate. With --from, entry N uses KEY#N unless it sets its own
+idempotency_key.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		authHeader, err := auth.GetAuthHeader()
+		authHeader, err := getIssueAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-		client := api.NewClient(authHeader)
+		client := newIssueClient(authHeader)
+		strict, _ := cmd.Flags().GetBool("strict")
+
+		if cmd.Flags().Changed("from") {
+			if cmd.Flags().Changed("title") {
+				output.Error("Cannot combine --from with --title", plaintext, jsonOut)
+				os.Exit(1)
+			}
+			path, _ := cmd.Flags().GetString("from")
+			specs, err := loadIssueSpecs(path)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			defaultTeam, _ := cmd.Flags().GetString("team")
+			baseKey, _ := cmd.Flags().GetString("idempotency-key")
+			for i := range specs {
+				if specs[i].Team == "" {
+					specs[i].Team = defaultTeam
+				}
+				if specs[i].IdempotencyKey == "" && baseKey != "" {
+					specs[i].IdempotencyKey = fmt.Sprintf("%s#%d", baseKey, i+1)
+				}
+			}
+			if failed := createIssuesFromSpecs(client, specs, strict, plaintext, jsonOut); failed > 0 {
+				os.Exit(1)
+			}
+			return
+		}
 
 		// Get flags
-		title, _ := cmd.Flags().GetString("title")
-		description, _ := cmd.Flags().GetString("description")
-		teamKey, _ := cmd.Flags().GetString("team")
+		spec := issueCreateSpec{}
+		spec.Title, _ = cmd.Flags().GetString("title")
+		spec.Description, _ = cmd.Flags().GetString("description")
+		spec.Team, _ = cmd.Flags().GetString("team")
 		priority, _ := cmd.Flags().GetInt("priority")
-		assignToMe, _ := cmd.Flags().GetBool("assign-me")
-
-		if title == "" {
+		spec.Priority = &priority
+		spec.AssignMe, _ = cmd.Flags().GetBool("assign-me")
+		spec.Assignee, _ = cmd.Flags().GetString("assignee")
+		spec.Project, _ = cmd.Flags().GetString("project")
+		spec.Parent, _ = cmd.Flags().GetString("parent")
+		labelsCSV, _ := cmd.Flags().GetString("label")
+		spec.Labels = splitCSV(labelsCSV)
+		spec.DueDate, _ = cmd.Flags().GetString("due-date")
+		spec.State, _ = cmd.Flags().GetString("state")
+		subscribersCSV, _ := cmd.Flags().GetString("subscriber")
+		spec.Subscribers = splitCSV(subscribersCSV)
+		spec.IdempotencyKey, _ = cmd.Flags().GetString("idempotency-key")
+
+		if spec.Title == "" {
 			output.Error("Title is required (--title)", plaintext, jsonOut)
 			os.Exit(1)
 		}
-
-		if teamKey == "" {
-			output.Error("Team is required (--team)", plaintext, jsonOut)
+		if spec.AssignMe && spec.Assignee != "" {
+			output.Error("Cannot combine --assign-me with --assignee (use --assignee me)", plaintext, jsonOut)
 			os.Exit(1)
 		}
-
-		// Get team ID from key
-		team, err := client.GetTeam(context.Background(), teamKey)
-		if err != nil {
-			output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
+		if cmd.Flags().Changed("due-date") && strings.TrimSpace(spec.DueDate) == "" {
+			output.Error("invalid due date: empty value (expected YYYY-MM-DD)", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-		// Build input
-		input := map[string]interface{}{
-			"title":  title,
-			"teamId": team.ID,
-		}
+		progress := output.NewProgress(plaintext, jsonOut)
+		defer progress.Stop()
 
-		if description != "" {
-			input["description"] = description
+		issue, existing, err := createIssueFromSpec(context.Background(), client, spec, strict, progress)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
+		progress.Stop()
 
-		if priority >= 0 && priority <= 4 {
-			input["priority"] = priority
-		}
+		copyFlagResult(cmd, issue.Identifier, issue.URL, jsonOut)
 
-		if assignToMe {
-			viewer, err := client.GetViewer(context.Background())
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-			input["assigneeId"] = viewer.ID
-		}
+		renderCreatedIssue(issue, existing, plaintext, jsonOut)
+	},
+}
 
-        // Handle project assignment
-        if cmd.Flags().Changed("project") {
-			projectID, _ := cmd.Flags().GetString("project")
-			if val, ok, err := buildProjectInput(projectID); err != nil {
-				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
-			} else if ok {
-				// For create, "unassigned" is equivalent to not setting project
-				if val != nil {
-					input["projectId"] = val
-				}
-			}
-        }
+// issueCreateSpec describes a single issue to create, either from flags or
+// from an entry in a --from spec file.
+type issueCreateSpec struct {
+	Title       string   `yaml:"title" json:"title"`
+	Description string   `yaml:"description" json:"description,omitempty"`
+	Team        string   `yaml:"team" json:"team,omitempty"`
+	Priority    *int     `yaml:"priority" json:"priority,omitempty"`
+	AssignMe    bool     `yaml:"assign_me" json:"assign_me,omitempty"`
+	Assignee    string   `yaml:"assignee" json:"assignee,omitempty"`
+	Project     string   `yaml:"project" json:"project,omitempty"`
+	Parent      string   `yaml:"parent" json:"parent,omitempty"`
+	Labels      []string `yaml:"labels" json:"labels,omitempty"`
+	DueDate     string   `yaml:"due_date" json:"due_date,omitempty"`
+	State       string   `yaml:"state" json:"state,omitempty"`
+	Subscribers []string `yaml:"subscribers" json:"subscribers,omitempty"`
+	// IdempotencyKey makes re-running the same create return the issue made
+	// the first time instead of a duplicate
+	IdempotencyKey string `yaml:"idempotency_key" json:"idempotency_key,omitempty"`
+
+	// Pre-resolved IDs, set by callers that cache lookups across many specs
+	assigneeID string
+	labelIDs   []string
+}
 
-        // Handle parent assignment (sub-issue)
-        if cmd.Flags().Changed("parent") {
-            parentIdent, _ := cmd.Flags().GetString("parent")
-            parentIdent = strings.TrimSpace(parentIdent)
-            if parentIdent != "" && parentIdent != "unassigned" {
-                // Resolve to node ID
-                p, err := client.GetIssue(context.Background(), parentIdent)
-                if err != nil {
-                    output.Error(fmt.Sprintf("Parent issue '%s' not found", parentIdent), plaintext, jsonOut)
-                    os.Exit(1)
-                }
-                input["parentId"] = p.ID
-            }
-        }
+// resolveTeamStateID finds the team's workflow state named name
+// (case-insensitive), listing the available states when there is none.
+func resolveTeamStateID(ctx context.Context, client *api.Client, teamKey, name string) (string, error) {
+	states, err := client.GetTeamStates(ctx, teamKey)
+	if err != nil {
+		return "", fmt.Errorf("Failed to get team states: %w", err)
+	}
+	return matchStateID(states, name)
+}
 
-        // Handle label assignment on create (optional)
-        if cmd.Flags().Changed("label") {
-			labelsCSV, _ := cmd.Flags().GetString("label")
-			// Empty string means clear (no labels) — equivalent to not setting
-			if strings.TrimSpace(labelsCSV) != "" {
-				ids, err := lookupIssueLabelIDsByNames(context.Background(), client, labelsCSV)
-				if err != nil {
-					output.Error(err.Error(), plaintext, jsonOut)
-					os.Exit(1)
-				}
-				input["labelIds"] = ids
-			} else {
-				input["labelIds"] = []string{}
-			}
+// matchStateID is resolveTeamStateID for states that were already fetched.
+func matchStateID(states []api.WorkflowState, name string) (string, error) {
+	stateNames := make([]string, 0, len(states))
+	for _, state := range states {
+		if strings.EqualFold(state.Name, name) {
+			return state.ID, nil
 		}
+		stateNames = append(stateNames, state.Name)
+	}
+	return "", fmt.Errorf("State '%s' not found. Available states: %s", name, strings.Join(stateNames, ", "))
+}
 
-		// Create issue
-		issue, err := client.CreateIssue(context.Background(), input)
+// lookupAssigneeID resolves an assignee given as "me", an email or a display
+// name to a user ID.
+func lookupAssigneeID(ctx context.Context, client *api.Client, assignee string) (string, error) {
+	user, err := lookupAssignee(ctx, client, assignee)
+	if err != nil {
+		return "", err
+	}
+	return user.ID, nil
+}
+
+// lookupAssignee is lookupAssigneeID returning the whole user.
+func lookupAssignee(ctx context.Context, client *api.Client, assignee string) (*api.User, error) {
+	if assignee == "me" {
+		viewer, err := client.GetViewer(ctx)
 		if err != nil {
-			// Standardize project not-found error when a project was provided
-			if cmd.Flags().Changed("project") {
-				projectID, _ := cmd.Flags().GetString("project")
-				if projectID != "" && projectID != "unassigned" && isProjectNotFoundErr(err) {
-					output.Error(fmt.Sprintf("Project '%s' not found", projectID), plaintext, jsonOut)
-					os.Exit(1)
-				}
-			}
-			output.Error(fmt.Sprintf("Failed to create issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			return nil, fmt.Errorf("Failed to get current user: %w", err)
+		}
+		return viewer, nil
+	}
+	users, err := client.GetUsers(ctx, 100, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get users: %w", err)
+	}
+	for i, user := range users.Nodes {
+		if user.Email == assignee || user.Name == assignee {
+			return &users.Nodes[i], nil
 		}
+	}
+	return nil, fmt.Errorf("User not found: %s (use an email, display name or 'me'; see 'linctl user list')", assignee)
+}
 
-		if jsonOut {
-			output.JSON(issue)
-		} else if plaintext {
-			fmt.Printf("Created issue %s: %s\n", issue.Identifier, issue.Title)
-			if issue.Project != nil {
-				fmt.Printf("Project: %s\n", issue.Project.Name)
-			}
+// buildIssueCreateInput resolves a spec into an IssueCreateInput, looking up
+// the parent, team, subscribers, assignee and labels as needed.
+func buildIssueCreateInput(ctx context.Context, client *api.Client, spec issueCreateSpec, strict bool, progress *output.Progress) (map[string]interface{}, error) {
+	if strings.TrimSpace(spec.Title) == "" {
+		return nil, fmt.Errorf("title is required")
+	}
+
+	// Resolve the parent first so a sub-issue can default to its team
+	var parent *api.Issue
+	parentIdent := strings.TrimSpace(spec.Parent)
+	if parentIdent != "" && parentIdent != "unassigned" {
+		progress.Step("Resolving parent issue…")
+		// Only a UUID parent without a team skips the team check
+		p, err := resolveParentIssue(ctx, client, parentIdent, spec.Team == "")
+		if err != nil {
+			return nil, err
+		}
+		parent = p
+	}
+
+	teamKey := spec.Team
+	if teamKey == "" {
+		if parent == nil || parent.Team == nil {
+			return nil, fmt.Errorf("Team is required (--team)")
+		}
+		teamKey = parent.Team.Key
+	}
+
+	// Get team ID from key
+	progress.Step("Resolving team…")
+	team, err := client.GetTeam(ctx, teamKey)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find team '%s': %w", teamKey, err)
+	}
+	if team.Key != "" {
+		teamKey = team.Key
+	}
+
+	if warning, err := checkParentTeam(parent, team, strict); err != nil {
+		return nil, err
+	} else if warning != "" {
+		fmt.Fprintf(os.Stderr, "Warning: %s\n", warning)
+	}
+
+	// Build input
+	input := map[string]interface{}{
+		"title":  spec.Title,
+		"teamId": team.ID,
+	}
+
+	if spec.Description != "" {
+		input["description"] = spec.Description
+	}
+
+	if spec.State != "" {
+		progress.Step("Resolving state…")
+		stateID, err := resolveTeamStateID(ctx, client, teamKey, spec.State)
+		if err != nil {
+			return nil, err
+		}
+		input["stateId"] = stateID
+	}
+
+	priority := 3
+	if spec.Priority != nil {
+		priority = *spec.Priority
+	}
+	if priority >= 0 && priority <= 4 {
+		input["priority"] = priority
+	}
+
+	if spec.DueDate != "" {
+		normalized, err := utils.ParseDueDate(spec.DueDate)
+		if err != nil {
+			return nil, err
+		}
+		input["dueDate"] = normalized
+	}
+
+	if len(spec.Subscribers) > 0 {
+		progress.Step("Resolving subscribers…")
+		ids, err := lookupUserIDsByEmails(ctx, client, strings.Join(spec.Subscribers, ","))
+		if err != nil {
+			return nil, err
+		}
+		if len(ids) > 0 {
+			input["subscriberIds"] = ids
+		}
+	}
+
+	if spec.Assignee != "" && spec.AssignMe {
+		return nil, fmt.Errorf("cannot combine assignee with assign-me")
+	}
+	if spec.assigneeID != "" {
+		input["assigneeId"] = spec.assigneeID
+	} else if spec.Assignee != "" && spec.Assignee != "me" {
+		progress.Step("Resolving assignee…")
+		id, err := lookupAssigneeID(ctx, client, spec.Assignee)
+		if err != nil {
+			return nil, err
+		}
+		input["assigneeId"] = id
+	} else if spec.AssignMe || spec.Assignee == "me" {
+		progress.Step("Resolving assignee…")
+		viewer, err := client.GetViewer(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get current user: %w", err)
+		}
+		input["assigneeId"] = viewer.ID
+	}
+
+	// Handle project assignment; "unassigned" is equivalent to not setting it
+	if spec.Project != "" {
+		if isProjectName(spec.Project) {
+			progress.Step("Resolving project…")
+		}
+		val, ok, err := buildProjectInput(ctx, client, spec.Project, teamKey)
+		if err != nil {
+			return nil, err
+		}
+		if ok && val != nil {
+			input["projectId"] = val
+		}
+	}
+
+	// Handle parent assignment (sub-issue)
+	if parent != nil {
+		input["parentId"] = parent.ID
+	}
+
+	// Handle label assignment
+	if len(spec.labelIDs) > 0 {
+		input["labelIds"] = spec.labelIDs
+	} else if len(spec.Labels) > 0 {
+		progress.Step("Resolving labels…")
+		ids, err := lookupLabelIDsByNames(ctx, "issue", client.GetIssueLabels, strings.Join(spec.Labels, ","))
+		if err != nil {
+			return nil, err
+		}
+		input["labelIds"] = ids
+	}
+
+	return input, nil
+}
+
+// createIssueFromSpec builds the input for spec and creates the issue.
+// existing is set when the create failed but an earlier attempt (a timed-out
+// request or a previous run with the same idempotency key) had already made
+// the issue, which is returned unchanged.
+func createIssueFromSpec(ctx context.Context, client *api.Client, spec issueCreateSpec, strict bool, progress *output.Progress) (issue *api.Issue, existing bool, err error) {
+	input, err := buildIssueCreateInput(ctx, client, spec, strict, progress)
+	if err != nil {
+		return nil, false, err
+	}
+
+	// Create with a client-chosen ID so a failed or repeated request can be
+	// checked for an issue the server already created
+	id := idempotentIssueID(spec.IdempotencyKey)
+	input["id"] = id
+
+	progress.Step("Creating issue…")
+	issue, err = client.CreateIssue(ctx, input)
+	if err != nil {
+		if found, lookupErr := client.GetIssue(ctx, id); lookupErr == nil && strings.EqualFold(found.ID, id) {
+			return found, true, nil
+		}
+		// Standardize project not-found error when a project was provided
+		if _, ok := input["projectId"]; ok && isProjectNotFoundErr(err) {
+			return nil, false, fmt.Errorf("Project '%s' not found", spec.Project)
+		}
+		return nil, false, errors.New(describeIssueMutationError("create issue", err))
+	}
+	return issue, false, nil
+}
+
+// loadIssueSpecs reads a YAML or JSON file holding one issue spec or a list.
+func loadIssueSpecs(path string) ([]issueCreateSpec, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read spec file: %w", err)
+	}
+
+	var specs []issueCreateSpec
+	if err := yaml.Unmarshal(data, &specs); err != nil {
+		var single issueCreateSpec
+		if err := yaml.Unmarshal(data, &single); err != nil {
+			return nil, fmt.Errorf("failed to parse spec file %s: %w", path, err)
+		}
+		specs = []issueCreateSpec{single}
+	}
+	if len(specs) == 0 {
+		return nil, fmt.Errorf("spec file %s contains no issues", path)
+	}
+	return specs, nil
+}
+
+// issueCreateResult reports the outcome of creating one spec entry.
+type issueCreateResult struct {
+	Index      int    `json:"index"`
+	Title      string `json:"title"`
+	Identifier string `json:"identifier,omitempty"`
+	URL        string `json:"url,omitempty"`
+	Existing   bool   `json:"existing,omitempty"` // made by an earlier attempt, not this one
+	Error      string `json:"error,omitempty"`
+}
+
+// createIssueSpecs creates each spec in turn and returns per-issue results.
+func createIssueSpecs(client *api.Client, specs []issueCreateSpec, strict bool, progress *output.Progress) []issueCreateResult {
+	results := make([]issueCreateResult, 0, len(specs))
+	for i, spec := range specs {
+		progress.Step("Creating issue %d of %d…", i+1, len(specs))
+		result := issueCreateResult{Index: i + 1, Title: spec.Title}
+		issue, existing, err := createIssueFromSpec(context.Background(), client, spec, strict, nil)
+		if err != nil {
+			result.Error = err.Error()
 		} else {
-			fmt.Printf("%s Created issue %s: %s\n",
-				color.New(color.FgGreen).Sprint("✓"),
-				color.New(color.FgCyan, color.Bold).Sprint(issue.Identifier),
-				issue.Title)
-			if issue.Assignee != nil {
-				fmt.Printf("  Assigned to: %s\n", color.New(color.FgCyan).Sprint(issue.Assignee.Name))
-			}
-			if issue.Project != nil {
-				fmt.Printf("  Project: %s\n", color.New(color.FgBlue).Sprint(issue.Project.Name))
+			result.Identifier = issue.Identifier
+			result.URL = issue.URL
+			result.Existing = existing
+		}
+		results = append(results, result)
+	}
+	progress.Stop()
+	return results
+}
+
+// renderIssueCreateResults prints per-issue results and a summary, returning
+// the number of failures.
+func renderIssueCreateResults(results []issueCreateResult, plaintext, jsonOut bool) int {
+	failed, existing := 0, 0
+	for _, r := range results {
+		if r.Error != "" {
+			failed++
+		} else if r.Existing {
+			existing++
+		}
+	}
+
+	if jsonOut {
+		output.JSON(results)
+		return failed
+	}
+
+	for _, r := range results {
+		if plaintext {
+			if r.Error != "" {
+				fmt.Printf("Failed [%d] %s: %s\n", r.Index, r.Title, r.Error)
+			} else {
+				fmt.Printf("%s issue %s: %s\n", createdVerb(r.Existing), r.Identifier, r.Title)
 			}
+			continue
 		}
-	},
+		if r.Error != "" {
+			fmt.Printf("%s [%d] %s: %s\n",
+				output.Color(output.RoleError).Sprint("✗"),
+				r.Index, r.Title,
+				output.Color(output.RoleError).Sprint(r.Error))
+		} else {
+			fmt.Printf("%s %s issue %s: %s\n",
+				output.Color(output.RoleSuccess).Sprint("✓"),
+				createdVerb(r.Existing),
+				output.Color(output.RoleIdentifier).Sprint(r.Identifier),
+				r.Title)
+		}
+	}
+	if existing > 0 {
+		fmt.Printf("\nCreated %d of %d issues (%d already existed)\n", len(results)-failed-existing, len(results), existing)
+	} else {
+		fmt.Printf("\nCreated %d of %d issues\n", len(results)-failed, len(results))
+	}
+	return failed
+}
+
+// createdVerb describes a created issue, or one an earlier attempt created.
+func createdVerb(existing bool) string {
+	if existing {
+		return "Found existing"
+	}
+	return "Created"
+}
+
+// createIssuesFromSpecs creates each spec, reports per-issue results and
+// returns the number of failures.
+func createIssuesFromSpecs(client *api.Client, specs []issueCreateSpec, strict bool, plaintext, jsonOut bool) int {
+	results := createIssueSpecs(client, specs, strict, output.NewProgress(plaintext, jsonOut))
+	return renderIssueCreateResults(results, plaintext, jsonOut)
+}
+
+// splitCSV splits a comma-separated flag value, dropping empty entries.
+func splitCSV(s string) []string {
+	var out []string
+	for _, part := range strings.Split(s, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			out = append(out, part)
+		}
+	}
+	return out
+}
+
+// indentListContinuation indents every line after the first by two spaces so
+// multi-line text stays inside the Markdown list item it starts.
+func indentListContinuation(s string) string {
+	return strings.ReplaceAll(s, "\n", "\n  ")
+}
+
+// resolveParentIssue resolves a --parent value. Identifiers such as RAE-123 are
+// looked up; UUIDs are used directly unless needDetails requires a fetch.
+func resolveParentIssue(ctx context.Context, client *api.Client, ref string, needDetails bool) (*api.Issue, error) {
+	id, err := normalizeIdentifier(ref)
+	if err != nil {
+		return nil, err
+	}
+	if isValidUUID(id) && !needDetails {
+		return &api.Issue{ID: id}, nil
+	}
+	issue, err := client.GetIssue(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Parent issue '%s' not found", id)
+	}
+	return issue, nil
+}
+
+// checkParentTeam compares a sub-issue's team with its parent's. A mismatch
+// yields a warning, or an error when strict is set.
+func checkParentTeam(parent *api.Issue, team *api.Team, strict bool) (string, error) {
+	if parent == nil || parent.Team == nil || team == nil || parent.Team.ID == team.ID {
+		return "", nil
+	}
+	msg := fmt.Sprintf("team %s differs from parent %s's team %s", team.Key, parent.Identifier, parent.Team.Key)
+	if strict {
+		return "", fmt.Errorf("%s (remove --strict to allow)", msg)
+	}
+	return msg, nil
+}
+
+// renderCreatedIssue prints the issue returned by issueCreate, echoing the
+// assignee, priority, labels and project Linear actually set. existing marks
+// an issue an earlier attempt created (see createIssueFromSpec).
+func renderCreatedIssue(issue *api.Issue, existing, plaintext, jsonOut bool) {
+	if jsonOut {
+		if existing {
+			output.Hint(fmt.Sprintf("%s was created by an earlier attempt", issue.Identifier))
+		}
+		output.JSON(issue)
+		return
+	}
+
+	labels := []string{}
+	if issue.Labels != nil {
+		for _, l := range issue.Labels.Nodes {
+			labels = append(labels, l.Name)
+		}
+	}
+
+	if plaintext {
+		fmt.Printf("%s issue %s: %s\n", createdVerb(existing), issue.Identifier, issue.Title)
+		if issue.Assignee != nil {
+			fmt.Printf("Assignee: %s\n", issue.Assignee.Name)
+		}
+		fmt.Printf("Priority: %s\n", priorityToString(issue.Priority))
+		if len(labels) > 0 {
+			fmt.Printf("Labels: %s\n", strings.Join(labels, ", "))
+		}
+		if issue.Project != nil {
+			fmt.Printf("Project: %s\n", issue.Project.Name)
+		}
+		return
+	}
+
+	fmt.Printf("%s %s issue %s: %s\n",
+		output.Color(output.RoleSuccess).Sprint("✓"),
+		createdVerb(existing),
+		output.Color(output.RoleIdentifier).Sprint(issue.Identifier),
+		issue.Title)
+	if issue.Assignee != nil {
+		fmt.Printf("  Assigned to: %s\n", output.Color(output.RoleName).Sprint(issue.Assignee.Name))
+	}
+	fmt.Printf("  Priority: %s\n", priorityToString(issue.Priority))
+	if len(labels) > 0 {
+		fmt.Printf("  Labels: %s\n", output.Color(output.RoleAccent).Sprint(strings.Join(labels, ", ")))
+	}
+	if issue.Project != nil {
+		fmt.Printf("  Project: %s\n", output.Color(output.RoleProject).Sprint(issue.Project.Name))
+	}
 }
 
 var issueUpdateCmd = &cobra.Command{
@@ -1453,29 +3082,60 @@ var issueUpdateCmd = &cobra.Command{
 	Short: "Update an issue",
 	Long: `Update various fields of an issue.
 
+Without an issue ID, the identifier in the current git branch is used when
+running in an interactive terminal, after asking for confirmation (--yes
+skips it); scripts and CI must pass --from-branch.
+
 Examples:
   linctl issue update LIN-123 --title "New title"
   linctl issue update LIN-123 --description "Updated description"
   linctl issue update LIN-123 --assignee john.doe@company.com
   linctl issue update LIN-123 --state "In Progress"
   linctl issue update LIN-123 --priority 1
+  linctl issue update LIN-123 --priority +1   # One step more urgent (Low → Normal)
+  linctl issue update LIN-123 --priority -1   # One step less urgent (High → Normal)
   linctl issue update LIN-123 --due-date "2024-12-31"
-  linctl issue update LIN-123 --title "New title" --assignee me --priority 2`,
-	Args: cobra.ExactArgs(1),
+  linctl issue update LIN-123 --due-date none   # Or --clear-due-date
+  linctl issue update LIN-123 --cycle current
+  linctl issue update LIN-123 --cycle none
+  linctl issue update LIN-123 --title "New title" --assignee me --priority 2
+  linctl issue update LIN-123 --label "" --dry-run   # Preview the change first
+  linctl issue update --state "In Review"   # In a terminal: the issue named in the git branch
+  linctl issue update --from-branch --state "In Review"`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
+		issueID, err := issueArgOrBranch(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if dryRun, _ := cmd.Flags().GetBool("dry-run"); !dryRun {
+			ok, err := confirmBranchIssue(cmd, args, issueID)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			if !ok {
+				output.Error("Update cancelled", plaintext, jsonOut)
+				os.Exit(1)
+			}
+		}
 
-		authHeader, err := auth.GetAuthHeader()
+		authHeader, err := getIssueAuthHeader()
 		if err != nil {
 			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
-		client := api.NewClient(authHeader)
+		client := newIssueClient(authHeader)
 
-        // Build update input
+        // Build update input. names keeps what --dry-run shows for the
+        // fields input only has IDs for.
         input := make(map[string]interface{})
+        var names issueUpdateNames
+        dryRun, _ := cmd.Flags().GetBool("dry-run")
 
         // Handle title update
         if cmd.Flags().Changed("title") {
@@ -1498,98 +3158,121 @@ Examples:
 				viewer, err := client.GetViewer(context.Background())
 				if err != nil {
 					output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 				input["assigneeId"] = viewer.ID
+				names.assignee = viewer.Name
 			case "unassigned", "":
 				input["assigneeId"] = nil
 			default:
-				// Look up user by email
-				users, err := client.GetUsers(context.Background(), 100, "", "")
+				user, err := lookupAssignee(context.Background(), client, assignee)
 				if err != nil {
-					output.Error(fmt.Sprintf("Failed to get users: %v", err), plaintext, jsonOut)
-					os.Exit(1)
-				}
-
-				var foundUser *api.User
-				for _, user := range users.Nodes {
-					if user.Email == assignee || user.Name == assignee {
-						foundUser = &user
-						break
-					}
+					output.Error(err.Error(), plaintext, jsonOut)
+					os.Exit(exitCode(err))
 				}
+				input["assigneeId"] = user.ID
+				names.assignee = user.Name
+			}
+		}
 
-				if foundUser == nil {
-					output.Error(fmt.Sprintf("User not found: %s", assignee), plaintext, jsonOut)
-					os.Exit(1)
+		// State and cycle are resolved within the issue's team, so fetch
+		// the issue at most once when either is requested
+		var current *api.Issue
+		issueLabels := labelSource(client.GetIssueLabels)
+		var teamStates []api.WorkflowState
+		if cmd.Flags().Changed("state") {
+			// Fetch the issue, its team's states and, when label names
+			// need resolving, the labels in a single request
+			var issue api.Issue
+			var labels api.Labels
+			queries := map[string]api.BatchQuery{
+				"issue":  api.IssueQuery(issueID, &issue),
+				"states": api.IssueTeamStatesQuery(issueID, &teamStates),
+			}
+			if issueUpdateResolvesLabels(cmd) {
+				queries["labels"] = api.IssueLabelsQuery(&labels)
+				issueLabels = func(context.Context) (*api.Labels, error) { return &labels, nil }
+			}
+			if err := client.BatchFetch(context.Background(), queries); err != nil {
+				output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			current = &issue
+		}
+		currentIssue := func() *api.Issue {
+			if current == nil {
+				issue, err := client.GetIssue(context.Background(), issueID)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
+					os.Exit(exitCode(err))
 				}
-
-				input["assigneeId"] = foundUser.ID
+				current = issue
 			}
+			return current
 		}
 
 		// Handle state update
 		if cmd.Flags().Changed("state") {
 			stateName, _ := cmd.Flags().GetString("state")
 
-			// First, get the issue to know which team it belongs to
-			issue, err := client.GetIssue(context.Background(), args[0])
-			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get issue: %v", err), plaintext, jsonOut)
-				os.Exit(1)
-			}
-
-			// Get available states for the team
-			states, err := client.GetTeamStates(context.Background(), issue.Team.Key)
+			stateID, err := matchStateID(teamStates, stateName)
 			if err != nil {
-				output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
-				os.Exit(1)
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
 			}
 
-			// Find the state by name (case-insensitive)
-			var stateID string
-			for _, state := range states {
-				if strings.EqualFold(state.Name, stateName) {
-					stateID = state.ID
-					break
+			input["stateId"] = stateID
+			for _, state := range teamStates {
+				if state.ID == stateID {
+					names.state = state.Name
 				}
 			}
+		}
 
-			if stateID == "" {
-				// Show available states
-				var stateNames []string
-				for _, state := range states {
-					stateNames = append(stateNames, state.Name)
-				}
-				output.Error(fmt.Sprintf("State '%s' not found. Available states: %s", stateName, strings.Join(stateNames, ", ")), plaintext, jsonOut)
-				os.Exit(1)
+		// Handle cycle update
+		if cmd.Flags().Changed("cycle") {
+			cycleArg, _ := cmd.Flags().GetString("cycle")
+			cycle, err := resolveIssueCycle(context.Background(), client, currentIssue().Team.Key, cycleArg)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			input["cycleId"] = nil
+			if cycle != nil {
+				input["cycleId"] = cycle.ID
+				names.cycle = cycleDisplayName(cycle)
 			}
-
-			input["stateId"] = stateID
 		}
 
 		// Handle priority update
 		if cmd.Flags().Changed("priority") {
-			priority, _ := cmd.Flags().GetInt("priority")
+			priorityArg, _ := cmd.Flags().GetString("priority")
+			priority, err := resolveIssuePriority(priorityArg, func() int { return currentIssue().Priority })
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
 			input["priority"] = priority
 		}
 
 		// Handle due date update
-		if cmd.Flags().Changed("due-date") {
-			dueDate, _ := cmd.Flags().GetString("due-date")
-			if dueDate == "" {
-				input["dueDate"] = nil
-			} else {
-				input["dueDate"] = dueDate
-			}
+		if dueDate, ok, err := issueDueDateUpdate(cmd); err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		} else if ok {
+			input["dueDate"] = dueDate
 		}
 
 			// Handle project assignment update
 			if cmd.Flags().Changed("project") {
 				projectID, _ := cmd.Flags().GetString("project")
-				if val, ok, err := buildProjectInput(projectID); err != nil {
+				teamKey := ""
+				if isProjectName(projectID) {
+					teamKey = currentIssue().Team.Key
+				}
+				if val, ok, err := buildProjectInput(context.Background(), client, projectID, teamKey); err != nil {
 					output.Error(err.Error(), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				} else if ok {
 					input["projectId"] = val
 				}
@@ -1603,12 +3286,14 @@ Examples:
 					// Explicitly remove parent
 					input["parentId"] = nil
 				} else {
-					p, err := client.GetIssue(context.Background(), parentIdent)
+					// --dry-run shows the parent's identifier even when a UUID was given
+					p, err := resolveParentIssue(context.Background(), client, parentIdent, dryRun)
 					if err != nil {
-						output.Error(fmt.Sprintf("Parent issue '%s' not found", parentIdent), plaintext, jsonOut)
-						os.Exit(1)
+						output.Error(err.Error(), plaintext, jsonOut)
+						os.Exit(exitCode(err))
 					}
 					input["parentId"] = p.ID
+					names.parent = p.Identifier
 				}
 			}
 
@@ -1623,25 +3308,25 @@ Examples:
 				// Explicit clear all labels
 				input["labelIds"] = []string{}
 			} else {
-				ids, err := lookupIssueLabelIDsByNames(context.Background(), client, labelsCSV)
+				ids, err := lookupLabelIDsByNames(context.Background(), "issue", issueLabels, labelsCSV)
 				if err != nil {
 					output.Error(err.Error(), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 				input["labelIds"] = ids
 			}
 			// If add/remove also provided, warn that they are ignored
 			if (addSet || removeSet) && !jsonOut {
-				fmt.Println("Warning: --label specified; ignoring --add-label/--remove-label as per precedence rule")
+				fmt.Fprintln(os.Stderr, "Warning: --label specified; ignoring --add-label/--remove-label as per precedence rule")
 			}
 		} else {
 			if addSet {
 				addCSV, _ := cmd.Flags().GetString("add-label")
 				if strings.TrimSpace(addCSV) != "" {
-					ids, err := lookupIssueLabelIDsByNames(context.Background(), client, addCSV)
+					ids, err := lookupLabelIDsByNames(context.Background(), "issue", issueLabels, addCSV)
 					if err != nil {
 						output.Error(err.Error(), plaintext, jsonOut)
-						os.Exit(1)
+						os.Exit(exitCode(err))
 					}
                     input["addedLabelIds"] = ids
 				}
@@ -1649,10 +3334,10 @@ Examples:
 			if removeSet {
 				removeCSV, _ := cmd.Flags().GetString("remove-label")
 				if strings.TrimSpace(removeCSV) != "" {
-					ids, err := lookupIssueLabelIDsByNames(context.Background(), client, removeCSV)
+					ids, err := lookupLabelIDsByNames(context.Background(), "issue", issueLabels, removeCSV)
 					if err != nil {
 						output.Error(err.Error(), plaintext, jsonOut)
-						os.Exit(1)
+						os.Exit(exitCode(err))
 					}
                     input["removedLabelIds"] = ids
 				}
@@ -1665,8 +3350,58 @@ Examples:
 			os.Exit(1)
 		}
 
+		if dryRun {
+			// Show label names as Linear has them rather than as typed
+			if issueUpdateResolvesLabels(cmd) {
+				labels, err := issueLabels(context.Background())
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to get labels: %v", err), plaintext, jsonOut)
+					os.Exit(exitCode(err))
+				}
+				names.labels = make(map[string]string, len(labels.Nodes))
+				for _, l := range labels.Nodes {
+					names.labels[l.ID] = l.Name
+				}
+			}
+			issue := currentIssue()
+			// and the project by name, whether it was given by name or ID
+			if id, ok := input["projectId"].(string); ok && (issue.Project == nil || issue.Project.ID != id) {
+				project, err := client.GetProject(context.Background(), id)
+				if err != nil {
+					output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
+					os.Exit(exitCode(err))
+				}
+				names.project = project.Name
+			}
+			renderIssueDiff(issue.Identifier, diffIssueUpdate(issue, input, names), plaintext, jsonOut)
+			return
+		}
+
+		// Clearing labels, the assignee or the parent can't be undone, so
+		// show what will be lost and confirm first
+		if clearsIssueFields(input) {
+			if changes := destructiveIssueChanges(currentIssue(), input); len(changes) > 0 {
+				force, _ := cmd.Flags().GetBool("force")
+				if !force && !assumeYesRequested() {
+					fmt.Fprintf(confirmOut, "Updating %s will:\n", currentIssue().Identifier)
+					for _, change := range changes {
+						fmt.Fprintf(confirmOut, "  %s\n", change)
+					}
+				}
+				ok, err := confirm("Apply these changes?", force)
+				if err != nil {
+					output.Error(err.Error(), plaintext, jsonOut)
+					os.Exit(exitCode(err))
+				}
+				if !ok {
+					output.Error("Update cancelled", plaintext, jsonOut)
+					os.Exit(1)
+				}
+			}
+		}
+
 		// Update the issue
-		issue, err := client.UpdateIssue(context.Background(), args[0], input)
+		issue, err := client.UpdateIssue(context.Background(), issueID, input)
 		if err != nil {
 			// Standardize project not-found error when a project was provided
 			if cmd.Flags().Changed("project") {
@@ -1676,22 +3411,250 @@ Examples:
 					os.Exit(1)
 				}
 			}
-			output.Error(fmt.Sprintf("Failed to update issue: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(describeIssueMutationError("update issue", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
 			output.JSON(issue)
-		} else if plaintext {
+			return
+		}
+		var addedLabels, removedLabels []string
+		if _, ok := input["addedLabelIds"]; ok {
+			addCSV, _ := cmd.Flags().GetString("add-label")
+			addedLabels = splitCSV(addCSV)
+		}
+		if _, ok := input["removedLabelIds"]; ok {
+			removeCSV, _ := cmd.Flags().GetString("remove-label")
+			removedLabels = splitCSV(removeCSV)
+		}
+		changes := describeIssueUpdate(issue, input, addedLabels, removedLabels)
+		if plaintext {
 			fmt.Printf("Updated issue %s\n", issue.Identifier)
+			for _, change := range changes {
+				fmt.Printf("- %s: %s\n", change.field, change.value)
+			}
 		} else {
 			output.Success(fmt.Sprintf("Updated issue %s", issue.Identifier), plaintext, jsonOut)
+			for _, change := range changes {
+				fmt.Printf("  %s %s\n", output.Color(output.RoleLabel).Sprint(change.field+":"), change.value)
+			}
 		}
 	},
 }
 
+// issueUpdateResolvesLabels reports whether issue update will look up label
+// names: --label with a value, or --add-label/--remove-label without --label.
+func issueUpdateResolvesLabels(cmd *cobra.Command) bool {
+	flags := []string{"add-label", "remove-label"}
+	if cmd.Flags().Changed("label") {
+		flags = []string{"label"}
+	}
+	for _, name := range flags {
+		if value, _ := cmd.Flags().GetString(name); cmd.Flags().Changed(name) && strings.TrimSpace(value) != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// issueFieldChange is one line of the summary printed after an update.
+type issueFieldChange struct {
+	field string
+	value string
+}
+
+// describeIssueUpdate summarizes the fields input changed, with the values
+// as they are on the updated issue: state and assignee names rather than IDs.
+// added and removed are the label names given to --add-label/--remove-label.
+func describeIssueUpdate(issue *api.Issue, input map[string]interface{}, added, removed []string) []issueFieldChange {
+	var changes []issueFieldChange
+	add := func(field, value string) {
+		changes = append(changes, issueFieldChange{field, value})
+	}
+	orNone := func(value string) string {
+		if value == "" {
+			return "(none)"
+		}
+		return value
+	}
+
+	if _, ok := input["title"]; ok {
+		add("Title", issue.Title)
+	}
+	if _, ok := input["description"]; ok {
+		if issue.Description == "" {
+			add("Description", "(cleared)")
+		} else {
+			add("Description", fmt.Sprintf("updated (%d characters)", len([]rune(issue.Description))))
+		}
+	}
+	if _, ok := input["stateId"]; ok {
+		state := ""
+		if issue.State != nil {
+			state = issue.State.Name
+		}
+		add("State", orNone(state))
+	}
+	if _, ok := input["assigneeId"]; ok {
+		if issue.Assignee == nil {
+			add("Assignee", "(unassigned)")
+		} else {
+			add("Assignee", issue.Assignee.Name)
+		}
+	}
+	if _, ok := input["priority"]; ok {
+		add("Priority", priorityToString(issue.Priority))
+	}
+	if _, ok := input["cycleId"]; ok {
+		cycle := ""
+		if issue.Cycle != nil {
+			cycle = issue.Cycle.Name
+			if cycle == "" {
+				cycle = fmt.Sprintf("Cycle %d", issue.Cycle.Number)
+			}
+		}
+		add("Cycle", orNone(cycle))
+	}
+	if _, ok := input["dueDate"]; ok {
+		due := ""
+		if issue.DueDate != nil {
+			due = *issue.DueDate
+		}
+		add("Due date", orNone(due))
+	}
+	if _, ok := input["projectId"]; ok {
+		name := ""
+		if issue.Project != nil {
+			name = issue.Project.Name
+		}
+		add("Project", orNone(name))
+	}
+	if _, ok := input["parentId"]; ok {
+		parent := ""
+		if issue.Parent != nil {
+			parent = issue.Parent.Identifier
+		}
+		add("Parent", orNone(parent))
+	}
+	if len(added) > 0 {
+		add("Labels added", strings.Join(added, ", "))
+	}
+	if len(removed) > 0 {
+		add("Labels removed", strings.Join(removed, ", "))
+	}
+	_, setLabels := input["labelIds"]
+	if setLabels || len(added) > 0 || len(removed) > 0 {
+		var names []string
+		if issue.Labels != nil {
+			for _, l := range issue.Labels.Nodes {
+				names = append(names, l.Name)
+			}
+		}
+		add("Labels", orNone(strings.Join(names, ", ")))
+	}
+	return changes
+}
+
+// clearsIssueFields reports whether input unassigns the issue, removes its
+// parent or clears all of its labels.
+func clearsIssueFields(input map[string]interface{}) bool {
+	if v, ok := input["assigneeId"]; ok && v == nil {
+		return true
+	}
+	if v, ok := input["parentId"]; ok && v == nil {
+		return true
+	}
+	if ids, ok := input["labelIds"].([]string); ok && len(ids) == 0 {
+		return true
+	}
+	return false
+}
+
+// destructiveIssueChanges describes, as "Field: before → after" lines, the
+// values input would clear from issue. Fields that are already empty are
+// skipped.
+func destructiveIssueChanges(issue *api.Issue, input map[string]interface{}) []string {
+	var changes []string
+	if v, ok := input["assigneeId"]; ok && v == nil && issue.Assignee != nil {
+		changes = append(changes, fmt.Sprintf("Assignee: %s → (unassigned)", issue.Assignee.Name))
+	}
+	if v, ok := input["parentId"]; ok && v == nil && issue.Parent != nil {
+		changes = append(changes, fmt.Sprintf("Parent: %s → (none)", issue.Parent.Identifier))
+	}
+	if ids, ok := input["labelIds"].([]string); ok && len(ids) == 0 && issue.Labels != nil && len(issue.Labels.Nodes) > 0 {
+		var names []string
+		for _, l := range issue.Labels.Nodes {
+			names = append(names, l.Name)
+		}
+		changes = append(changes, fmt.Sprintf("Labels: %s → (none)", strings.Join(names, ", ")))
+	}
+	return changes
+}
+
+// issueDueDateUpdate reads the due date flags of issue update. ok is false
+// when neither was given, leaving the due date alone; otherwise value is the
+// normalized date, or nil to clear it (--clear-due-date, or --due-date
+// none, clear or "").
+func issueDueDateUpdate(cmd *cobra.Command) (value interface{}, ok bool, err error) {
+	clearFlag, _ := cmd.Flags().GetBool("clear-due-date")
+	if !cmd.Flags().Changed("due-date") {
+		if clearFlag {
+			return nil, true, nil
+		}
+		return nil, false, nil
+	}
+	dueDate, _ := cmd.Flags().GetString("due-date")
+	switch strings.ToLower(strings.TrimSpace(dueDate)) {
+	case "", "none", "clear":
+		return nil, true, nil
+	}
+	if clearFlag {
+		return nil, false, fmt.Errorf("Cannot combine --clear-due-date with --due-date %s", dueDate)
+	}
+	normalized, err := utils.ParseDueDate(dueDate)
+	if err != nil {
+		return nil, false, fmt.Errorf("%w, or 'none' to clear it", err)
+	}
+	return normalized, true, nil
+}
+
+// resolveIssueCycle maps a --cycle value to a cycle within teamKey:
+// "current" is the team's active cycle, a number selects that cycle and
+// "none" returns nil to clear the issue's cycle.
+func resolveIssueCycle(ctx context.Context, client *api.Client, teamKey, value string) (*api.Cycle, error) {
+	value = strings.TrimSpace(value)
+	switch strings.ToLower(value) {
+	case "none", "":
+		return nil, nil
+	case "current":
+		cycle, err := client.GetTeamActiveCycle(ctx, teamKey)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get active cycle for team %s: %w", teamKey, err)
+		}
+		if cycle == nil {
+			return nil, fmt.Errorf("team %s has no active cycle", teamKey)
+		}
+		return cycle, nil
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil || number <= 0 {
+		return nil, fmt.Errorf("invalid cycle '%s' (use 'current', a cycle number, or 'none')", value)
+	}
+	cycle, err := client.GetTeamCycleByNumber(ctx, teamKey, number)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cycle %d for team %s: %w", number, teamKey, err)
+	}
+	if cycle == nil {
+		return nil, fmt.Errorf("cycle %d not found for team %s", number, teamKey)
+	}
+	return cycle, nil
+}
+
 func init() {
 	rootCmd.AddCommand(issueCmd)
+	issueCmd.PersistentFlags().Bool("from-branch", false, "Take the issue ID (and the --team default) from a TEAM-123 identifier in the current git branch")
 	issueCmd.AddCommand(issueListCmd)
 	issueCmd.AddCommand(issueSearchCmd)
 	issueCmd.AddCommand(issueGetCmd)
@@ -1700,64 +3663,72 @@ func init() {
 	issueCmd.AddCommand(issueUpdateCmd)
 
 	// Issue list flags
-	issueListCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
-	issueListCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueListCmd.Flags().StringP("team", "t", "", "Filter by team key")
-	issueListCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
-	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
-	issueListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
-	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
-	issueListCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
-    issueListCmd.Flags().String("project", "", "Filter by project ID (UUID)")
-    issueListCmd.Flags().String("label", "", "Filter by labels (comma-separated names). AND semantics for multiple labels.")
-    issueListCmd.Flags().String("label-any", "", "Match any of these labels (comma-separated names). OR semantics.")
-    issueListCmd.Flags().String("label-not", "", "Exclude issues that have any of these labels (comma-separated names).")
-    issueListCmd.Flags().Bool("unlabeled", false, "Only issues with no labels (cannot be combined with label filters)")
-    issueListCmd.Flags().String("parent", "", "Filter by parent issue identifier (e.g., 'RAE-123')")
-    issueListCmd.Flags().Bool("has-parent", false, "Only sub-issues (issues that have a parent)")
-    issueListCmd.Flags().Bool("no-parent", false, "Only top-level issues (no parent)")
+	addIssueFilterFlags(issueListCmd)
+	issueListCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch (0 fetches all pages)")
+	issueListCmd.Flags().Bool("plaintext-table", false, "With --plaintext, print a Markdown table instead of one block per issue")
+	issueListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated, priority, state (priority and state sort the fetched issues)")
+    issueListCmd.Flags().Bool("blocked", false, "Only issues blocked by another issue")
+    issueListCmd.Flags().Bool("blocking", false, "Only issues that block another issue")
+    issueListCmd.Flags().Bool("active-assignees-only", false, "Hide issues assigned to deactivated users")
+    issueListCmd.Flags().Bool("show-age", false, "Add an Age column (e.g. 3d, 2w) showing time since creation")
+    issueListCmd.Flags().String("actor", "", "Only issues changed by this user (me or an email) within the --newer-than window; fetches each candidate's history")
+    issueListCmd.Flags().Duration("watch", 0, "Refresh every interval (e.g. 30s, at least 2s) until interrupted, redrawing only when the issues change")
+    issueListCmd.Flags().Bool("enrich", false, "Add derived isOverdue, ageDays and assigneeEmail fields to --json output")
+    addSinceFlags(issueListCmd, "issues")
+    issueListCmd.Flags().Bool("with-totals", false, "With --json, print {\"nodes\": [...], \"totals\": {...}} with the count, estimate sum and counts by state type")
+    addMaxWidthFlag(issueListCmd, issueColumnWidths)
+
+	// Issue get flags
+	issueGetCmd.Flags().Bool("comments", false, "Fetch and show the full comment thread, including replies")
+	issueGetCmd.Flags().Bool("history", false, "Fetch and show the full issue history")
+	issueGetCmd.Flags().Bool("enrich", false, "Add derived isOverdue, ageDays and assigneeEmail fields to --json output")
+	issueGetCmd.Flags().String("sections", "", "Only render these --plaintext sections (comma-separated: "+strings.Join(issueGetSections, ", ")+")")
+	issueGetCmd.Flags().String("no-sections", "", "Omit these --plaintext sections (comma-separated, e.g. technical,history)")
+	issueGetCmd.Flags().String("hyperlinks", "auto", "Make identifiers and URLs clickable (OSC 8) in table output: auto, always or never")
+	issueGetCmd.Flags().Bool("render-markdown", false, "Render the description and comments as Markdown (headings, lists, code blocks) in table output")
+	addCopyFlag(issueGetCmd, "issue")
 
 	// Issue search flags
-	issueSearchCmd.Flags().StringP("assignee", "a", "", "Filter by assignee (email or 'me')")
-	issueSearchCmd.Flags().StringP("state", "s", "", "Filter by state name")
-	issueSearchCmd.Flags().StringP("team", "t", "", "Filter by team key")
-	issueSearchCmd.Flags().IntP("priority", "r", -1, "Filter by priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
+	addIssueFilterFlags(issueSearchCmd)
 	issueSearchCmd.Flags().IntP("limit", "l", 50, "Maximum number of issues to fetch")
-	issueSearchCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled issues")
-	issueSearchCmd.Flags().Bool("include-archived", false, "Include archived issues in results")
-	issueSearchCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
-	issueSearchCmd.Flags().StringP("newer-than", "n", "", "Show issues created after this time (default: 6_months_ago, use 'all_time' for no filter)")
-    issueSearchCmd.Flags().String("project", "", "Filter by project ID (UUID)")
-    issueSearchCmd.Flags().String("label", "", "Filter by labels (comma-separated names). AND semantics for multiple labels.")
-    issueSearchCmd.Flags().String("label-any", "", "Match any of these labels (comma-separated names). OR semantics.")
-    issueSearchCmd.Flags().String("label-not", "", "Exclude issues that have any of these labels (comma-separated names).")
-    issueSearchCmd.Flags().Bool("unlabeled", false, "Only issues with no labels (cannot be combined with label filters)")
-    issueSearchCmd.Flags().String("parent", "", "Filter by parent issue identifier (e.g., 'RAE-123')")
-    issueSearchCmd.Flags().Bool("has-parent", false, "Only sub-issues (issues that have a parent)")
-    issueSearchCmd.Flags().Bool("no-parent", false, "Only top-level issues (no parent)")
+	issueSearchCmd.Flags().Bool("plaintext-table", false, "With --plaintext, print a Markdown table instead of one block per issue")
+	issueSearchCmd.Flags().String("in", defaultSearchScope, "Fields to match: comma-separated title, description, comments")
+	issueSearchCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated, priority, state (priority and state sort the fetched issues)")
+    issueSearchCmd.Flags().Bool("enrich", false, "Add derived isOverdue, ageDays and assigneeEmail fields to --json output")
+    addMaxWidthFlag(issueSearchCmd, issueColumnWidths)
 
 	// Issue create flags
-	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required)")
+	issueCreateCmd.Flags().StringP("title", "", "", "Issue title (required unless --from is set)")
 	issueCreateCmd.Flags().StringP("description", "d", "", "Issue description")
-	issueCreateCmd.Flags().StringP("team", "t", "", "Team key (required)")
+	issueCreateCmd.Flags().StringP("team", "t", "", "Team key or name (required unless --parent is set; defaults to the parent's team)")
 	issueCreateCmd.Flags().Int("priority", 3, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
 	issueCreateCmd.Flags().BoolP("assign-me", "m", false, "Assign to yourself")
-	issueCreateCmd.Flags().String("project", "", "Project ID to assign issue to")
+	issueCreateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name or 'me'); cannot be combined with --assign-me")
+	issueCreateCmd.Flags().String("project", "", "Project ID to assign issue to (or project name)")
 	issueCreateCmd.Flags().String("label", "", "Comma-separated labels to set during creation (e.g., 'bug,backend')")
-	issueCreateCmd.Flags().String("parent", "", "Parent issue identifier (e.g., 'RAE-123') to create a sub-issue")
-	_ = issueCreateCmd.MarkFlagRequired("title")
-	_ = issueCreateCmd.MarkFlagRequired("team")
+	issueCreateCmd.Flags().String("parent", "", "Parent issue identifier (e.g., 'RAE-123') or UUID to create a sub-issue")
+	issueCreateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format)")
+	issueCreateCmd.Flags().StringP("state", "s", "", "Initial workflow state name (e.g. 'Backlog'); defaults to the team's default state")
+	issueCreateCmd.Flags().String("from", "", "Create issues from a YAML or JSON spec file (single issue or a list)")
+	issueCreateCmd.Flags().Bool("strict", false, "Fail instead of warning when --team differs from the parent issue's team")
+	issueCreateCmd.Flags().String("subscriber", "", "Comma-separated emails of users to subscribe to the issue")
+	issueCreateCmd.Flags().String("idempotency-key", "", "Key (any string or a UUID) that makes retries of this create return the same issue instead of a duplicate")
+	addCopyFlag(issueCreateCmd, "issue")
 
 	// Issue update flags
 	issueUpdateCmd.Flags().String("title", "", "New title for the issue")
 	issueUpdateCmd.Flags().StringP("description", "d", "", "New description for the issue")
 	issueUpdateCmd.Flags().StringP("assignee", "a", "", "Assignee (email, name, 'me', or 'unassigned')")
 	issueUpdateCmd.Flags().StringP("state", "s", "", "State name (e.g., 'Todo', 'In Progress', 'Done')")
-	issueUpdateCmd.Flags().Int("priority", -1, "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low)")
-	issueUpdateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format, or empty to remove)")
-	issueUpdateCmd.Flags().String("project", "", "Project ID to assign issue to (or 'unassigned' to remove)")
+	issueUpdateCmd.Flags().String("priority", "", "Priority (0=None, 1=Urgent, 2=High, 3=Normal, 4=Low, or a name), or +N/-N to raise/lower urgency")
+	issueUpdateCmd.Flags().String("cycle", "", "Cycle within the issue's team: 'current', a cycle number, or 'none' to remove")
+	issueUpdateCmd.Flags().String("due-date", "", "Due date (YYYY-MM-DD format), or 'none'/'clear' (or empty) to remove it")
+	issueUpdateCmd.Flags().Bool("clear-due-date", false, "Remove the due date (same as --due-date none)")
+	issueUpdateCmd.Flags().String("project", "", "Project ID to assign issue to (or project name, or 'unassigned' to remove)")
 	issueUpdateCmd.Flags().String("label", "", "Set labels exactly (comma-separated). Empty string clears all labels. Takes precedence over add/remove.")
 	issueUpdateCmd.Flags().String("add-label", "", "Add labels (comma-separated). Ignored if --label is provided.")
 	issueUpdateCmd.Flags().String("remove-label", "", "Remove labels (comma-separated). Ignored if --label is provided.")
-	issueUpdateCmd.Flags().String("parent", "", "Parent issue identifier to set (or 'unassigned' to remove parent)")
+	issueUpdateCmd.Flags().String("parent", "", "Parent issue identifier or UUID to set (or 'unassigned' to remove parent)")
+	issueUpdateCmd.Flags().Bool("dry-run", false, "Show what would change, field by field, without updating the issue")
+	issueUpdateCmd.Flags().Bool("force", false, "Don't ask for confirmation before clearing labels, the assignee or the parent (like the global --yes)")
 }
@@ -0,0 +1,121 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+// issueHistoryConcurrency bounds the parallel history requests made by
+// --actor.
+const issueHistoryConcurrency = 4
+
+// issueHistoryLookup is the part of the API needed to check who changed an
+// issue.
+type issueHistoryLookup interface {
+	GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*api.IssueHistory, error)
+}
+
+// actorMatcher identifies the --actor user by ID (for "me") or email.
+type actorMatcher struct {
+	id    string
+	email string
+}
+
+func (a actorMatcher) matches(u *api.User) bool {
+	if u == nil {
+		return false
+	}
+	if a.id != "" && u.ID == a.id {
+		return true
+	}
+	return a.email != "" && strings.EqualFold(u.Email, a.email)
+}
+
+// resolveHistoryActor turns an --actor value into a matcher; "me" is the
+// authenticated user.
+func resolveHistoryActor(ctx context.Context, client *api.Client, value string) (actorMatcher, error) {
+	if value != "me" {
+		return actorMatcher{email: value}, nil
+	}
+	viewer, err := client.GetViewer(ctx)
+	if err != nil {
+		return actorMatcher{}, fmt.Errorf("Failed to get current user: %w", err)
+	}
+	return actorMatcher{id: viewer.ID, email: viewer.Email}, nil
+}
+
+// actorWindow switches an issue filter's --newer-than window from creation to
+// last update, since an issue touched in the window may be older than it,
+// and returns the window start (zero for all_time).
+func actorWindow(filter map[string]interface{}) time.Time {
+	createdAt, ok := filter["createdAt"]
+	if !ok {
+		return time.Time{}
+	}
+	delete(filter, "createdAt")
+	filter["updatedAt"] = createdAt
+	since, _ := createdAt.(map[string]interface{})["gte"].(string)
+	t, _ := time.Parse(time.RFC3339, since)
+	return t
+}
+
+// filterIssuesByActor keeps the issues whose history has an entry by actor
+// created at or after since. Each issue's history is fetched separately, at
+// most issueHistoryConcurrency at a time.
+func filterIssuesByActor(ctx context.Context, client issueHistoryLookup, issues *api.Issues, actor actorMatcher, since time.Time) (*api.Issues, error) {
+	touched := make([]bool, len(issues.Nodes))
+	errs := make([]error, len(issues.Nodes))
+	sem := make(chan struct{}, issueHistoryConcurrency)
+	var wg sync.WaitGroup
+	for i := range issues.Nodes {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			touched[i], errs[i] = issueTouchedBy(ctx, client, issues.Nodes[i].ID, actor, since)
+		}(i)
+	}
+	wg.Wait()
+
+	kept := &api.Issues{Nodes: []api.Issue{}, PageInfo: issues.PageInfo}
+	for i, issue := range issues.Nodes {
+		if errs[i] != nil {
+			return nil, fmt.Errorf("%s: %v", issue.Identifier, errs[i])
+		}
+		if touched[i] {
+			kept.Nodes = append(kept.Nodes, issue)
+		}
+	}
+	return kept, nil
+}
+
+// issueTouchedBy pages through an issue's history until it finds an entry by
+// actor within the window. Linear returns history newest first, so paging
+// stops at the first entry older than since.
+func issueTouchedBy(ctx context.Context, client issueHistoryLookup, issueID string, actor actorMatcher, since time.Time) (bool, error) {
+	after := ""
+	for {
+		page, err := client.GetIssueHistory(ctx, issueID, fetchAllPageSize, after)
+		if err != nil {
+			return false, err
+		}
+		for _, entry := range page.Nodes {
+			if entry.CreatedAt.Before(since) {
+				return false, nil
+			}
+			if actor.matches(entry.Actor) {
+				return true, nil
+			}
+		}
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return false, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,110 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+func TestIssueList_ActorFiltersByHistory(t *testing.T) {
+	recent := time.Now().Add(-24 * time.Hour).UTC().Format(time.RFC3339)
+	stale := time.Now().AddDate(0, -2, 0).UTC().Format(time.RFC3339)
+	history := map[string][]any{
+		// changed by the viewer yesterday
+		"id-1": {map[string]any{"id": "h1", "createdAt": recent, "actor": map[string]any{"id": "viewer-1"}}},
+		// only changed by someone else
+		"id-2": {map[string]any{"id": "h2", "createdAt": recent, "actor": map[string]any{"id": "user-2"}}},
+		// changed by the viewer, but before the window
+		"id-3": {map[string]any{"id": "h3", "createdAt": stale, "actor": map[string]any{"id": "viewer-1"}}},
+	}
+
+	var mu sync.Mutex
+	var filter map[string]any
+	withIssueMockServer(t, func(query string, v map[string]any) any {
+		switch {
+		case strings.Contains(query, "viewer"):
+			return map[string]any{"viewer": map[string]any{"id": "viewer-1", "email": "me@example.com"}}
+		case strings.Contains(query, "IssueHistory"):
+			return map[string]any{"issue": map[string]any{"history": map[string]any{
+				"nodes": history[v["id"].(string)],
+			}}}
+		case strings.Contains(query, "query Issues("):
+			mu.Lock()
+			filter, _ = v["filter"].(map[string]any)
+			mu.Unlock()
+			return map[string]any{"issues": map[string]any{"nodes": []any{
+				map[string]any{"id": "id-1", "identifier": "ENG-1"},
+				map[string]any{"id": "id-2", "identifier": "ENG-2"},
+				map[string]any{"id": "id-3", "identifier": "ENG-3"},
+			}}}
+		}
+		t.Errorf("unexpected query: %s", query)
+		return nil
+	})
+	resetFlags(t, issueListCmd)
+	viper.Set("json", true)
+	_ = issueListCmd.Flags().Set("actor", "me")
+	_ = issueListCmd.Flags().Set("newer-than", "1_week_ago")
+
+	out := captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+
+	var got []map[string]any
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out, err)
+	}
+	if len(got) != 1 || got[0]["identifier"] != "ENG-1" {
+		t.Fatalf("expected only ENG-1, got %v", got)
+	}
+	if _, ok := filter["updatedAt"]; !ok {
+		t.Fatalf("expected the window to apply to updatedAt, got %v", filter)
+	}
+	if _, ok := filter["createdAt"]; ok {
+		t.Fatalf("--actor should not restrict by creation date, got %v", filter)
+	}
+}
+
+func TestActorMatcher_ByEmail(t *testing.T) {
+	m := actorMatcher{email: "Ada@Example.com"}
+	if !m.matches(&api.User{Email: "ada@example.com"}) {
+		t.Fatal("expected a case-insensitive email match")
+	}
+	if m.matches(nil) || m.matches(&api.User{Email: "grace@example.com"}) {
+		t.Fatal("unexpected match")
+	}
+}
+
+// pagedHistory serves history pages newest first and counts the requests.
+type pagedHistory struct {
+	pages [][]api.IssueHistoryEntry
+	calls int
+}
+
+func (h *pagedHistory) GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*api.IssueHistory, error) {
+	page := h.pages[h.calls]
+	h.calls++
+	more := h.calls < len(h.pages)
+	return &api.IssueHistory{Nodes: page, PageInfo: api.PageInfo{HasNextPage: more, EndCursor: "next"}}, nil
+}
+
+func TestIssueTouchedBy_StopsAtEntriesBeforeWindow(t *testing.T) {
+	since := time.Now().AddDate(0, 0, -7)
+	other := &api.User{ID: "user-2"}
+	history := &pagedHistory{pages: [][]api.IssueHistoryEntry{
+		{{CreatedAt: time.Now(), Actor: other}, {CreatedAt: since.AddDate(0, 0, -1), Actor: other}},
+		{{CreatedAt: since.AddDate(0, -1, 0), Actor: &api.User{ID: "viewer-1"}}},
+	}}
+
+	touched, err := issueTouchedBy(context.Background(), history, "id-1", actorMatcher{id: "viewer-1"}, since)
+	if err != nil || touched {
+		t.Fatalf("issueTouchedBy = %v, %v; want false", touched, err)
+	}
+	if history.calls != 1 {
+		t.Fatalf("expected paging to stop at the first entry before the window, got %d requests", history.calls)
+	}
+}
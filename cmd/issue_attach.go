@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var issueAttachCmd = &cobra.Command{
+	Use:   "attach ISSUE-ID",
+	Short: "Attach a URL to an issue, or remove an attachment",
+	Long: `Link an external URL (pull request, design, doc) to an issue as an attachment.
+
+Examples:
+  linctl issue attach LIN-123 --url https://github.com/org/repo/pull/42 --title "PR #42"
+  linctl issue attach LIN-123 --url https://figma.com/file/abc --title "Design"
+  linctl issue attach LIN-123 --remove ATTACHMENT-ID`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		rawURL, _ := cmd.Flags().GetString("url")
+		title, _ := cmd.Flags().GetString("title")
+		subtitle, _ := cmd.Flags().GetString("subtitle")
+		removeID, _ := cmd.Flags().GetString("remove")
+
+		if removeID != "" && rawURL != "" {
+			output.Error("Cannot combine --remove with --url", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if removeID == "" && rawURL == "" {
+			output.Error("Either --url or --remove is required", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		if rawURL != "" {
+			if err := validateAttachmentURL(rawURL); err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		authHeader, err := getIssueAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := newIssueClient(authHeader)
+
+		issue, err := client.GetIssue(context.Background(), args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Issue '%s' not found", args[0]), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		if removeID != "" {
+			if err := client.DeleteAttachment(context.Background(), removeID); err != nil {
+				output.Error(fmt.Sprintf("Failed to remove attachment: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			output.Success(fmt.Sprintf("Removed attachment %s from %s", removeID, issue.Identifier), plaintext, jsonOut)
+			return
+		}
+
+		if title == "" {
+			title = rawURL
+		}
+		input := map[string]interface{}{
+			"issueId": issue.ID,
+			"url":     rawURL,
+			"title":   title,
+		}
+		if subtitle != "" {
+			input["subtitle"] = subtitle
+		}
+
+		attachment, err := client.CreateAttachment(context.Background(), input)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to attach URL: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(attachment)
+			return
+		}
+
+		if plaintext {
+			fmt.Printf("Attached to %s\n", issue.Identifier)
+			fmt.Printf("- **ID**: %s\n", attachment.ID)
+			fmt.Printf("- **Title**: %s\n", attachment.Title)
+			fmt.Printf("- **URL**: %s\n", attachment.URL)
+			return
+		}
+
+		fmt.Printf("%s Attached to %s\n",
+			output.Color(output.RoleSuccess).Sprint("✓"),
+			output.Color(output.RoleIdentifier).Sprint(issue.Identifier))
+		fmt.Printf("  📎 %s - %s\n",
+			attachment.Title,
+			output.Color(output.RoleLink).Sprint(attachment.URL))
+		fmt.Printf("  %s\n", output.Color(output.RoleMuted).Sprintf("ID: %s", attachment.ID))
+	},
+}
+
+// validateAttachmentURL requires an absolute http(s) URL with a host.
+func validateAttachmentURL(raw string) error {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https") {
+		return fmt.Errorf("invalid URL '%s': must be an absolute http(s) URL", raw)
+	}
+	return nil
+}
+
+func init() {
+	issueCmd.AddCommand(issueAttachCmd)
+
+	issueAttachCmd.Flags().String("url", "", "URL to attach (http or https)")
+	issueAttachCmd.Flags().String("title", "", "Attachment title (defaults to the URL)")
+	issueAttachCmd.Flags().String("subtitle", "", "Attachment subtitle")
+	issueAttachCmd.Flags().String("remove", "", "Remove the attachment with this ID instead")
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestValidateAttachmentURL(t *testing.T) {
+	valid := []string{"https://github.com/org/repo/pull/42", "http://example.com", " https://figma.com/file/abc "}
+	invalid := []string{"", "github.com/org/repo", "ftp://example.com/file", "https://", "not a url", "mailto:a@b.c"}
+	for _, u := range valid {
+		if err := validateAttachmentURL(u); err != nil {
+			t.Errorf("expected %q to be valid, got %v", u, err)
+		}
+	}
+	for _, u := range invalid {
+		if err := validateAttachmentURL(u); err == nil {
+			t.Errorf("expected %q to be rejected", u)
+		}
+	}
+}
+
+func TestIssueAttach_CreatesAndEchoesAttachment(t *testing.T) {
+	var input map[string]any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "attachmentCreate"):
+			input, _ = vars["input"].(map[string]any)
+			return map[string]any{"attachmentCreate": map[string]any{"success": true, "attachment": map[string]any{
+				"id": "att-1", "title": input["title"], "url": input["url"],
+			}}}
+		case strings.Contains(query, "query Issue("):
+			return map[string]any{"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7", "title": "Thing"}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueAttachCmd)
+	viper.Set("plaintext", true)
+	_ = issueAttachCmd.Flags().Set("url", "https://github.com/org/repo/pull/42")
+	_ = issueAttachCmd.Flags().Set("title", "PR #42")
+
+	out := captureStdout(t, func() { issueAttachCmd.Run(issueAttachCmd, []string{"ENG-7"}) })
+
+	if input["issueId"] != "issue-1" || input["url"] != "https://github.com/org/repo/pull/42" || input["title"] != "PR #42" {
+		t.Fatalf("unexpected attachmentCreate input: %v", input)
+	}
+	for _, want := range []string{"Attached to ENG-7", "att-1", "PR #42", "https://github.com/org/repo/pull/42"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -1,6 +1,9 @@
 package cmd
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 // These tests exercise Cobra flag parsing on the real command objects
 // without invoking the Run functions (no network/API side-effects).
@@ -45,7 +48,7 @@ func TestIssueUpdateCmd_ProjectFlag_Unassigned(t *testing.T) {
 	}
 
 	// Check helper integration contract
-	if val, ok, err := buildProjectInput(got); err != nil || !ok || val != nil {
+	if val, ok, err := buildProjectInput(context.Background(), nil, got, ""); err != nil || !ok || val != nil {
 		t.Errorf("buildProjectInput('unassigned') => (%v,%v,%v), want (nil,true,nil)", val, ok, err)
 	}
 }
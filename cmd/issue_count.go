@@ -0,0 +1,104 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var issueCountCmd = &cobra.Command{
+	Use:   "count",
+	Short: "Count issues matching filters",
+	Long: `Print the number of issues matching the same filters as 'issue list'.
+
+Linear has no count query, so matching issues are paged through 250 at a
+time and counted. Filters the API can apply fetch only issue IDs, which is
+much faster than listing; label operators, parent and comment/attachment
+filters are applied locally and fetch full issues. With --json the result is
+{"count": N}.
+
+Examples:
+  linctl issue count --team ENG --label bug
+  linctl issue count --mine --state "In Progress"
+  linctl issue count --project "Mobile App" --include-completed --json`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := getIssueAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := newIssueClient(authHeader)
+
+		filter, requiredAllIDs, anyIDs, notIDs, wantUnlabeled, parentID, wantHasParent, wantNoParent := buildIssueFilter(cmd, client)
+		wantHasComments, wantNoComments := issuePresenceFlags(cmd, "comments")
+		wantHasAttachments, wantNoAttachments := issuePresenceFlags(cmd, "attachments")
+		includeArchived, _ := cmd.Flags().GetBool("include-archived")
+
+		clientSide := len(requiredAllIDs) > 0 || len(anyIDs) > 0 || len(notIDs) > 0 || wantUnlabeled ||
+			parentID != "" || wantHasParent || wantNoParent ||
+			wantHasComments || wantNoComments || wantHasAttachments || wantNoAttachments
+
+		var count int
+		if !clientSide {
+			count, err = client.CountIssues(context.Background(), filter, includeArchived)
+		} else {
+			// Some filters are applied locally, so page through full issues
+			// and count the ones that match.
+			progress := output.NewProgress(plaintext, jsonOut)
+			count, err = countMatchingIssues(progress, func(first int, after string) (*api.Issues, error) {
+				return client.GetIssues(context.Background(), filter, first, after, "", includeArchived, api.IssueListOptions{Presence: wantHasComments || wantNoComments || wantHasAttachments || wantNoAttachments})
+			}, func(page *api.Issues) *api.Issues {
+				page = filterIssuesAdvanced(page, requiredAllIDs, anyIDs, notIDs, wantUnlabeled)
+				page = filterIssuesByParent(page, parentID, wantHasParent, wantNoParent)
+				page = filterIssuesByComments(page, wantHasComments, wantNoComments)
+				return filterIssuesByAttachments(page, wantHasAttachments, wantNoAttachments)
+			})
+			progress.Stop()
+		}
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to count issues: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		if jsonOut {
+			output.JSON(map[string]int{"count": count})
+			return
+		}
+		fmt.Println(count)
+	},
+}
+
+// countMatchingIssues pages through every result and counts the issues that
+// survive keep.
+func countMatchingIssues(progress *output.Progress, fetch func(first int, after string) (*api.Issues, error), keep func(*api.Issues) *api.Issues) (int, error) {
+	count := 0
+	after := ""
+	for n := 1; ; n++ {
+		progress.Step("Fetching page %d…", n)
+		page, err := fetch(fetchAllPageSize, after)
+		if err != nil {
+			return 0, err
+		}
+		count += len(keep(page).Nodes)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return count, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+func init() {
+	issueCmd.AddCommand(issueCountCmd)
+
+	addIssueFilterFlags(issueCountCmd)
+}
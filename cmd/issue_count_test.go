@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestIssueCount_PagesIDOnlyQuery(t *testing.T) {
+	var filter map[string]any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if !strings.Contains(query, "IssueCount") {
+			t.Fatalf("expected the lightweight count query, got %s", query)
+		}
+		filter, _ = vars["filter"].(map[string]any)
+		if vars["after"] == nil {
+			return map[string]any{"issues": map[string]any{
+				"nodes":    []any{map[string]any{"id": "a"}, map[string]any{"id": "b"}},
+				"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+			}}
+		}
+		return map[string]any{"issues": map[string]any{
+			"nodes":    []any{map[string]any{"id": "c"}},
+			"pageInfo": map[string]any{"hasNextPage": false},
+		}}
+	})
+	resetFlags(t, issueCountCmd)
+	_ = issueCountCmd.Flags().Set("team", "ENG")
+
+	out := captureStdout(t, func() { issueCountCmd.Run(issueCountCmd, nil) })
+	if out != "3\n" {
+		t.Fatalf("expected a bare count of 3, got %q", out)
+	}
+	if _, ok := filter["team"]; !ok {
+		t.Fatalf("expected the --team filter to be applied, got %v", filter)
+	}
+
+	viper.Set("json", true)
+	out = captureStdout(t, func() { issueCountCmd.Run(issueCountCmd, nil) })
+	if strings.Join(strings.Fields(out), "") != `{"count":3}` {
+		t.Fatalf("unexpected JSON output: %q", out)
+	}
+}
+
+func TestIssueCount_AppliesClientSideFilters(t *testing.T) {
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if !strings.Contains(query, "query Issues(") {
+			t.Fatalf("expected full issue pages for client-side filters, got %s", query)
+		}
+		withComment := map[string]any{"nodes": []any{map[string]any{"id": "c1"}}}
+		return map[string]any{"issues": map[string]any{
+			"nodes": []any{
+				map[string]any{"id": "1", "comments": withComment},
+				map[string]any{"id": "2", "comments": map[string]any{"nodes": []any{}}},
+				map[string]any{"id": "3", "comments": withComment},
+			},
+			"pageInfo": map[string]any{"hasNextPage": false},
+		}}
+	})
+	resetFlags(t, issueCountCmd)
+	_ = issueCountCmd.Flags().Set("has-comments", "true")
+
+	out := captureStdout(t, func() { issueCountCmd.Run(issueCountCmd, nil) })
+	if out != "2\n" {
+		t.Fatalf("expected 2 issues with comments, got %q", out)
+	}
+}
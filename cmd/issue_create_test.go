@@ -0,0 +1,385 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// withIssueMockServer points the issue commands at an httptest GraphQL server.
+// handler receives the query text and variables and returns the "data" payload.
+func withIssueMockServer(t *testing.T, handler func(query string, vars map[string]any) any) {
+	t.Helper()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string         `json:"query"`
+			Variables map[string]any `json:"variables"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": handler(body.Query, body.Variables)})
+	}))
+	origClient, origAuth := newIssueClient, getIssueAuthHeader
+	newIssueClient = func(string) *api.Client { return api.NewClientWithURL(srv.URL, "Bearer test") }
+	getIssueAuthHeader = func() (string, error) { return "Bearer test", nil }
+	t.Cleanup(func() {
+		newIssueClient, getIssueAuthHeader = origClient, origAuth
+		srv.Close()
+		viper.Set("plaintext", false)
+		viper.Set("json", false)
+	})
+}
+
+// resetFlags restores every flag on c to its default value.
+func resetFlags(t *testing.T, c *cobra.Command) {
+	t.Helper()
+	c.Flags().VisitAll(func(f *pflag.Flag) {
+		if sv, ok := f.Value.(pflag.SliceValue); ok {
+			_ = sv.Replace(nil)
+		} else {
+			_ = f.Value.Set(f.DefValue)
+		}
+		f.Changed = false
+	})
+}
+
+// executeCommand runs args through rootCmd, so cobra's own checks (required
+// flags, argument counts) and the persistent pre-run apply as they do for the
+// binary. It returns stdout and the error from Execute; the flags of the
+// command that runs start at their defaults and are reset again afterwards.
+// The config file is the test's withConfigFile one if it set one up, and an
+// empty temporary one otherwise, so ~/.linctl.yaml never leaks in.
+func executeCommand(t *testing.T, args ...string) (string, error) {
+	t.Helper()
+	if cfgFile == "" {
+		withConfigFile(t)
+	}
+	args = append(args, "--config", cfgFile)
+	target, _, err := rootCmd.Find(args)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resetFlags(t, target)
+	t.Cleanup(func() { resetFlags(t, target) })
+	rootCmd.SetArgs(args)
+	t.Cleanup(func() { rootCmd.SetArgs(nil) })
+	var execErr error
+	out := captureStdout(t, func() { execErr = rootCmd.Execute() })
+	return out, execErr
+}
+
+func assignedIssueCreateHandler(query string, vars map[string]any) any {
+	switch {
+	case strings.Contains(query, "TeamByKey"):
+		return map[string]any{"teams": map[string]any{"nodes": []any{
+			map[string]any{"id": "team-1", "key": "ENG", "name": "Engineering"},
+		}}}
+	case strings.Contains(query, "issueCreate"):
+		return map[string]any{"issueCreate": map[string]any{"issue": map[string]any{
+			"id":         "issue-1",
+			"identifier": "ENG-42",
+			"title":      "Fix login",
+			"priority":   2,
+			"assignee":   map[string]any{"id": "u1", "name": "Default Owner", "email": "owner@example.com"},
+			"labels": map[string]any{"nodes": []any{
+				map[string]any{"id": "L1", "name": "bug"},
+			}},
+		}}}
+	}
+	return map[string]any{}
+}
+
+func TestIssueCreate_ShowsAssigneeInAllModes(t *testing.T) {
+	for _, mode := range []string{"plaintext", "rich"} {
+		t.Run(mode, func(t *testing.T) {
+			withIssueMockServer(t, assignedIssueCreateHandler)
+			resetFlags(t, issueCreateCmd)
+			viper.Set("plaintext", mode == "plaintext")
+			viper.Set("json", false)
+			_ = issueCreateCmd.Flags().Set("title", "Fix login")
+			_ = issueCreateCmd.Flags().Set("team", "ENG")
+
+			out := captureStdout(t, func() { issueCreateCmd.Run(issueCreateCmd, nil) })
+			for _, want := range []string{"ENG-42", "Default Owner", "High", "bug"} {
+				if !strings.Contains(out, want) {
+					t.Fatalf("%s output missing %q:\n%s", mode, want, out)
+				}
+			}
+		})
+	}
+}
+
+func TestIssueCreate_AssigneeResolvesTeammate(t *testing.T) {
+	var assigneeID any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "query Users("):
+			return map[string]any{"users": map[string]any{"nodes": []any{
+				map[string]any{"id": "u-ada", "name": "Ada Lovelace", "email": "ada@example.com"},
+				map[string]any{"id": "u-grace", "name": "Grace Hopper", "email": "grace@example.com"},
+			}}}
+		case strings.Contains(query, "issueCreate"):
+			input, _ := vars["input"].(map[string]any)
+			assigneeID = input["assigneeId"]
+		}
+		return assignedIssueCreateHandler(query, vars)
+	})
+	resetFlags(t, issueCreateCmd)
+	viper.Set("plaintext", true)
+	_ = issueCreateCmd.Flags().Set("title", "Fix login")
+	_ = issueCreateCmd.Flags().Set("team", "ENG")
+	_ = issueCreateCmd.Flags().Set("assignee", "grace@example.com")
+
+	captureStdout(t, func() { issueCreateCmd.Run(issueCreateCmd, nil) })
+	if assigneeID != "u-grace" {
+		t.Fatalf("expected the issue to be created for Grace, got assigneeId=%v", assigneeID)
+	}
+
+	_, err := buildIssueCreateInput(context.Background(), newIssueClient(""), issueCreateSpec{Title: "x", Team: "ENG", Assignee: "nobody@example.com"}, false, output.NewProgress(true, false))
+	if err == nil || !strings.Contains(err.Error(), "User not found: nobody@example.com") {
+		t.Fatalf("expected a clear error for an unknown user, got %v", err)
+	}
+	_, err = buildIssueCreateInput(context.Background(), newIssueClient(""), issueCreateSpec{Title: "x", Team: "ENG", Assignee: "ada@example.com", AssignMe: true}, false, output.NewProgress(true, false))
+	if err == nil || !strings.Contains(err.Error(), "assign-me") {
+		t.Fatalf("expected assignee and assign-me to conflict, got %v", err)
+	}
+}
+
+func TestIssueCreate_InitialState(t *testing.T) {
+	var stateID any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamStates"):
+			return map[string]any{"team": map[string]any{"states": map[string]any{"nodes": []any{
+				map[string]any{"id": "st-todo", "name": "Todo", "type": "unstarted"},
+				map[string]any{"id": "st-backlog", "name": "Backlog", "type": "backlog"},
+			}}}}
+		case strings.Contains(query, "issueCreate"):
+			input, _ := vars["input"].(map[string]any)
+			stateID = input["stateId"]
+		}
+		return assignedIssueCreateHandler(query, vars)
+	})
+	resetFlags(t, issueCreateCmd)
+	t.Cleanup(func() { resetFlags(t, issueCreateCmd) })
+	viper.Set("plaintext", true)
+	_ = issueCreateCmd.Flags().Set("title", "Fix login")
+	_ = issueCreateCmd.Flags().Set("team", "ENG")
+	_ = issueCreateCmd.Flags().Set("state", "backlog")
+
+	captureStdout(t, func() { issueCreateCmd.Run(issueCreateCmd, nil) })
+	if stateID != "st-backlog" {
+		t.Fatalf("expected stateId st-backlog, got %v", stateID)
+	}
+
+	_, err := buildIssueCreateInput(context.Background(), newIssueClient(""), issueCreateSpec{Title: "x", Team: "ENG", State: "Doing"}, false, output.NewProgress(true, false))
+	if err == nil || !strings.Contains(err.Error(), "State 'Doing' not found. Available states: Todo, Backlog") {
+		t.Fatalf("expected the available states in the error, got %v", err)
+	}
+}
+
+func TestCheckParentTeam(t *testing.T) {
+	parent := &api.Issue{Identifier: "OPS-1", Team: &api.Team{ID: "team-ops", Key: "OPS"}}
+	eng := &api.Team{ID: "team-eng", Key: "ENG"}
+	ops := &api.Team{ID: "team-ops", Key: "OPS"}
+
+	if warning, err := checkParentTeam(parent, ops, true); warning != "" || err != nil {
+		t.Fatalf("matching teams: got warning=%q err=%v", warning, err)
+	}
+	if warning, err := checkParentTeam(nil, eng, true); warning != "" || err != nil {
+		t.Fatalf("no parent: got warning=%q err=%v", warning, err)
+	}
+
+	warning, err := checkParentTeam(parent, eng, false)
+	if err != nil || !strings.Contains(warning, "ENG") || !strings.Contains(warning, "OPS-1") {
+		t.Fatalf("mismatch: got warning=%q err=%v", warning, err)
+	}
+
+	_, err = checkParentTeam(parent, eng, true)
+	if err == nil || !strings.Contains(err.Error(), "--strict") {
+		t.Fatalf("mismatch under --strict: expected error, got %v", err)
+	}
+}
+
+func TestIssueCreate_DefaultsToParentTeam(t *testing.T) {
+	var createdTeamID any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "query Issue("):
+			return map[string]any{"issue": map[string]any{
+				"id": "parent-1", "identifier": "OPS-1", "title": "Parent",
+				"team": map[string]any{"id": "team-ops", "key": "OPS", "name": "Operations"},
+			}}
+		case strings.Contains(query, "TeamByKey"):
+			if vars["key"] != "OPS" {
+				t.Errorf("expected team lookup for OPS, got %v", vars["key"])
+			}
+			return map[string]any{"teams": map[string]any{"nodes": []any{
+				map[string]any{"id": "team-ops", "key": "OPS", "name": "Operations"},
+			}}}
+		case strings.Contains(query, "issueCreate"):
+			input, _ := vars["input"].(map[string]any)
+			createdTeamID = input["teamId"]
+			return map[string]any{"issueCreate": map[string]any{"issue": map[string]any{
+				"id": "child-1", "identifier": "OPS-2", "title": "Child",
+			}}}
+		}
+		return map[string]any{}
+	})
+
+	out, err := executeCommand(t, "issue", "create", "--title", "Child", "--parent", "OPS-1", "--plaintext")
+	if err != nil {
+		t.Fatalf("issue create without --team failed: %v", err)
+	}
+	if createdTeamID != "team-ops" {
+		t.Fatalf("expected child to default to parent's team, got %v", createdTeamID)
+	}
+	if !strings.Contains(out, "OPS-2") {
+		t.Fatalf("unexpected output:\n%s", out)
+	}
+}
+
+func TestIssueCreate_FromSpecFileWithoutTitleOrTeamFlags(t *testing.T) {
+	var titles []any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamByKey"):
+			return map[string]any{"teams": map[string]any{"nodes": []any{
+				map[string]any{"id": "team-1", "key": "ENG", "name": "Engineering"},
+			}}}
+		case strings.Contains(query, "issueCreate"):
+			input, _ := vars["input"].(map[string]any)
+			titles = append(titles, input["title"])
+			return map[string]any{"issueCreate": map[string]any{"issue": map[string]any{
+				"id": "i", "identifier": fmt.Sprintf("ENG-%d", len(titles)), "title": input["title"],
+			}}}
+		}
+		return map[string]any{}
+	})
+	path := filepath.Join(t.TempDir(), "issues.yaml")
+	_ = os.WriteFile(path, []byte("- title: First\n  team: ENG\n- title: Second\n  team: ENG\n"), 0600)
+
+	out, err := executeCommand(t, "issue", "create", "--from", path, "--plaintext")
+	if err != nil {
+		t.Fatalf("issue create --from failed: %v", err)
+	}
+	if len(titles) != 2 || !strings.Contains(out, "Created 2 of 2 issues") {
+		t.Fatalf("expected both spec entries to be created, got %v\n%s", titles, out)
+	}
+}
+
+func TestResolveParentIssue_UUIDSkipsLookup(t *testing.T) {
+	const uuid = "0b7c2f0e-4a7d-4a8e-9d8a-1f2e3d4c5b6a"
+	calls := 0
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		calls++
+		return map[string]any{"issue": map[string]any{"id": "resolved-id", "identifier": "RAE-1"}}
+	})
+	client := newIssueClient("")
+
+	p, err := resolveParentIssue(context.Background(), client, uuid, false)
+	if err != nil || p.ID != uuid || calls != 0 {
+		t.Fatalf("UUID parent: got %+v, err=%v, calls=%d", p, err, calls)
+	}
+
+	p, err = resolveParentIssue(context.Background(), client, "RAE-1", false)
+	if err != nil || p.ID != "resolved-id" || calls != 1 {
+		t.Fatalf("identifier parent: got %+v, err=%v, calls=%d", p, err, calls)
+	}
+}
+
+func TestLoadIssueSpecs_SingleAndList(t *testing.T) {
+	dir := t.TempDir()
+	single := filepath.Join(dir, "single.yaml")
+	_ = os.WriteFile(single, []byte("title: One\nteam: ENG\nlabels: [bug, api]\npriority: 1\n"), 0600)
+	list := filepath.Join(dir, "list.json")
+	_ = os.WriteFile(list, []byte(`[{"title": "A", "team": "ENG"}, {"title": "B", "due_date": "2025-01-31"}]`), 0600)
+
+	specs, err := loadIssueSpecs(single)
+	if err != nil || len(specs) != 1 {
+		t.Fatalf("single: got %v, err=%v", specs, err)
+	}
+	if specs[0].Title != "One" || len(specs[0].Labels) != 2 || specs[0].Priority == nil || *specs[0].Priority != 1 {
+		t.Fatalf("single: unexpected spec %+v", specs[0])
+	}
+
+	specs, err = loadIssueSpecs(list)
+	if err != nil || len(specs) != 2 {
+		t.Fatalf("list: got %v, err=%v", specs, err)
+	}
+	if specs[1].Title != "B" || specs[1].DueDate != "2025-01-31" {
+		t.Fatalf("list: unexpected spec %+v", specs[1])
+	}
+}
+
+func TestIssueCreate_FromSpecFileReportsPerIssueResults(t *testing.T) {
+	created := 0
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamByKey"):
+			return map[string]any{"teams": map[string]any{"nodes": []any{
+				map[string]any{"id": "team-1", "key": "ENG", "name": "Engineering"},
+			}}}
+		case strings.Contains(query, "issueCreate"):
+			created++
+			input, _ := vars["input"].(map[string]any)
+			return map[string]any{"issueCreate": map[string]any{"issue": map[string]any{
+				"id": "i", "identifier": fmt.Sprintf("ENG-%d", created), "title": input["title"],
+			}}}
+		}
+		return map[string]any{}
+	})
+
+	path := filepath.Join(t.TempDir(), "issues.yaml")
+	_ = os.WriteFile(path, []byte("- title: First\n- title: Second\n  due_date: not-a-date\n- title: Third\n"), 0600)
+
+	viper.Set("plaintext", true)
+	specs, err := loadIssueSpecs(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range specs {
+		specs[i].Team = "ENG"
+	}
+	var failed int
+	out := captureStdout(t, func() {
+		failed = createIssuesFromSpecs(newIssueClient(""), specs, false, true, false)
+	})
+	if failed != 1 || created != 2 {
+		t.Fatalf("expected 1 failure and 2 creations, got failed=%d created=%d\n%s", failed, created, out)
+	}
+	for _, want := range []string{"Created issue ENG-1: First", "Failed [2] Second", "Created issue ENG-2: Third", "Created 2 of 3 issues"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("output missing %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestRenderIssueCreateResults_ReportsExistingIssues(t *testing.T) {
+	results := []issueCreateResult{
+		{Index: 1, Title: "First", Identifier: "ENG-1"},
+		{Index: 2, Title: "Second", Identifier: "ENG-2", Existing: true},
+	}
+	var failed int
+	out := captureStdout(t, func() { failed = renderIssueCreateResults(results, true, false) })
+	if failed != 0 {
+		t.Fatalf("expected no failures, got %d", failed)
+	}
+	for _, want := range []string{"Created issue ENG-1: First", "Found existing issue ENG-2: Second", "Created 1 of 2 issues (1 already existed)"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+)
+
+// URL shapes of pull/merge requests and commits on GitHub, GitLab and
+// Bitbucket.
+var (
+	pullRequestURLPattern = regexp.MustCompile(`/(?:pull|merge_requests|pull-requests)/[0-9]+(?:$|[/?#])`)
+	commitURLPattern      = regexp.MustCompile(`/commits?/[0-9a-fA-F]{7,40}(?:$|[/?#])`)
+)
+
+// linkedDevItem is a pull request or commit attached to an issue.
+type linkedDevItem struct {
+	Kind   string // "PR" or "Commit"
+	Title  string
+	URL    string
+	Status string // e.g. open, draft, merged or closed; "" when unknown
+}
+
+// linkedDevelopment picks the pull requests and commits out of an issue's
+// attachments, recognized by URL or by the integration's sourceType. The
+// status comes from the integration metadata when Linear provides it.
+func linkedDevelopment(attachments *api.Attachments) []linkedDevItem {
+	if attachments == nil {
+		return nil
+	}
+	var items []linkedDevItem
+	for _, a := range attachments.Nodes {
+		kind := ""
+		sourceType := strings.ToLower(fmt.Sprint(a.Extra["sourceType"]))
+		switch {
+		case commitURLPattern.MatchString(a.URL) || strings.Contains(sourceType, "commit"):
+			kind = "Commit"
+		case pullRequestURLPattern.MatchString(a.URL) || strings.Contains(sourceType, "pullrequest") || strings.Contains(sourceType, "mergerequest"):
+			kind = "PR"
+		default:
+			continue
+		}
+		items = append(items, linkedDevItem{
+			Kind:   kind,
+			Title:  a.Title,
+			URL:    a.URL,
+			Status: attachmentStatus(a.Metadata),
+		})
+	}
+	return items
+}
+
+// attachmentStatus reads a PR's state from integration metadata.
+func attachmentStatus(metadata map[string]interface{}) string {
+	if draft, _ := metadata["draft"].(bool); draft {
+		return "draft"
+	}
+	for _, key := range []string{"status", "state"} {
+		if s, ok := metadata[key].(string); ok && s != "" {
+			return strings.ToLower(s)
+		}
+	}
+	if merged, _ := metadata["merged"].(bool); merged {
+		return "merged"
+	}
+	return ""
+}
+
+// devStatusColor colors a PR status: merged work is done, open work needs
+// attention and closed work is of secondary interest.
+func devStatusColor(status string) output.Role {
+	switch status {
+	case "merged":
+		return output.RoleSuccess
+	case "open", "draft", "inreview", "in_review", "approved":
+		return output.RoleWarning
+	}
+	return output.RoleMuted
+}
@@ -0,0 +1,44 @@
+package cmd
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func TestLinkedDevelopment(t *testing.T) {
+	var attachments api.Attachments
+	if err := json.Unmarshal([]byte(`{"nodes": [
+		{"title": "Fix redirect", "url": "https://github.com/acme/web/pull/118", "metadata": {"status": "merged"}},
+		{"title": "Draft refactor", "url": "https://github.com/acme/web/pull/120", "metadata": {"draft": true, "status": "open"}},
+		{"title": "a1b2c3d Guard redirect", "url": "https://github.com/acme/web/commit/a1b2c3d4e5"},
+		{"title": "MR !7", "url": "https://gitlab.com/acme/api/-/merge_requests/7"},
+		{"title": "Synced PR", "url": "https://git.internal/acme/web/change/9", "sourceType": "githubPullRequest"},
+		{"title": "Design doc", "url": "https://docs.example.com/login"},
+		{"title": "Pull list", "url": "https://github.com/acme/web/pulls"}
+	]}`), &attachments); err != nil {
+		t.Fatal(err)
+	}
+
+	got := linkedDevelopment(&attachments)
+	want := []linkedDevItem{
+		{Kind: "PR", Title: "Fix redirect", URL: "https://github.com/acme/web/pull/118", Status: "merged"},
+		{Kind: "PR", Title: "Draft refactor", URL: "https://github.com/acme/web/pull/120", Status: "draft"},
+		{Kind: "Commit", Title: "a1b2c3d Guard redirect", URL: "https://github.com/acme/web/commit/a1b2c3d4e5"},
+		{Kind: "PR", Title: "MR !7", URL: "https://gitlab.com/acme/api/-/merge_requests/7"},
+		{Kind: "PR", Title: "Synced PR", URL: "https://git.internal/acme/web/change/9"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d items, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("item %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+
+	if linkedDevelopment(nil) != nil {
+		t.Fatal("expected no items without attachments")
+	}
+}
@@ -0,0 +1,182 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+)
+
+// issueFieldDiff is one field 'issue update --dry-run' would change.
+type issueFieldDiff struct {
+	Field  string `json:"field"`
+	Before string `json:"before"`
+	After  string `json:"after"`
+}
+
+// issueUpdateNames holds display values for the fields the update input
+// only has IDs for, collected while the flags are resolved.
+type issueUpdateNames struct {
+	state    string
+	assignee string
+	cycle    string
+	project  string
+	parent   string
+	labels   map[string]string // label ID → name, for labels being set or added
+}
+
+// diffIssueUpdate compares issue with what input would make of it, returning
+// the fields whose value changes. Empty values are shown as "(none)".
+func diffIssueUpdate(issue *api.Issue, input map[string]interface{}, names issueUpdateNames) []issueFieldDiff {
+	var diffs []issueFieldDiff
+	none := func(s string) string {
+		if s == "" {
+			return "(none)"
+		}
+		return s
+	}
+	add := func(field, before, after string) {
+		if before, after = none(before), none(after); before != after {
+			diffs = append(diffs, issueFieldDiff{Field: field, Before: before, After: after})
+		}
+	}
+	// Related entities change when their ID does, whatever the flag said:
+	// --assignee ada@x.com on an issue Ada already has is no change.
+	addRef := func(field, beforeID, before string, afterID interface{}, after string) {
+		if id, _ := afterID.(string); id != beforeID {
+			diffs = append(diffs, issueFieldDiff{Field: field, Before: none(before), After: none(after)})
+		}
+	}
+	oneLine := func(s string) string {
+		return truncateString(strings.Join(strings.Fields(s), " "), 60)
+	}
+
+	if v, ok := input["title"].(string); ok {
+		add("Title", issue.Title, v)
+	}
+	if v, ok := input["description"].(string); ok && v != issue.Description {
+		add("Description", oneLine(issue.Description), oneLine(v))
+	}
+	if v, ok := input["stateId"]; ok {
+		beforeID, before := "", ""
+		if issue.State != nil {
+			beforeID, before = issue.State.ID, issue.State.Name
+		}
+		addRef("State", beforeID, before, v, names.state)
+	}
+	if v, ok := input["assigneeId"]; ok {
+		beforeID, before := "", ""
+		if issue.Assignee != nil {
+			beforeID, before = issue.Assignee.ID, issue.Assignee.Name
+		}
+		addRef("Assignee", beforeID, before, v, names.assignee)
+	}
+	if v, ok := input["priority"].(int); ok {
+		add("Priority", priorityToString(issue.Priority), priorityToString(v))
+	}
+	if v, ok := input["cycleId"]; ok {
+		beforeID, before := "", ""
+		if issue.Cycle != nil {
+			beforeID, before = issue.Cycle.ID, cycleDisplayName(issue.Cycle)
+		}
+		addRef("Cycle", beforeID, before, v, names.cycle)
+	}
+	if v, ok := input["dueDate"]; ok {
+		before, after := "", ""
+		if issue.DueDate != nil {
+			before = *issue.DueDate
+		}
+		if s, ok := v.(string); ok {
+			after = s
+		}
+		add("Due date", before, after)
+	}
+	if v, ok := input["projectId"]; ok {
+		beforeID, before := "", ""
+		if issue.Project != nil {
+			beforeID, before = issue.Project.ID, issue.Project.Name
+		}
+		addRef("Project", beforeID, before, v, names.project)
+	}
+	if v, ok := input["parentId"]; ok {
+		beforeID, before := "", ""
+		if issue.Parent != nil {
+			beforeID, before = issue.Parent.ID, issue.Parent.Identifier
+		}
+		addRef("Parent", beforeID, before, v, names.parent)
+	}
+
+	setIDs, set := input["labelIds"].([]string)
+	addedIDs, _ := input["addedLabelIds"].([]string)
+	removedIDs, _ := input["removedLabelIds"].([]string)
+	if set || len(addedIDs) > 0 || len(removedIDs) > 0 {
+		var before, after []string
+		current := map[string]bool{}
+		if issue.Labels != nil {
+			for _, l := range issue.Labels.Nodes {
+				before = append(before, l.Name)
+				current[l.ID] = true
+			}
+		}
+		if set {
+			for _, id := range setIDs {
+				after = append(after, names.labels[id])
+			}
+		} else {
+			removed := map[string]bool{}
+			for _, id := range removedIDs {
+				removed[id] = true
+			}
+			if issue.Labels != nil {
+				for _, l := range issue.Labels.Nodes {
+					if !removed[l.ID] {
+						after = append(after, l.Name)
+					}
+				}
+			}
+			for _, id := range addedIDs {
+				if !current[id] && !removed[id] {
+					after = append(after, names.labels[id])
+				}
+			}
+		}
+		add("Labels", strings.Join(before, ", "), strings.Join(after, ", "))
+	}
+	return diffs
+}
+
+// renderIssueDiff prints what 'issue update --dry-run' would change: a JSON
+// object under --json, plain "before → after" lines under --plaintext and
+// the old value in red and the new one in green otherwise.
+func renderIssueDiff(identifier string, diffs []issueFieldDiff, plaintext, jsonOut bool) {
+	if jsonOut {
+		if diffs == nil {
+			diffs = []issueFieldDiff{}
+		}
+		output.JSON(map[string]interface{}{
+			"issue":   identifier,
+			"dryRun":  true,
+			"changes": diffs,
+		})
+		return
+	}
+	if len(diffs) == 0 {
+		fmt.Printf("Dry run: %s would not change\n", identifier)
+		return
+	}
+	if plaintext {
+		fmt.Printf("Dry run: %s would change\n", identifier)
+		for _, d := range diffs {
+			fmt.Printf("- %s: %s → %s\n", d.Field, d.Before, d.After)
+		}
+		return
+	}
+	fmt.Printf("Dry run: %s would change\n", output.Color(output.RoleIdentifier).Sprint(identifier))
+	for _, d := range diffs {
+		fmt.Printf("  %s %s → %s\n",
+			output.Color(output.RoleLabel).Sprint(d.Field+":"),
+			output.Color(output.RoleError).Sprint(d.Before),
+			output.Color(output.RoleSuccess).Sprint(d.After))
+	}
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+func TestDiffIssueUpdate(t *testing.T) {
+	issue := &api.Issue{
+		Title:    "Old title",
+		Priority: 3,
+		State:    &api.State{ID: "st-1", Name: "Todo"},
+		Assignee: &api.User{ID: "u-ada", Name: "Ada"},
+		Labels:   &api.Labels{Nodes: []api.Label{{ID: "l-bug", Name: "bug"}, {ID: "l-ui", Name: "ui"}}},
+	}
+	input := map[string]interface{}{
+		"title":           "Old title", // unchanged, so not listed
+		"stateId":         "st-2",
+		"assigneeId":      nil,
+		"priority":        1,
+		"addedLabelIds":   []string{"l-perf", "l-bug"},
+		"removedLabelIds": []string{"l-ui"},
+		"parentId":        "p-1",
+	}
+	names := issueUpdateNames{state: "In Progress", parent: "ENG-1", labels: map[string]string{"l-perf": "perf", "l-bug": "bug"}}
+
+	got := diffIssueUpdate(issue, input, names)
+	want := []issueFieldDiff{
+		{Field: "State", Before: "Todo", After: "In Progress"},
+		{Field: "Assignee", Before: "Ada", After: "(none)"},
+		{Field: "Priority", Before: "Normal", After: "Urgent"},
+		{Field: "Parent", Before: "(none)", After: "ENG-1"},
+		{Field: "Labels", Before: "bug, ui", After: "bug, perf"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("diffIssueUpdate =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestDiffIssueUpdate_SameEntityIsNoChange(t *testing.T) {
+	issue := &api.Issue{
+		Assignee: &api.User{ID: "u-ada", Name: "Ada"},
+		Cycle:    &api.Cycle{ID: "c-12", Number: 12, Name: "Sprint 12"},
+		Project:  &api.Project{ID: "p-web", Name: "Website"},
+		Parent:   &api.Issue{ID: "i-1", Identifier: "ENG-1"},
+	}
+	// As resolved from --assignee ada@x.com, --cycle current, --project <UUID>
+	// and --parent eng-1: the same entities the issue already has
+	input := map[string]interface{}{"assigneeId": "u-ada", "cycleId": "c-12", "projectId": "p-web", "parentId": "i-1"}
+	names := issueUpdateNames{assignee: "Ada", cycle: "Sprint 12", project: "Website", parent: "ENG-1"}
+	if got := diffIssueUpdate(issue, input, names); len(got) != 0 {
+		t.Fatalf("expected no changes, got %+v", got)
+	}
+
+	input = map[string]interface{}{"assigneeId": "u-bob", "cycleId": nil, "projectId": "p-app"}
+	names = issueUpdateNames{assignee: "Bob", project: "Mobile App"}
+	want := []issueFieldDiff{
+		{Field: "Assignee", Before: "Ada", After: "Bob"},
+		{Field: "Cycle", Before: "Sprint 12", After: "(none)"},
+		{Field: "Project", Before: "Website", After: "Mobile App"},
+	}
+	if got := diffIssueUpdate(issue, input, names); !reflect.DeepEqual(got, want) {
+		t.Fatalf("diffIssueUpdate =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestIssueUpdate_DryRunResolvesNames(t *testing.T) {
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "query Issue("):
+			return map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-7", "title": "Old", "team": map[string]any{"key": "ENG"},
+				"assignee": map[string]any{"id": "u-ada", "name": "Ada", "email": "ada@x.com"},
+				"cycle":    map[string]any{"id": "c-12", "number": 12, "name": "Sprint 12"},
+			}}
+		case strings.Contains(query, "Users"):
+			return map[string]any{"users": map[string]any{"nodes": []any{
+				map[string]any{"id": "u-ada", "name": "Ada", "email": "ada@x.com"},
+			}}}
+		case strings.Contains(query, "TeamActiveCycle"):
+			return map[string]any{"team": map[string]any{"activeCycle": map[string]any{"id": "c-12", "number": 12, "name": "Sprint 12"}}}
+		case strings.Contains(query, "query Project("):
+			return map[string]any{"project": map[string]any{"id": vars["id"], "name": "Mobile App"}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueUpdateCmd)
+	viper.Set("plaintext", true)
+	_ = issueUpdateCmd.Flags().Set("assignee", "ada@x.com")
+	_ = issueUpdateCmd.Flags().Set("cycle", "current")
+	_ = issueUpdateCmd.Flags().Set("project", "0b7c2f0e-4a7d-4a8e-9d8a-1f2e3d4c5b6a")
+	_ = issueUpdateCmd.Flags().Set("dry-run", "true")
+
+	out := captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+	want := "Dry run: ENG-7 would change\n- Project: (none) → Mobile App\n"
+	if out != want {
+		t.Fatalf("unexpected diff:\n%q\nwant\n%q", out, want)
+	}
+}
+
+func TestIssueUpdate_DryRunShowsDiffWithoutUpdating(t *testing.T) {
+	updated := false
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "issueUpdate"):
+			updated = true
+		case strings.Contains(query, "query Issue("):
+			return map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-7", "title": "Old title", "team": map[string]any{"key": "ENG"},
+				"labels": map[string]any{"nodes": []any{map[string]any{"id": "l-bug", "name": "bug"}}},
+			}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueUpdateCmd)
+	viper.Set("json", true)
+	_ = issueUpdateCmd.Flags().Set("title", "New title")
+	_ = issueUpdateCmd.Flags().Set("label", "")
+	_ = issueUpdateCmd.Flags().Set("dry-run", "true")
+
+	out := captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+	if updated {
+		t.Fatal("--dry-run must not update the issue")
+	}
+	var got struct {
+		Issue   string           `json:"issue"`
+		DryRun  bool             `json:"dryRun"`
+		Changes []issueFieldDiff `json:"changes"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	want := []issueFieldDiff{
+		{Field: "Title", Before: "Old title", After: "New title"},
+		{Field: "Labels", Before: "bug", After: "(none)"},
+	}
+	if got.Issue != "ENG-7" || !got.DryRun || !reflect.DeepEqual(got.Changes, want) {
+		t.Fatalf("unexpected dry-run output: %+v", got)
+	}
+
+	// Plaintext keeps the diff free of color codes
+	viper.Set("json", false)
+	viper.Set("plaintext", true)
+	out = captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+	wantText := "Dry run: ENG-7 would change\n- Title: Old title → New title\n- Labels: bug → (none)\n"
+	if out != wantText {
+		t.Fatalf("unexpected plaintext diff:\n%q\nwant\n%q", out, wantText)
+	}
+}
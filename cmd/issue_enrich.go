@@ -0,0 +1,51 @@
+package cmd
+
+import (
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+// enrichedIssue is an issue plus the derived fields added by --enrich. The
+// embedded issue keeps the default JSON shape; the extra fields are appended.
+type enrichedIssue struct {
+	api.Issue
+	IsOverdue     bool   `json:"isOverdue"`
+	AgeDays       int    `json:"ageDays"`
+	AssigneeEmail string `json:"assigneeEmail"`
+}
+
+// enrichIssue computes the --enrich fields as of now. An issue is overdue once
+// its due date has passed in now's time zone (not on the due date itself) and
+// it is neither completed nor canceled.
+func enrichIssue(issue api.Issue, now time.Time) enrichedIssue {
+	e := enrichedIssue{Issue: issue}
+	if issue.DueDate != nil && *issue.DueDate != "" && !issueIsClosed(issue) {
+		if due, err := time.ParseInLocation("2006-01-02", *issue.DueDate, now.Location()); err == nil {
+			today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+			e.IsOverdue = due.Before(today)
+		}
+	}
+	if !issue.CreatedAt.IsZero() && now.After(issue.CreatedAt) {
+		e.AgeDays = int(now.Sub(issue.CreatedAt).Hours() / 24)
+	}
+	if issue.Assignee != nil {
+		e.AssigneeEmail = issue.Assignee.Email
+	}
+	return e
+}
+
+func enrichIssues(issues []api.Issue, now time.Time) []enrichedIssue {
+	out := make([]enrichedIssue, len(issues))
+	for i, issue := range issues {
+		out[i] = enrichIssue(issue, now)
+	}
+	return out
+}
+
+func issueIsClosed(issue api.Issue) bool {
+	if issue.State == nil {
+		return false
+	}
+	return issue.State.Type == "completed" || issue.State.Type == "canceled"
+}
@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+func TestEnrichIssue_OverdueBoundary(t *testing.T) {
+	loc := time.FixedZone("UTC-5", -5*3600)
+	// Late evening on the 10th locally is already the 11th in UTC
+	now := time.Date(2025, 3, 10, 23, 30, 0, 0, loc)
+	due := func(d string) *string { return &d }
+
+	cases := []struct {
+		name  string
+		issue api.Issue
+		want  bool
+	}{
+		{"due yesterday", api.Issue{DueDate: due("2025-03-09")}, true},
+		{"due today", api.Issue{DueDate: due("2025-03-10")}, false},
+		{"due tomorrow", api.Issue{DueDate: due("2025-03-11")}, false},
+		{"no due date", api.Issue{}, false},
+		{"completed late", api.Issue{DueDate: due("2025-03-01"), State: &api.State{Type: "completed"}}, false},
+		{"canceled late", api.Issue{DueDate: due("2025-03-01"), State: &api.State{Type: "canceled"}}, false},
+		{"started late", api.Issue{DueDate: due("2025-03-01"), State: &api.State{Type: "started"}}, true},
+	}
+	for _, tc := range cases {
+		if got := enrichIssue(tc.issue, now).IsOverdue; got != tc.want {
+			t.Errorf("%s: isOverdue = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+
+	// Midnight starts the day after the due date
+	midnight := time.Date(2025, 3, 11, 0, 0, 0, 0, loc)
+	if !enrichIssue(api.Issue{DueDate: due("2025-03-10")}, midnight).IsOverdue {
+		t.Fatal("expected an issue due on the 10th to be overdue at midnight on the 11th")
+	}
+}
+
+func TestEnrichIssue_AgeAndAssigneeEmail(t *testing.T) {
+	now := time.Date(2025, 3, 10, 12, 0, 0, 0, time.UTC)
+	e := enrichIssue(api.Issue{
+		CreatedAt: now.Add(-73 * time.Hour),
+		Assignee:  &api.User{Email: "ada@example.com"},
+	}, now)
+	if e.AgeDays != 3 {
+		t.Fatalf("expected ageDays 3, got %d", e.AgeDays)
+	}
+	if e.AssigneeEmail != "ada@example.com" {
+		t.Fatalf("expected flattened assignee email, got %q", e.AssigneeEmail)
+	}
+}
+
+func TestIssueGet_EnrichIsOptIn(t *testing.T) {
+	withIssueMockServer(t, func(query string, _ map[string]any) any {
+		return map[string]any{"issue": map[string]any{
+			"id": "1", "identifier": "ENG-1", "title": "First",
+			"dueDate":  "2000-01-01",
+			"assignee": map[string]any{"id": "u1", "email": "ada@example.com"},
+		}}
+	})
+	resetFlags(t, issueGetCmd)
+	viper.Set("json", true)
+
+	plain := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-1"}) })
+	if strings.Contains(plain, "isOverdue") {
+		t.Fatalf("default JSON should not include derived fields: %s", plain)
+	}
+
+	_ = issueGetCmd.Flags().Set("enrich", "true")
+	out := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-1"}) })
+	var got map[string]any
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out, err)
+	}
+	if got["identifier"] != "ENG-1" || got["isOverdue"] != true || got["assigneeEmail"] != "ada@example.com" {
+		t.Fatalf("expected issue fields plus derived fields, got %v", got)
+	}
+}
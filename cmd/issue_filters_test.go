@@ -0,0 +1,560 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+func identifiers(issues *api.Issues) string {
+	ids := make([]string, 0, len(issues.Nodes))
+	for _, is := range issues.Nodes {
+		ids = append(ids, is.Identifier)
+	}
+	return strings.Join(ids, ",")
+}
+
+func TestFilterIssuesByParent(t *testing.T) {
+	issues := &api.Issues{Nodes: []api.Issue{
+		{Identifier: "ENG-1"},
+		{Identifier: "ENG-2", Parent: &api.Issue{ID: "p1"}},
+		{Identifier: "ENG-3", Parent: &api.Issue{ID: "p2"}},
+	}}
+	cases := []struct {
+		name     string
+		parentID string
+		wantHas  bool
+		wantNo   bool
+		want     string
+	}{
+		{name: "no filter", want: "ENG-1,ENG-2,ENG-3"},
+		{name: "has parent", wantHas: true, want: "ENG-2,ENG-3"},
+		{name: "no parent", wantNo: true, want: "ENG-1"},
+		{name: "specific parent", parentID: "p2", want: "ENG-3"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := identifiers(filterIssuesByParent(issues, tc.parentID, tc.wantHas, tc.wantNo))
+			if got != tc.want {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterIssuesByComments(t *testing.T) {
+	issues := &api.Issues{Nodes: []api.Issue{
+		{Identifier: "ENG-1"},
+		{Identifier: "ENG-2", Comments: &api.Comments{Nodes: []api.Comment{{ID: "c1"}}}},
+		{Identifier: "ENG-3", Comments: &api.Comments{}},
+	}}
+	cases := []struct {
+		name    string
+		wantHas bool
+		wantNo  bool
+		want    string
+	}{
+		{name: "no filter", want: "ENG-1,ENG-2,ENG-3"},
+		{name: "has comments", wantHas: true, want: "ENG-2"},
+		{name: "no comments", wantNo: true, want: "ENG-1,ENG-3"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := identifiers(filterIssuesByComments(issues, tc.wantHas, tc.wantNo))
+			if got != tc.want {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterIssuesByAttachments(t *testing.T) {
+	issues := &api.Issues{Nodes: []api.Issue{
+		{Identifier: "ENG-1"},
+		{Identifier: "ENG-2", Attachments: &api.Attachments{Nodes: []api.Attachment{{ID: "a1"}}}},
+		{Identifier: "ENG-3", Attachments: &api.Attachments{}},
+	}}
+	cases := []struct {
+		name    string
+		wantHas bool
+		wantNo  bool
+		want    string
+	}{
+		{name: "no filter", want: "ENG-1,ENG-2,ENG-3"},
+		{name: "has attachments", wantHas: true, want: "ENG-2"},
+		{name: "no attachments", wantNo: true, want: "ENG-1,ENG-3"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := identifiers(filterIssuesByAttachments(issues, tc.wantHas, tc.wantNo))
+			if got != tc.want {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestFilterIssuesByBlocking(t *testing.T) {
+	blocks := &api.IssueRelations{Nodes: []api.IssueRelation{{ID: "r1", Type: "blocks"}}}
+	related := &api.IssueRelations{Nodes: []api.IssueRelation{{ID: "r2", Type: "related"}}}
+	issues := &api.Issues{Nodes: []api.Issue{
+		{Identifier: "ENG-1"},
+		{Identifier: "ENG-2", Relations: blocks},
+		{Identifier: "ENG-3", InverseRelations: blocks},
+		{Identifier: "ENG-4", Relations: blocks, InverseRelations: blocks},
+		{Identifier: "ENG-5", Relations: related, InverseRelations: related},
+	}}
+	cases := []struct {
+		name         string
+		wantBlocked  bool
+		wantBlocking bool
+		want         string
+	}{
+		{name: "no filter", want: "ENG-1,ENG-2,ENG-3,ENG-4,ENG-5"},
+		{name: "blocked", wantBlocked: true, want: "ENG-3,ENG-4"},
+		{name: "blocking", wantBlocking: true, want: "ENG-2,ENG-4"},
+		{name: "both", wantBlocked: true, wantBlocking: true, want: "ENG-4"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := identifiers(filterIssuesByBlocking(issues, tc.wantBlocked, tc.wantBlocking))
+			if got != tc.want {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIssueList_BlockedUsesMockedRelations(t *testing.T) {
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if vars["withRelations"] != true {
+			t.Errorf("--blocked must select relations, got withRelations=%v", vars["withRelations"])
+		}
+		return map[string]any{"issues": map[string]any{"nodes": []any{
+			map[string]any{"id": "1", "identifier": "ENG-1", "title": "Waiting on API",
+				"inverseRelations": map[string]any{"nodes": []any{map[string]any{"id": "r1", "type": "blocks"}}}},
+			map[string]any{"id": "2", "identifier": "ENG-2", "title": "Unblocked",
+				"relations": map[string]any{"nodes": []any{map[string]any{"id": "r2", "type": "related"}}}},
+		}}}
+	})
+	resetFlags(t, issueListCmd)
+	viper.Set("json", true)
+	_ = issueListCmd.Flags().Set("blocked", "true")
+
+	out := captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+	if !strings.Contains(out, "ENG-1") || strings.Contains(out, "ENG-2") {
+		t.Fatalf("--blocked should keep only ENG-1:\n%s", out)
+	}
+}
+
+func TestIssueListQuery_SelectsPresenceConnections(t *testing.T) {
+	var queries []string
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		queries = append(queries, query)
+		return map[string]any{"issues": map[string]any{"nodes": []any{}}}
+	})
+	client := newIssueClient("Bearer test")
+	if _, err := client.GetIssues(context.Background(), nil, 1, "", "", false, api.IssueListOptions{}); err != nil {
+		t.Fatalf("GetIssues failed: %v", err)
+	}
+	for _, want := range []string{"comments(first: 1) @include(if: $withPresence)", "attachments(first: 1) @include(if: $withPresence)", "relations(first: 20) @include(if: $withRelations)", "inverseRelations(first: 20) @include(if: $withRelations)"} {
+		if len(queries) != 1 || !strings.Contains(queries[0], want) {
+			t.Fatalf("issue list query does not select %q", want)
+		}
+	}
+}
+
+func TestIssueListAndSearch_OptionalConnectionsFollowFilters(t *testing.T) {
+	cases := []struct {
+		cmd           *cobra.Command
+		args          []string
+		flag          string
+		wantPresence  bool
+		wantRelations bool
+	}{
+		{cmd: issueListCmd},
+		{cmd: issueListCmd, flag: "no-comments", wantPresence: true},
+		{cmd: issueListCmd, flag: "blocking", wantRelations: true},
+		{cmd: issueSearchCmd, args: []string{"login"}},
+		{cmd: issueSearchCmd, args: []string{"login"}, flag: "has-attachments", wantPresence: true},
+	}
+	for _, tc := range cases {
+		var vars map[string]any
+		withIssueMockServer(t, func(query string, v map[string]any) any {
+			vars = v
+			return map[string]any{"issues": map[string]any{"nodes": []any{}}, "searchIssues": map[string]any{"nodes": []any{}}}
+		})
+		resetFlags(t, tc.cmd)
+		viper.Set("json", true)
+		if tc.flag != "" {
+			_ = tc.cmd.Flags().Set(tc.flag, "true")
+		}
+		captureStdout(t, func() { tc.cmd.Run(tc.cmd, tc.args) })
+
+		if vars["withPresence"] != tc.wantPresence {
+			t.Errorf("%s --%s: withPresence = %v, want %v", tc.cmd.Name(), tc.flag, vars["withPresence"], tc.wantPresence)
+		}
+		if _, sent := vars["withRelations"]; sent && vars["withRelations"] != tc.wantRelations {
+			t.Errorf("%s --%s: withRelations = %v, want %v", tc.cmd.Name(), tc.flag, vars["withRelations"], tc.wantRelations)
+		}
+	}
+}
+
+func TestIssueListAndSearch_PresenceFlags(t *testing.T) {
+	for _, c := range []string{"list", "search"} {
+		cmd := issueListCmd
+		if c == "search" {
+			cmd = issueSearchCmd
+		}
+		for _, flag := range []string{"has-comments", "no-comments", "has-attachments", "no-attachments"} {
+			if cmd.Flags().Lookup(flag) == nil {
+				t.Fatalf("issue %s is missing --%s", c, flag)
+			}
+		}
+	}
+}
+
+func TestIssueList_IncludeArchived(t *testing.T) {
+	for _, archived := range []bool{false, true} {
+		var vars map[string]any
+		withIssueMockServer(t, func(query string, v map[string]any) any {
+			if strings.Contains(query, "query Issues(") {
+				vars = v
+			}
+			return map[string]any{"issues": map[string]any{"nodes": []any{}}}
+		})
+		resetFlags(t, issueListCmd)
+		viper.Set("json", true)
+		if archived {
+			_ = issueListCmd.Flags().Set("include-archived", "true")
+		}
+
+		captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+
+		got, sent := vars["includeArchived"]
+		if archived && got != true {
+			t.Fatalf("expected includeArchived=true with the flag, got %v", vars)
+		}
+		if !archived && sent {
+			t.Fatalf("expected includeArchived to be omitted by default, got %v", got)
+		}
+	}
+}
+
+func TestParseSearchScope(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    searchScope
+		wantErr bool
+	}{
+		{in: "", want: searchScope{title: true, description: true}},
+		{in: "title", want: searchScope{title: true}},
+		{in: " Title , COMMENTS ", want: searchScope{title: true, comments: true}},
+		{in: "title,description,comments", want: searchScope{title: true, description: true, comments: true}},
+		{in: "body", wantErr: true},
+		{in: ",", wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := parseSearchScope(tc.in)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("parseSearchScope(%q) error = %v, wantErr %v", tc.in, err, tc.wantErr)
+		}
+		if !tc.wantErr && got != tc.want {
+			t.Fatalf("parseSearchScope(%q) = %+v, want %+v", tc.in, got, tc.want)
+		}
+	}
+}
+
+func TestFilterIssuesBySearchScope(t *testing.T) {
+	issues := &api.Issues{Nodes: []api.Issue{
+		{ID: "1", Identifier: "ENG-1", Title: "Login timeout on Safari"},
+		{ID: "2", Identifier: "ENG-2", Title: "Flaky test", Description: "Login times out after a timeout"},
+		{ID: "3", Identifier: "ENG-3", Title: "Timeout", Description: "only during login"},
+	}}
+	comments := map[string][]api.Comment{
+		"3": {{Body: "Another login timeout today"}},
+	}
+	cases := []struct {
+		name  string
+		scope searchScope
+		want  string
+	}{
+		{name: "title", scope: searchScope{title: true}, want: "ENG-1"},
+		{name: "description", scope: searchScope{description: true}, want: "ENG-2"},
+		{name: "default keeps everything", scope: searchScope{title: true, description: true}, want: "ENG-1,ENG-2,ENG-3"},
+		{name: "title and comments drop description-only matches", scope: searchScope{title: true, comments: true}, want: "ENG-1,ENG-3"},
+		{name: "comments", scope: searchScope{comments: true}, want: "ENG-3"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var fetched []string
+			filtered, err := filterIssuesBySearchScope(issues, "login TIMEOUT", tc.scope, func(issueID string) ([]api.Comment, error) {
+				fetched = append(fetched, issueID)
+				return comments[issueID], nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := identifiers(filtered); got != tc.want {
+				t.Fatalf("got %s, want %s", got, tc.want)
+			}
+			if tc.scope.title && tc.scope.comments && strings.Join(fetched, ",") != "2,3" {
+				t.Fatalf("comments should only be fetched for issues whose fields don't match, got %v", fetched)
+			}
+		})
+	}
+}
+
+func TestIssueList_Subscribed(t *testing.T) {
+	var filter map[string]any
+	withIssueMockServer(t, func(query string, v map[string]any) any {
+		switch {
+		case strings.Contains(query, "viewer"):
+			return map[string]any{"viewer": map[string]any{"id": "viewer-1"}}
+		case strings.Contains(query, "query Issues("):
+			filter, _ = v["filter"].(map[string]any)
+		}
+		return map[string]any{"issues": map[string]any{"nodes": []any{}}}
+	})
+	resetFlags(t, issueListCmd)
+	viper.Set("json", true)
+	_ = issueListCmd.Flags().Set("subscribed", "true")
+	_ = issueListCmd.Flags().Set("team", "ENG")
+
+	captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+
+	subs, _ := filter["subscribers"].(map[string]any)
+	some, _ := subs["some"].(map[string]any)
+	id, _ := some["id"].(map[string]any)
+	if id["eq"] != "viewer-1" {
+		t.Fatalf("expected a subscribers filter on the viewer, got %v", filter)
+	}
+	if _, ok := filter["team"]; !ok {
+		t.Fatalf("expected --subscribed to compose with --team, got %v", filter)
+	}
+}
+
+func TestIssueListAndSearch_MineFiltersOnViewer(t *testing.T) {
+	for _, tc := range []struct {
+		cmd  *cobra.Command
+		args []string
+	}{
+		{cmd: issueListCmd},
+		{cmd: issueSearchCmd, args: []string{"login"}},
+	} {
+		var filter map[string]any
+		withIssueMockServer(t, func(query string, v map[string]any) any {
+			if strings.Contains(query, "viewer") {
+				return map[string]any{"viewer": map[string]any{"id": "viewer-1"}}
+			}
+			filter, _ = v["filter"].(map[string]any)
+			return map[string]any{"issues": map[string]any{"nodes": []any{}}, "searchIssues": map[string]any{"nodes": []any{}}}
+		})
+		resetFlags(t, tc.cmd)
+		viper.Set("json", true)
+		_ = tc.cmd.Flags().Set("mine", "true")
+
+		captureStdout(t, func() { tc.cmd.Run(tc.cmd, tc.args) })
+
+		assignee, _ := filter["assignee"].(map[string]any)
+		id, _ := assignee["id"].(map[string]any)
+		if id["eq"] != "viewer-1" {
+			t.Fatalf("issue %s --mine: expected the assignee filter to be the viewer, got %v", tc.cmd.Name(), filter)
+		}
+	}
+}
+
+func TestIssueList_TeamMatchesKeyOrName(t *testing.T) {
+	var filter map[string]any
+	var lookups int
+	withIssueMockServer(t, func(query string, v map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamByKey"):
+			lookups++
+			return map[string]any{"teams": map[string]any{"nodes": []any{}}}
+		case strings.Contains(query, "TeamByID"):
+			return map[string]any{"team": nil}
+		case strings.Contains(query, "TeamsByName"):
+			return map[string]any{"teams": map[string]any{"nodes": []any{
+				map[string]any{"id": "team-1", "key": "ENG", "name": "Engineering"},
+			}}}
+		}
+		filter, _ = v["filter"].(map[string]any)
+		return map[string]any{"issues": map[string]any{"nodes": []any{}}}
+	})
+	resetFlags(t, issueListCmd)
+	t.Cleanup(func() { resetFlags(t, issueListCmd) })
+	viper.Set("json", true)
+
+	for _, ref := range []string{"Engineering", "ENG"} {
+		_ = issueListCmd.Flags().Set("team", ref)
+		captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+		if got := fmt.Sprint(filter["team"]); got != "map[key:map[eq:ENG]]" {
+			t.Fatalf("--team %s: expected the resolved key, got %v", ref, got)
+		}
+	}
+	if lookups != 1 {
+		t.Fatalf("only the name should be looked up, got %d lookups", lookups)
+	}
+}
+
+func TestIssueList_ExcludeState(t *testing.T) {
+	var filter map[string]any
+	withIssueMockServer(t, func(query string, v map[string]any) any {
+		if strings.Contains(query, "query Issues(") {
+			filter, _ = v["filter"].(map[string]any)
+		}
+		return map[string]any{"issues": map[string]any{"nodes": []any{}}}
+	})
+	resetFlags(t, issueListCmd)
+	t.Cleanup(func() { resetFlags(t, issueListCmd) })
+	viper.Set("json", true)
+	_ = issueListCmd.Flags().Set("exclude-state", "Backlog, Won't Fix")
+
+	captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+
+	state, _ := filter["state"].(map[string]any)
+	if got := fmt.Sprint(state["and"]); got != "[map[name:map[neqIgnoreCase:Backlog]] map[name:map[neqIgnoreCase:Won't Fix]]]" {
+		t.Fatalf("expected a case-insensitive exclusion per state, got %v", filter["state"])
+	}
+	if _, ok := state["type"]; !ok {
+		t.Fatalf("expected --exclude-state to keep the completed/canceled type filter, got %v", state)
+	}
+
+	_ = issueListCmd.Flags().Set("include-completed", "true")
+	captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+	state, _ = filter["state"].(map[string]any)
+	if _, ok := state["type"]; ok || state["and"] == nil {
+		t.Fatalf("with --include-completed only the name exclusion should remain, got %v", state)
+	}
+}
+
+func TestIssueList_ActiveAssigneesOnly(t *testing.T) {
+	var query string
+	withIssueMockServer(t, func(q string, _ map[string]any) any {
+		query = q
+		return map[string]any{"issues": map[string]any{"nodes": []any{
+			map[string]any{"id": "1", "identifier": "ENG-1", "assignee": map[string]any{"name": "Ada", "active": true}},
+			map[string]any{"id": "2", "identifier": "ENG-2", "assignee": map[string]any{"name": "Former", "active": false}},
+			map[string]any{"id": "3", "identifier": "ENG-3"},
+		}}}
+	})
+	resetFlags(t, issueListCmd)
+	viper.Set("json", true)
+	_ = issueListCmd.Flags().Set("active-assignees-only", "true")
+
+	out := captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+
+	var got []api.Issue
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if ids := identifiers(&api.Issues{Nodes: got}); ids != "ENG-1,ENG-3" {
+		t.Fatalf("expected the inactive assignee's issue to be dropped, got %s", ids)
+	}
+	if !regexp.MustCompile(`assignee \{[^}]*\bactive\b`).MatchString(query) {
+		t.Fatalf("issue list query does not select assignee.active:\n%s", query)
+	}
+}
+
+func TestSearchQueryFromArgs(t *testing.T) {
+	cases := []struct {
+		args    []string
+		want    string
+		wantErr bool
+	}{
+		{args: []string{`"exact phrase"`}, want: `"exact phrase"`},
+		{args: []string{`  "two  spaces" kept  `}, want: `"two  spaces" kept`},
+		{args: []string{"payment outage"}, want: "payment outage"},
+		{args: []string{"exact phrase", "bug"}, want: `"exact phrase" bug`},
+		{args: []string{"label:bug", `title:"a b"`}, want: `label:bug title:"a b"`},
+		{args: []string{"  "}, wantErr: true},
+		{args: []string{`"unterminated`}, wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := searchQueryFromArgs(tc.args)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("searchQueryFromArgs(%q) error = %v, wantErr %v", tc.args, err, tc.wantErr)
+		}
+		if got != tc.want {
+			t.Fatalf("searchQueryFromArgs(%q) = %q, want %q", tc.args, got, tc.want)
+		}
+	}
+}
+
+func TestParseSearchTerms(t *testing.T) {
+	got := parseSearchTerms(`login "exact  phrase" label:bug -state:done title:"a b" 'x' customer: https://example.com/a TypeError:foo ENG:123`)
+	want := []searchTerm{
+		{text: "login"},
+		{text: "exact  phrase", phrase: true},
+		{text: "label:bug", qualifier: true},
+		{text: "-state:done", qualifier: true},
+		{text: `title:"a b"`, qualifier: true},
+		{text: "x"},
+		{text: "customer:"},
+		{text: "https://example.com/a"},
+		{text: "TypeError:foo"},
+		{text: "ENG:123"},
+	}
+	if fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Fatalf("parseSearchTerms =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestFilterIssuesBySearchScope_Phrases(t *testing.T) {
+	issues := &api.Issues{Nodes: []api.Issue{
+		{Identifier: "ENG-1", Title: "Exact phrase in the title"},
+		{Identifier: "ENG-2", Title: "Phrase that is not exact"},
+	}}
+	filtered, err := filterIssuesBySearchScope(issues, `"exact phrase" label:bug`, searchScope{title: true}, nil)
+	if got := identifiers(filtered); err != nil || got != "ENG-1" {
+		t.Fatalf("expected only the verbatim phrase match, got %s", got)
+	}
+}
+
+func TestIssueSearch_PreservesQuotedPhrase(t *testing.T) {
+	var term any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if strings.Contains(query, "IssueSearch") {
+			term = vars["term"]
+			return map[string]any{"searchIssues": map[string]any{"nodes": []any{}}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueSearchCmd)
+	viper.Set("json", true)
+	_ = issueSearchCmd.Flags().Set("team", "ENG")
+
+	captureStdout(t, func() { issueSearchCmd.Run(issueSearchCmd, []string{`"exact  phrase"`, "login"}) })
+
+	if term != `"exact  phrase" login` {
+		t.Fatalf("expected the quoted phrase to reach Linear intact, got %q", term)
+	}
+}
+
+func TestResolveTeamKey_AmbiguousName(t *testing.T) {
+	withIssueMockServer(t, func(query string, v map[string]any) any {
+		switch {
+		case strings.Contains(query, "TeamsByName"):
+			return map[string]any{"teams": map[string]any{"nodes": []any{
+				map[string]any{"id": "team-1", "key": "PLAT", "name": "Platform"},
+				map[string]any{"id": "team-2", "key": "PLAT2", "name": "platform"},
+			}}}
+		case strings.Contains(query, "TeamByID"):
+			return map[string]any{"team": nil}
+		}
+		return map[string]any{"teams": map[string]any{"nodes": []any{}}}
+	})
+
+	_, err := resolveTeamKey(context.Background(), newIssueClient(""), "Platform")
+	if err == nil || !strings.Contains(err.Error(), "ambiguous") || !strings.Contains(err.Error(), "PLAT2") {
+		t.Fatalf("expected an ambiguity error listing both keys, got %v", err)
+	}
+}
@@ -0,0 +1,226 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/fatih/color"
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/viper"
+)
+
+func fullThreadHandler(query string, vars map[string]any) any {
+	switch {
+	case strings.Contains(query, "query IssueComments("):
+		if vars["after"] == nil {
+			return map[string]any{"issue": map[string]any{"comments": map[string]any{
+				"nodes": []any{
+					map[string]any{"id": "c1", "body": "First comment", "createdAt": "2025-01-01T10:00:00Z", "user": map[string]any{"name": "Ada"}},
+					map[string]any{"id": "c2", "body": "A reply", "createdAt": "2025-01-01T11:00:00Z", "user": map[string]any{"name": "Grace"}, "parent": map[string]any{"id": "c1"}},
+				},
+				"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "page-2"},
+			}}}
+		}
+		return map[string]any{"issue": map[string]any{"comments": map[string]any{
+			"nodes": []any{
+				map[string]any{"id": "c3", "body": "Much later comment", "createdAt": "2025-02-01T10:00:00Z", "user": map[string]any{"name": "Linus"}},
+			},
+		}}}
+	case strings.Contains(query, "query IssueHistory("):
+		return map[string]any{"issue": map[string]any{"history": map[string]any{
+			"nodes": []any{
+				map[string]any{"id": "h1", "createdAt": "2025-01-02T10:00:00Z", "actor": map[string]any{"name": "Ada"},
+					"fromState": map[string]any{"name": "Todo"}, "toState": map[string]any{"name": "Done"}},
+			},
+		}}}
+	case strings.Contains(query, "query Issue("):
+		return map[string]any{"issue": map[string]any{
+			"id": "issue-1", "identifier": "ENG-7", "title": "Thread me",
+			"comments": map[string]any{"nodes": []any{
+				map[string]any{"id": "c3", "body": "Much later comment", "createdAt": "2025-02-01T10:00:00Z", "user": map[string]any{"name": "Linus"}},
+			}},
+		}}
+	}
+	return map[string]any{}
+}
+
+func TestIssueGet_CommentsAndHistoryFetchEverything(t *testing.T) {
+	withIssueMockServer(t, fullThreadHandler)
+	resetFlags(t, issueGetCmd)
+	viper.Set("plaintext", true)
+	_ = issueGetCmd.Flags().Set("comments", "true")
+	_ = issueGetCmd.Flags().Set("history", "true")
+
+	out := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	for _, want := range []string{
+		"## Comments (3)",
+		"First comment",
+		"**Reply from Grace**: A reply",
+		"Much later comment",
+		"## History (1)",
+		"State: Todo → Done",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("output missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "--comments` to see all comments") {
+		t.Fatalf("did not expect the see-all hint with --comments:\n%s", out)
+	}
+}
+
+func TestThreadComments(t *testing.T) {
+	at := func(h int) time.Time { return time.Date(2025, 1, 1, h, 0, 0, 0, time.UTC) }
+	roots := threadComments([]api.Comment{
+		{ID: "reply", CreatedAt: at(12), Parent: &api.Comment{ID: "root"}},
+		{ID: "orphan", CreatedAt: at(11), Parent: &api.Comment{ID: "missing"}},
+		{ID: "root", CreatedAt: at(10)},
+	})
+	if len(roots) != 2 || roots[0].ID != "root" || roots[1].ID != "orphan" {
+		t.Fatalf("unexpected roots: %+v", roots)
+	}
+	if roots[0].Children == nil || len(roots[0].Children.Nodes) != 1 || roots[0].Children.Nodes[0].ID != "reply" {
+		t.Fatalf("expected reply threaded under root, got %+v", roots[0].Children)
+	}
+	if countComments(roots) != 3 {
+		t.Fatalf("expected 3 comments in total, got %d", countComments(roots))
+	}
+}
+
+func TestIssueGet_RenderMarkdown(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() { color.NoColor = origNoColor })
+	color.NoColor = false
+
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if strings.Contains(query, "query Issue(") {
+			return map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-7", "title": "Spec",
+				"description": "## Goal\n- ship it",
+			}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueGetCmd)
+
+	out := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	if !strings.Contains(out, "## Goal\n- ship it") {
+		t.Fatalf("expected the raw description by default:\n%s", out)
+	}
+
+	_ = issueGetCmd.Flags().Set("render-markdown", "true")
+	out = captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	if strings.Contains(out, "## Goal") || !strings.Contains(out, "• ship it") {
+		t.Fatalf("expected a rendered description:\n%s", out)
+	}
+
+	viper.Set("plaintext", true)
+	out = captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	if !strings.Contains(out, "## Goal\n- ship it") {
+		t.Fatalf("plaintext output should stay raw:\n%s", out)
+	}
+}
+
+func TestIssueGet_Hyperlinks(t *testing.T) {
+	origNoColor, origTTY := color.NoColor, stdoutIsTTY
+	t.Cleanup(func() { color.NoColor, stdoutIsTTY = origNoColor, origTTY })
+	color.NoColor = false
+	stdoutIsTTY = func() bool { return false }
+
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if strings.Contains(query, "query Issue(") {
+			return map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-7", "title": "Parent work", "url": "https://linear.app/acme/issue/ENG-7",
+				"children": map[string]any{"nodes": []any{
+					map[string]any{"id": "c1", "identifier": "ENG-8", "title": "Child", "url": "https://linear.app/acme/issue/ENG-8"},
+				}},
+				"relations": map[string]any{"nodes": []any{
+					map[string]any{"id": "r1", "type": "blocks", "relatedIssue": map[string]any{"id": "i9", "identifier": "ENG-9", "title": "Blocked work", "url": "https://linear.app/acme/issue/ENG-9"}},
+				}},
+			}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueGetCmd)
+
+	out := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	if strings.Contains(out, "\x1b]8;;") {
+		t.Fatalf("auto should not emit hyperlinks when stdout is not a terminal:\n%q", out)
+	}
+	if !strings.Contains(out, "Blocks:") || !strings.Contains(out, "ENG-9") {
+		t.Fatalf("expected related issues in rich output:\n%s", out)
+	}
+
+	_ = issueGetCmd.Flags().Set("hyperlinks", "always")
+	out = captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	for _, id := range []string{"ENG-7", "ENG-8", "ENG-9"} {
+		if want := output.Hyperlink(id, "https://linear.app/acme/issue/"+id); !strings.Contains(out, want) {
+			t.Fatalf("expected %s to be linked:\n%q", id, out)
+		}
+	}
+
+	viper.Set("plaintext", true)
+	out = captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	if strings.Contains(out, "\x1b]8;;") {
+		t.Fatalf("plaintext output must not contain hyperlinks:\n%q", out)
+	}
+}
+
+func TestIssueGet_PlaintextSections(t *testing.T) {
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if strings.Contains(query, "query Issue(") {
+			return map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-7", "title": "Trim me", "description": "Body text",
+				"labels": map[string]any{"nodes": []any{map[string]any{"id": "l1", "name": "bug"}}},
+				"history": map[string]any{"nodes": []any{
+					map[string]any{"id": "h1", "createdAt": "2024-01-02T03:04:05Z", "actor": map[string]any{"name": "Ann"}},
+				}},
+			}}
+		}
+		return map[string]any{}
+	})
+	viper.Set("plaintext", true)
+	t.Cleanup(func() { viper.Set("plaintext", false) })
+	resetFlags(t, issueGetCmd)
+
+	_ = issueGetCmd.Flags().Set("sections", "core, labels")
+	out := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	for _, want := range []string{"# ENG-7 - Trim me", "## Core Details", "## Labels"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+	for _, unwanted := range []string{"## Description", "## Status & Dates", "## Technical Details", "History"} {
+		if strings.Contains(out, unwanted) {
+			t.Fatalf("did not expect %q with --sections core,labels:\n%s", unwanted, out)
+		}
+	}
+
+	resetFlags(t, issueGetCmd)
+	_ = issueGetCmd.Flags().Set("no-sections", "technical,history")
+	out = captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-7"}) })
+	if !strings.Contains(out, "## Description") || !strings.Contains(out, "## Status & Dates") {
+		t.Fatalf("expected unexcluded sections:\n%s", out)
+	}
+	if strings.Contains(out, "## Technical Details") || strings.Contains(out, "History") {
+		t.Fatalf("excluded sections rendered:\n%s", out)
+	}
+}
+
+func TestParseIssueSections_RejectsUnknownNames(t *testing.T) {
+	if _, err := parseIssueSections("core,summary", ""); err == nil || !strings.Contains(err.Error(), "summary") {
+		t.Fatalf("expected unknown --sections name to be rejected, got %v", err)
+	}
+	if _, err := parseIssueSections("", "histroy"); err == nil || !strings.Contains(err.Error(), "--no-sections") {
+		t.Fatalf("expected unknown --no-sections name to be rejected, got %v", err)
+	}
+	show, err := parseIssueSections("CORE,comments", "comments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !show("core") || show("comments") || show("dates") {
+		t.Fatalf("unexpected selection: core=%v comments=%v dates=%v", show("core"), show("comments"), show("dates"))
+	}
+}
@@ -0,0 +1,264 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// importColumns is the column order assumed when a CSV has no header row.
+var importColumns = []string{"title", "description", "priority", "assignee", "labels"}
+
+var issueImportCmd = &cobra.Command{
+	Use:   "import FILE.csv",
+	Short: "Create issues from a CSV file",
+	Long: `Create one issue per CSV row.
+
+Recognised columns are title, description, priority, assignee (email) and
+labels (comma- or semicolon-separated names). A header row naming the columns
+is detected automatically; without one, columns are read in that order.
+Priority accepts 0-4 or none/urgent/high/normal/medium/low.
+
+Examples:
+  linctl issue import backlog.csv --team ENG
+  linctl issue import backlog.csv --team ENG --out created.csv`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		teamKey, _ := cmd.Flags().GetString("team")
+		if teamKey == "" {
+			output.Error("Team is required (--team)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		f, err := os.Open(args[0])
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to open CSV: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		header, rows, err := readImportCSV(f)
+		_ = f.Close()
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if len(rows) == 0 {
+			output.Error("CSV contains no rows to import", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		authHeader, err := getIssueAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+		client := newIssueClient(authHeader)
+
+		progress := output.NewProgress(plaintext, jsonOut)
+		defer progress.Stop()
+
+		progress.Step("Resolving labels and assignees…")
+		specs, err := importRowsToSpecs(context.Background(), client, header, rows, teamKey)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		results := createIssueSpecs(client, specs, false, progress)
+
+		if outPath, _ := cmd.Flags().GetString("out"); outPath != "" {
+			if err := writeImportResults(outPath, header, rows, results); err != nil {
+				output.Error(fmt.Sprintf("Failed to write %s: %v", outPath, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		if failed := renderIssueCreateResults(results, plaintext, jsonOut); failed > 0 {
+			os.Exit(1)
+		}
+	},
+}
+
+// readImportCSV parses the CSV and returns the column names (from the header
+// row when present, otherwise importColumns) and the data rows.
+func readImportCSV(r io.Reader) ([]string, [][]string, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to parse CSV: %w", err)
+	}
+	if len(records) == 0 {
+		return importColumns, nil, nil
+	}
+
+	if isImportHeader(records[0]) {
+		header := make([]string, len(records[0]))
+		for i, col := range records[0] {
+			header[i] = strings.ToLower(strings.TrimSpace(col))
+		}
+		return header, records[1:], nil
+	}
+	return importColumns, records, nil
+}
+
+// isImportHeader reports whether row looks like a header naming known columns.
+func isImportHeader(row []string) bool {
+	for _, cell := range row {
+		name := strings.ToLower(strings.TrimSpace(cell))
+		for _, col := range importColumns {
+			if name == col {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// importRowsToSpecs maps CSV rows to issue specs, fetching the labels and
+// resolving each distinct assignee only once.
+func importRowsToSpecs(ctx context.Context, client *api.Client, header []string, rows [][]string, teamKey string) ([]issueCreateSpec, error) {
+	// Labels are fetched once, on the first row that names any
+	var labels *api.Labels
+	cachedLabels := labelSource(func(context.Context) (*api.Labels, error) { return labels, nil })
+	assigneeIDs := map[string]string{}
+
+	specs := make([]issueCreateSpec, 0, len(rows))
+	for i, row := range rows {
+		line := i + 1
+		get := func(col string) string {
+			for j, name := range header {
+				if name == col && j < len(row) {
+					return strings.TrimSpace(row[j])
+				}
+			}
+			return ""
+		}
+
+		spec := issueCreateSpec{
+			Title:       get("title"),
+			Description: get("description"),
+			Team:        teamKey,
+		}
+
+		if p := get("priority"); p != "" {
+			priority, err := parseImportPriority(p)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", line, err)
+			}
+			spec.Priority = &priority
+		}
+
+		if email := get("assignee"); email != "" {
+			id, ok := assigneeIDs[strings.ToLower(email)]
+			if !ok {
+				user, err := client.GetUser(ctx, email)
+				if err != nil {
+					return nil, fmt.Errorf("row %d: user not found with email '%s': %w", line, email, err)
+				}
+				id = user.ID
+				assigneeIDs[strings.ToLower(email)] = id
+			}
+			spec.assigneeID = id
+		}
+
+		if names := strings.ReplaceAll(get("labels"), ";", ","); strings.TrimSpace(names) != "" {
+			if labels == nil {
+				fetched, err := client.GetIssueLabels(ctx)
+				if err != nil {
+					return nil, fmt.Errorf("failed to fetch issue labels: %w", err)
+				}
+				labels = fetched
+			}
+			ids, err := lookupLabelIDsByNames(ctx, "issue", cachedLabels, names)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: %w", line, err)
+			}
+			spec.labelIDs = ids
+		}
+
+		specs = append(specs, spec)
+	}
+	return specs, nil
+}
+
+// parseImportPriority accepts a number 0-4 or a priority name.
+func parseImportPriority(s string) (int, error) {
+	if n, err := strconv.Atoi(s); err == nil && n >= 0 && n <= 4 {
+		return n, nil
+	}
+	switch strings.ToLower(s) {
+	case "none", "no priority":
+		return 0, nil
+	case "urgent":
+		return 1, nil
+	case "high":
+		return 2, nil
+	case "normal", "medium":
+		return 3, nil
+	case "low":
+		return 4, nil
+	}
+	return 0, fmt.Errorf("invalid priority '%s' (use 0-4 or none/urgent/high/normal/low)", s)
+}
+
+// writeImportResults writes the input rows with identifier, url and error
+// columns appended. Every cell of every row is kept: rows wider than the
+// header widen it with unnamed columns so the result columns stay aligned.
+func writeImportResults(path string, header []string, rows [][]string, results []issueCreateResult) (err error) {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+	}()
+
+	width := len(header)
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+
+	w := csv.NewWriter(f)
+	names := make([]string, width, width+3)
+	copy(names, header)
+	if err := w.Write(append(names, "identifier", "url", "error")); err != nil {
+		return err
+	}
+	for i, row := range rows {
+		record := make([]string, width, width+3)
+		copy(record, row)
+		var result issueCreateResult
+		if i < len(results) {
+			result = results[i]
+		}
+		if err := w.Write(append(record, result.Identifier, result.URL, result.Error)); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+func init() {
+	issueCmd.AddCommand(issueImportCmd)
+
+	issueImportCmd.Flags().StringP("team", "t", "", "Team key for the imported issues (required)")
+	issueImportCmd.Flags().String("out", "", "Write the rows with created identifiers to this CSV file")
+}
@@ -0,0 +1,148 @@
+package cmd
+
+import (
+	"context"
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReadImportCSV_HeaderDetectionAndQuoting(t *testing.T) {
+	withHeader := "Title,Labels,Priority\n\"Fix, then ship\",\"bug,api\",high\n"
+	header, rows, err := readImportCSV(strings.NewReader(withHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(header, "|") != "title|labels|priority" || len(rows) != 1 {
+		t.Fatalf("unexpected header/rows: %v %v", header, rows)
+	}
+	if rows[0][0] != "Fix, then ship" || rows[0][1] != "bug,api" {
+		t.Fatalf("quoted cells not preserved: %q", rows[0])
+	}
+
+	noHeader := "First issue,Some description,2\nSecond issue\n"
+	header, rows, err = readImportCSV(strings.NewReader(noHeader))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if header[0] != "title" || len(rows) != 2 || rows[1][0] != "Second issue" {
+		t.Fatalf("headerless CSV parsed incorrectly: %v %v", header, rows)
+	}
+}
+
+func TestParseImportPriority(t *testing.T) {
+	cases := map[string]int{"0": 0, "1": 1, "4": 4, "Urgent": 1, "high": 2, "Medium": 3, "low": 4, "none": 0}
+	for in, want := range cases {
+		got, err := parseImportPriority(in)
+		if err != nil || got != want {
+			t.Errorf("parseImportPriority(%q) = %d, %v; want %d", in, got, err, want)
+		}
+	}
+	if _, err := parseImportPriority("7"); err == nil {
+		t.Errorf("expected error for out-of-range priority")
+	}
+}
+
+func TestImportRowsToSpecs_CachesLookups(t *testing.T) {
+	labelCalls, userCalls := 0, 0
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "issueLabels"):
+			labelCalls++
+			return map[string]any{"issueLabels": map[string]any{"nodes": []any{
+				map[string]any{"id": "L_bug", "name": "Bug"},
+				map[string]any{"id": "L_api", "name": "API"},
+			}}}
+		case strings.Contains(query, "query User("):
+			userCalls++
+			return map[string]any{"user": map[string]any{"id": "U1", "name": "Ann", "email": vars["email"]}}
+		}
+		return map[string]any{}
+	})
+
+	header := []string{"title", "assignee", "labels"}
+	rows := [][]string{
+		{"One", "ann@example.com", "bug;api"},
+		{"Two", "ANN@example.com", "Bug"},
+	}
+	specs, err := importRowsToSpecs(context.Background(), newIssueClient(""), header, rows, "ENG")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if labelCalls != 1 || userCalls != 1 {
+		t.Fatalf("expected cached lookups, got labels=%d users=%d", labelCalls, userCalls)
+	}
+	if specs[0].assigneeID != "U1" || strings.Join(specs[0].labelIDs, ",") != "L_bug,L_api" || specs[1].Team != "ENG" {
+		t.Fatalf("unexpected specs: %+v", specs)
+	}
+}
+
+func TestImportRowsToSpecs_LabelsDedupAndSuggest(t *testing.T) {
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		return map[string]any{"issueLabels": map[string]any{"nodes": []any{
+			map[string]any{"id": "L_bug", "name": "Bug"},
+			map[string]any{"id": "L_api", "name": "API"},
+		}}}
+	})
+
+	header := []string{"title", "labels"}
+	specs, err := importRowsToSpecs(context.Background(), newIssueClient(""), header, [][]string{{"One", "bug;Bug; BUG,api"}}, "ENG")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(specs[0].labelIDs, ","); got != "L_bug,L_api" {
+		t.Fatalf("expected each label once, got %s", got)
+	}
+
+	_, err = importRowsToSpecs(context.Background(), newIssueClient(""), header, [][]string{{"One", "bug"}, {"Two", "Bgu"}}, "ENG")
+	if err == nil || !strings.Contains(err.Error(), "row 2") || !strings.Contains(err.Error(), "did you mean: Bug") {
+		t.Fatalf("expected a row-numbered suggestion, got %v", err)
+	}
+}
+
+func TestWriteImportResults(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.csv")
+	header := []string{"title"}
+	rows := [][]string{{"One"}, {"Two"}}
+	results := []issueCreateResult{{Identifier: "ENG-1", URL: "https://linear.app/x/ENG-1"}, {Error: "boom"}}
+	if err := writeImportResults(path, header, rows, results); err != nil {
+		t.Fatal(err)
+	}
+	f, _ := os.Open(path)
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(records[0], ",") != "title,identifier,url,error" || records[1][1] != "ENG-1" || records[2][3] != "boom" {
+		t.Fatalf("unexpected output CSV: %v", records)
+	}
+}
+
+func TestWriteImportResults_KeepsEveryCell(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.csv")
+	header := []string{"title", "priority"}
+	rows := [][]string{{"One", "high", "extra note"}, {"Two"}, {"Three", "low"}}
+	results := []issueCreateResult{{Identifier: "ENG-1"}, {Identifier: "ENG-2"}}
+	if err := writeImportResults(path, header, rows, results); err != nil {
+		t.Fatal(err)
+	}
+	f, _ := os.Open(path)
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{
+		{"title", "priority", "", "identifier", "url", "error"},
+		{"One", "high", "extra note", "ENG-1", "", ""},
+		{"Two", "", "", "ENG-2", "", ""},
+		{"Three", "low", "", "", "", ""},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Fatalf("output CSV = %q, want %q", records, want)
+	}
+}
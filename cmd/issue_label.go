@@ -0,0 +1,250 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var issueLabelCmd = &cobra.Command{
+	Use:   "label",
+	Short: "Add or remove labels on every issue matching filters",
+	Long: `Apply a label change to every issue matching the same filters as 'issue list'.
+
+The label is resolved once, then each matching issue is updated in turn.
+Issues that already have (or already lack) the label are skipped. Use
+--dry-run to see which issues would change; otherwise you are asked to
+confirm unless --yes is given.
+
+Examples:
+  linctl issue label add bug --team ENG --state Backlog --dry-run
+  linctl issue label add "needs-triage,backend" --team ENG --unlabeled
+  linctl issue label remove stale --label stale --include-completed --yes`,
+}
+
+var issueLabelAddCmd = &cobra.Command{
+	Use:   "add LABELS",
+	Short: "Add labels (comma-separated names) to matching issues",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runBulkLabel(cmd, args[0], true)
+	},
+}
+
+var issueLabelRemoveCmd = &cobra.Command{
+	Use:   "remove LABELS",
+	Short: "Remove labels (comma-separated names) from matching issues",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		runBulkLabel(cmd, args[0], false)
+	},
+}
+
+// bulkLabelResult is the outcome for one issue of `issue label add/remove`.
+type bulkLabelResult struct {
+	Identifier string `json:"identifier"`
+	Title      string `json:"title"`
+	Status     string `json:"status"` // changed, skipped, would-change or failed
+	Error      string `json:"error,omitempty"`
+}
+
+func runBulkLabel(cmd *cobra.Command, names string, add bool) {
+	plaintext := viper.GetBool("plaintext")
+	jsonOut := viper.GetBool("json")
+
+	authHeader, err := getIssueAuthHeader()
+	if err != nil {
+		output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+		os.Exit(1)
+	}
+	client := newIssueClient(authHeader)
+	ctx := context.Background()
+
+	labelIDs, err := lookupLabelIDsByNames(ctx, "issue", client.GetIssueLabels, names)
+	if err != nil {
+		output.Error(err.Error(), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if len(labelIDs) == 0 {
+		output.Error("At least one label name is required", plaintext, jsonOut)
+		os.Exit(1)
+	}
+
+	filter, requiredAllIDs, anyIDs, notIDs, wantUnlabeled, parentID, wantHasParent, wantNoParent := buildIssueFilter(cmd, client)
+	wantHasComments, wantNoComments := issuePresenceFlags(cmd, "comments")
+	wantHasAttachments, wantNoAttachments := issuePresenceFlags(cmd, "attachments")
+	includeArchived, _ := cmd.Flags().GetBool("include-archived")
+
+	progress := output.NewProgress(plaintext, jsonOut)
+	issues, err := fetchMatchingIssues(progress, 0, func(first int, after string) (*api.Issues, error) {
+		return client.GetIssues(ctx, filter, first, after, "", includeArchived, api.IssueListOptions{Presence: wantHasComments || wantNoComments || wantHasAttachments || wantNoAttachments})
+	}, func(page *api.Issues) *api.Issues {
+		page = filterIssuesAdvanced(page, requiredAllIDs, anyIDs, notIDs, wantUnlabeled)
+		page = filterIssuesByParent(page, parentID, wantHasParent, wantNoParent)
+		page = filterIssuesByComments(page, wantHasComments, wantNoComments)
+		return filterIssuesByAttachments(page, wantHasAttachments, wantNoAttachments)
+	})
+	progress.Stop()
+	if err != nil {
+		output.Error(fmt.Sprintf("Failed to fetch issues: %v", err), plaintext, jsonOut)
+		os.Exit(exitCode(err))
+	}
+	if issues.PageInfo.HasNextPage {
+		fmt.Fprintf(os.Stderr, "Warning: more than %d issues match; only the first %d are considered. Narrow the filters and run again for the rest.\n", fetchAllCap, fetchAllCap)
+	}
+
+	var pending []api.Issue
+	var results []bulkLabelResult
+	for _, issue := range issues.Nodes {
+		if labelChangeNeeded(issue, labelIDs, add) {
+			pending = append(pending, issue)
+		} else {
+			results = append(results, bulkLabelResult{Identifier: issue.Identifier, Title: issue.Title, Status: "skipped"})
+		}
+	}
+
+	dryRun, _ := cmd.Flags().GetBool("dry-run")
+	if dryRun {
+		for _, issue := range pending {
+			results = append(results, bulkLabelResult{Identifier: issue.Identifier, Title: issue.Title, Status: "would-change"})
+		}
+		renderBulkLabelResults(results, names, add, true, plaintext, jsonOut)
+		return
+	}
+
+	if len(pending) > 0 {
+		verb := "Add"
+		if !add {
+			verb = "Remove"
+		}
+		ok, err := confirm(fmt.Sprintf("%s %s on %d issues?", verb, names, len(pending)), false)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if !ok {
+			output.Info("Aborted; no issues were changed", plaintext, jsonOut)
+			return
+		}
+	}
+
+	key := "addedLabelIds"
+	if !add {
+		key = "removedLabelIds"
+	}
+	progress = output.NewProgress(plaintext, jsonOut)
+	for i, issue := range pending {
+		progress.Step("Updating issue %d of %d…", i+1, len(pending))
+		result := bulkLabelResult{Identifier: issue.Identifier, Title: issue.Title, Status: "changed"}
+		if _, err := client.UpdateIssue(ctx, issue.ID, map[string]interface{}{key: labelIDs}); err != nil {
+			result.Status = "failed"
+			result.Error = describeIssueMutationError("update issue", err)
+		}
+		results = append(results, result)
+	}
+	progress.Stop()
+
+	if failed := renderBulkLabelResults(results, names, add, false, plaintext, jsonOut); failed > 0 {
+		os.Exit(1)
+	}
+}
+
+// labelChangeNeeded reports whether adding (or removing) labelIDs would
+// change issue: it lacks one of them, or (when removing) has one.
+func labelChangeNeeded(issue api.Issue, labelIDs []string, add bool) bool {
+	has := map[string]bool{}
+	if issue.Labels != nil {
+		for _, l := range issue.Labels.Nodes {
+			has[l.ID] = true
+		}
+	}
+	for _, id := range labelIDs {
+		if has[id] != add {
+			return true
+		}
+	}
+	return false
+}
+
+// renderBulkLabelResults prints the changed, skipped and failed issues and a
+// summary, returning the number of failures.
+func renderBulkLabelResults(results []bulkLabelResult, names string, add, dryRun, plaintext, jsonOut bool) int {
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Status]++
+	}
+
+	if jsonOut {
+		if results == nil {
+			results = []bulkLabelResult{}
+		}
+		output.JSON(results)
+		return counts["failed"]
+	}
+
+	past, prep := "Added", "to"
+	if !add {
+		past, prep = "Removed", "from"
+	}
+	for _, r := range results {
+		if r.Status == "skipped" {
+			continue
+		}
+		if plaintext {
+			switch r.Status {
+			case "failed":
+				fmt.Printf("Failed %s: %s\n", r.Identifier, r.Error)
+			case "would-change":
+				fmt.Printf("Would update %s: %s\n", r.Identifier, r.Title)
+			default:
+				fmt.Printf("Updated %s: %s\n", r.Identifier, r.Title)
+			}
+			continue
+		}
+		switch r.Status {
+		case "failed":
+			fmt.Printf("%s %s: %s\n",
+				output.Color(output.RoleError).Sprint("✗"),
+				output.Color(output.RoleIdentifier).Sprint(r.Identifier),
+				output.Color(output.RoleError).Sprint(r.Error))
+		case "would-change":
+			fmt.Printf("%s %s %s\n",
+				output.Color(output.RoleMuted).Sprint("•"),
+				output.Color(output.RoleIdentifier).Sprint(r.Identifier),
+				r.Title)
+		default:
+			fmt.Printf("%s %s %s\n",
+				output.Color(output.RoleSuccess).Sprint("✓"),
+				output.Color(output.RoleIdentifier).Sprint(r.Identifier),
+				r.Title)
+		}
+	}
+
+	skipped := ""
+	if n := counts["skipped"]; n > 0 {
+		skipped = fmt.Sprintf(" (%d already up to date)", n)
+	}
+	if dryRun {
+		fmt.Printf("\nDry run: would update %d of %d issues%s\n", counts["would-change"], len(results), skipped)
+		return 0
+	}
+	fmt.Printf("\n%s %s %s %d of %d issues%s\n", past, strings.TrimSpace(names), prep, counts["changed"], len(results), skipped)
+	return counts["failed"]
+}
+
+func init() {
+	issueCmd.AddCommand(issueLabelCmd)
+	issueLabelCmd.AddCommand(issueLabelAddCmd)
+	issueLabelCmd.AddCommand(issueLabelRemoveCmd)
+
+	for _, c := range []*cobra.Command{issueLabelAddCmd, issueLabelRemoveCmd} {
+		addIssueFilterFlags(c)
+		c.Flags().Bool("dry-run", false, "List the issues that would change without updating them")
+	}
+}
@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// bulkLabelHandler serves one bug label and three backlog issues, one of
+// which already carries it, recording every issueUpdate call.
+func bulkLabelHandler(mu *sync.Mutex, updates *[]map[string]any) func(string, map[string]any) any {
+	bug := map[string]any{"id": "L_bug", "name": "bug"}
+	return func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "issueLabels"):
+			return map[string]any{"issueLabels": map[string]any{"nodes": []any{bug}}}
+		case strings.Contains(query, "issueUpdate"):
+			mu.Lock()
+			*updates = append(*updates, vars)
+			mu.Unlock()
+			return map[string]any{"issueUpdate": map[string]any{"success": true, "issue": map[string]any{"id": vars["id"]}}}
+		case strings.Contains(query, "query Issues("):
+			return map[string]any{"issues": map[string]any{
+				"nodes": []any{
+					map[string]any{"id": "i1", "identifier": "ENG-1", "title": "One", "labels": map[string]any{"nodes": []any{}}},
+					map[string]any{"id": "i2", "identifier": "ENG-2", "title": "Two", "labels": map[string]any{"nodes": []any{bug}}},
+					map[string]any{"id": "i3", "identifier": "ENG-3", "title": "Three", "labels": map[string]any{"nodes": []any{}}},
+				},
+				"pageInfo": map[string]any{"hasNextPage": false},
+			}}
+		}
+		return map[string]any{}
+	}
+}
+
+func TestIssueLabelAdd_UpdatesOnlyIssuesMissingLabel(t *testing.T) {
+	var mu sync.Mutex
+	var updates []map[string]any
+	withIssueMockServer(t, bulkLabelHandler(&mu, &updates))
+	resetFlags(t, issueLabelAddCmd)
+	_ = issueLabelAddCmd.Flags().Set("team", "ENG")
+	viper.Set("yes", true)
+	viper.Set("plaintext", true)
+	t.Cleanup(func() { viper.Set("yes", false) })
+
+	out := captureStdout(t, func() { issueLabelAddCmd.Run(issueLabelAddCmd, []string{"bug"}) })
+
+	if len(updates) != 2 {
+		t.Fatalf("expected 2 updates (ENG-2 already labeled), got %d: %v", len(updates), updates)
+	}
+	for _, u := range updates {
+		input, _ := u["input"].(map[string]any)
+		ids, _ := input["addedLabelIds"].([]any)
+		if len(ids) != 1 || ids[0] != "L_bug" || u["id"] == "i2" {
+			t.Fatalf("unexpected update %v", u)
+		}
+	}
+	if !strings.Contains(out, "Added bug to 2 of 3 issues (1 already up to date)") {
+		t.Fatalf("missing summary in output:\n%s", out)
+	}
+}
+
+func TestIssueLabelRemove_DryRunMakesNoChanges(t *testing.T) {
+	var mu sync.Mutex
+	var updates []map[string]any
+	withIssueMockServer(t, bulkLabelHandler(&mu, &updates))
+	resetFlags(t, issueLabelRemoveCmd)
+	_ = issueLabelRemoveCmd.Flags().Set("dry-run", "true")
+	viper.Set("json", true)
+
+	out := captureStdout(t, func() { issueLabelRemoveCmd.Run(issueLabelRemoveCmd, []string{"bug"}) })
+
+	if len(updates) != 0 {
+		t.Fatalf("dry run should not update issues, got %v", updates)
+	}
+	var results []bulkLabelResult
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("invalid JSON %q: %v", out, err)
+	}
+	changing := 0
+	for _, r := range results {
+		if r.Status == "would-change" {
+			changing++
+			if r.Identifier != "ENG-2" {
+				t.Fatalf("only ENG-2 has the label, got %+v", r)
+			}
+		}
+	}
+	if changing != 1 || len(results) != 3 {
+		t.Fatalf("unexpected dry-run results: %+v", results)
+	}
+}
+
+func TestIssueFilterFlags_SharedByListSearchCountAndLabel(t *testing.T) {
+	reference := &cobra.Command{}
+	addIssueFilterFlags(reference)
+	for _, cmd := range []*cobra.Command{issueListCmd, issueSearchCmd, issueCountCmd, issueLabelAddCmd, issueLabelRemoveCmd} {
+		reference.Flags().VisitAll(func(want *pflag.Flag) {
+			got := cmd.Flags().Lookup(want.Name)
+			if got == nil || got.Usage != want.Usage || got.Shorthand != want.Shorthand {
+				t.Errorf("%s: --%s is missing or differs from the shared filter flags", cmd.CommandPath(), want.Name)
+			}
+		})
+	}
+	if err := issueLabelAddCmd.ParseFlags([]string{"--exclude-state", "Done"}); err != nil {
+		t.Fatalf("issue label add should accept --exclude-state: %v", err)
+	}
+	resetFlags(t, issueLabelAddCmd)
+}
@@ -62,7 +62,7 @@ func TestLookupIssueLabelIDsByNames_TrimDedupAndMatch(t *testing.T) {
 	defer srv.Close()
 
 	client := api.NewClientWithURL(srv.URL, "Bearer test")
-	ids, err := lookupIssueLabelIDsByNames(context.Background(), client, "  Bug , API, bug  ")
+	ids, err := lookupLabelIDsByNames(context.Background(), "issue", client.GetIssueLabels, "  Bug , API, bug  ")
 	if err != nil {
 		t.Fatalf("lookup returned error: %v", err)
 	}
@@ -84,7 +84,7 @@ func TestLookupIssueLabelIDsByNames_UnknownWithSuggestions(t *testing.T) {
 	defer srv.Close()
 
 	client := api.NewClientWithURL(srv.URL, "Bearer test")
-	_, err := lookupIssueLabelIDsByNames(context.Background(), client, "bkg")
+	_, err := lookupLabelIDsByNames(context.Background(), "issue", client.GetIssueLabels, "bkg")
 	if err == nil {
 		t.Fatalf("expected error for unknown label, got nil")
 	}
@@ -94,3 +94,25 @@ func TestLookupIssueLabelIDsByNames_UnknownWithSuggestions(t *testing.T) {
 		t.Fatalf("unexpected error message: %s", msg)
 	}
 }
+
+func TestLookupLabelIDsByNames_ProjectLabels(t *testing.T) {
+	mc := &mockProjectClient{labels: []api.Label{
+		{ID: "PL_infra", Name: "Infrastructure"},
+		{ID: "PL_q3", Name: "Q3 Goals"},
+	}}
+	ids, err := lookupLabelIDsByNames(context.Background(), "project", mc.GetProjectLabels, " q3 goals,INFRASTRUCTURE, Q3 Goals ")
+	if err != nil {
+		t.Fatalf("lookup returned error: %v", err)
+	}
+	if len(ids) != 2 || ids[0] != "PL_q3" || ids[1] != "PL_infra" {
+		t.Fatalf("unexpected IDs: %v", ids)
+	}
+
+	_, err = lookupLabelIDsByNames(context.Background(), "project", mc.GetProjectLabels, "Infrastucture")
+	if err == nil {
+		t.Fatalf("expected error for unknown label, got nil")
+	}
+	if msg := err.Error(); !strings.Contains(msg, "project label not found: 'Infrastucture'") || !strings.Contains(msg, "did you mean: Infrastructure") {
+		t.Fatalf("unexpected error message: %s", msg)
+	}
+}
@@ -0,0 +1,106 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var issueOpenCmd = &cobra.Command{
+	Use:     "open [issue-id]",
+	Aliases: []string{"browse"},
+	Short:   "Open an issue in the browser",
+	Long: `Open an issue in Linear in your default browser. Without a browser
+(e.g. over SSH) the URL is printed instead.
+
+Without an issue ID, the identifier in the current git branch is used when
+running in an interactive terminal; scripts and CI must pass --from-branch.
+
+Examples:
+  linctl issue open LIN-123
+  linctl issue open                  # The issue named in the current git branch
+  linctl issue open LIN-123 --print
+  linctl issue open --from-branch --copy`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		issueID, err := issueArgOrBranch(cmd, args)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		authHeader, err := getIssueAuthHeader()
+		if err != nil {
+			output.Error("Not authenticated. Run 'linctl auth' first.", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		client := newIssueClient(authHeader)
+		issue, err := client.GetIssue(context.Background(), issueID)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to fetch issue: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if issue.URL == "" {
+			output.Error(fmt.Sprintf("Could not determine a URL for issue %s", issue.Identifier), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		printOnly, _ := cmd.Flags().GetBool("print")
+		copyURL, _ := cmd.Flags().GetBool("copy")
+
+		result := map[string]interface{}{
+			"id":         issue.ID,
+			"identifier": issue.Identifier,
+			"url":        issue.URL,
+		}
+
+		switch {
+		case printOnly:
+			if jsonOut {
+				output.JSON(result)
+				return
+			}
+			fmt.Println(issue.URL)
+		case copyURL:
+			err := copyToClipboard(issue.URL)
+			result["copied"] = err == nil
+			if jsonOut {
+				output.JSON(result)
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Clipboard unavailable (%v); issue URL:\n", err)
+				fmt.Println(issue.URL)
+				return
+			}
+			output.Success(fmt.Sprintf("Copied %s URL to clipboard: %s", issue.Identifier, issue.URL), plaintext, jsonOut)
+		default:
+			err := openBrowser(issue.URL)
+			result["opened"] = err == nil
+			if jsonOut {
+				output.JSON(result)
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Could not open a browser (%v); issue URL:\n", err)
+				fmt.Println(issue.URL)
+				return
+			}
+			output.Success(fmt.Sprintf("Opened %s in your browser: %s", issue.Identifier, issue.URL), plaintext, jsonOut)
+		}
+	},
+}
+
+func init() {
+	issueCmd.AddCommand(issueOpenCmd)
+
+	issueOpenCmd.Flags().Bool("print", false, "Print the issue URL instead of opening it")
+	issueOpenCmd.Flags().Bool("copy", false, "Copy the issue URL to the clipboard instead of opening it")
+}
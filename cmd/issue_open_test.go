@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestIssueOpen_FromBranch(t *testing.T) {
+	withGitBranch(t, "ann/eng-42-fix-login")
+	var opened []string
+	origOpen := openBrowser
+	openBrowser = func(u string) error {
+		opened = append(opened, u)
+		return nil
+	}
+	issueOpenCmd.InheritedFlags()
+	t.Cleanup(func() {
+		openBrowser = origOpen
+		resetFlags(t, issueOpenCmd)
+	})
+	var requested any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		requested = vars["id"]
+		return map[string]any{"issue": map[string]any{
+			"id":         "issue-1",
+			"identifier": "ENG-42",
+			"url":        "https://linear.app/acme/issue/ENG-42/fix-login",
+		}}
+	})
+
+	_ = issueOpenCmd.Flags().Set("from-branch", "true")
+	viper.Set("plaintext", true)
+	out := captureStdout(t, func() { issueOpenCmd.Run(issueOpenCmd, nil) })
+	if requested != "ENG-42" {
+		t.Fatalf("expected ENG-42 from the branch to be fetched, got %v", requested)
+	}
+	if len(opened) != 1 || opened[0] != "https://linear.app/acme/issue/ENG-42/fix-login" {
+		t.Fatalf("expected the issue URL to be opened, got %v", opened)
+	}
+	if !strings.Contains(out, "Opened ENG-42") {
+		t.Fatalf("unexpected output: %s", out)
+	}
+}
@@ -1,8 +1,15 @@
 package cmd
 
 import (
+	"context"
 	"errors"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/fatih/color"
 )
 
 func TestIsValidUUID(t *testing.T) {
@@ -30,25 +37,58 @@ func TestIsValidUUID(t *testing.T) {
 }
 
 func TestBuildProjectInput(t *testing.T) {
+	ctx := context.Background()
+
 	// Empty → ok=false, no input
-	if val, ok, err := buildProjectInput(""); err != nil || ok || val != nil {
+	if val, ok, err := buildProjectInput(ctx, nil, "", ""); err != nil || ok || val != nil {
 		t.Errorf("empty flag: want (nil,false,nil), got (%v,%v,%v)", val, ok, err)
 	}
 
 	// unassigned → ok=true, val=nil
-	if val, ok, err := buildProjectInput("unassigned"); err != nil || !ok || val != nil {
+	if val, ok, err := buildProjectInput(ctx, nil, "unassigned", ""); err != nil || !ok || val != nil {
 		t.Errorf("unassigned: want (nil,true,nil), got (%v,%v,%v)", val, ok, err)
 	}
 
-	// valid uuid → ok=true, val=uuid
+	// valid uuid → ok=true, val=uuid, no lookup
 	uuid := "123e4567-e89b-12d3-a456-426614174000"
-	if val, ok, err := buildProjectInput(uuid); err != nil || !ok || val != uuid {
+	if val, ok, err := buildProjectInput(ctx, nil, uuid, ""); err != nil || !ok || val != uuid {
 		t.Errorf("uuid: want (%s,true,nil), got (%v,%v,%v)", uuid, val, ok, err)
 	}
+}
+
+func TestResolveProjectByName(t *testing.T) {
+	var filter map[string]any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		filter, _ = vars["filter"].(map[string]any)
+		nodes := []any{
+			map[string]any{"id": "p-1", "name": "Mobile App"},
+			map[string]any{"id": "p-2", "name": "Mobile App v2"},
+			map[string]any{"id": "p-3", "name": "Website"},
+			map[string]any{"id": "p-4", "name": "website"},
+		}
+		return map[string]any{"projects": map[string]any{"nodes": nodes}}
+	})
+	client := newIssueClient("")
+	ctx := context.Background()
+
+	val, ok, err := buildProjectInput(ctx, client, "mobile app", "ENG")
+	if err != nil || !ok || val != "p-1" {
+		t.Fatalf("exact name: want (p-1,true,nil), got (%v,%v,%v)", val, ok, err)
+	}
+	if teams, _ := filter["accessibleTeams"].(map[string]any); teams == nil {
+		t.Fatalf("expected lookup scoped to the team, got filter %v", filter)
+	}
 
-	// invalid uuid → error
-	if _, _, err := buildProjectInput("not-a-uuid"); err == nil {
-		t.Errorf("expected error for invalid uuid")
+	if _, err := resolveProjectByName(ctx, client, "Website", ""); err == nil || !strings.Contains(err.Error(), "ambiguous") ||
+		!strings.Contains(err.Error(), "p-3") || !strings.Contains(err.Error(), "p-4") {
+		t.Fatalf("expected ambiguity error listing both IDs, got %v", err)
+	}
+	if _, ok := filter["accessibleTeams"]; ok {
+		t.Fatalf("expected unscoped lookup without a team, got filter %v", filter)
+	}
+
+	if _, err := resolveProjectByName(ctx, client, "Mobile", ""); err == nil || !strings.Contains(err.Error(), "Did you mean") {
+		t.Fatalf("expected not-found error with candidates, got %v", err)
 	}
 }
 
@@ -70,3 +110,199 @@ func TestIsProjectNotFoundErr(t *testing.T) {
 		}
 	}
 }
+
+func TestRenderIssueCollection_EmptyJSONIsArray(t *testing.T) {
+	out := captureStdout(t, func() {
+		renderIssueCollection(&api.Issues{}, false, true, issueCollectionOptions{emptyMessage: "No issues found"})
+	})
+	if got := strings.TrimSpace(out); got != "[]" {
+		t.Fatalf("expected empty JSON array, got %q", got)
+	}
+}
+
+func TestRenderIssueCollection_PlaintextTable(t *testing.T) {
+	issues := &api.Issues{Nodes: []api.Issue{{
+		Identifier: "ENG-1",
+		Title:      "Handle a|b in titles",
+		State:      &api.State{Name: "Todo"},
+		URL:        "https://linear.app/acme/issue/ENG-1",
+	}}}
+	out := captureStdout(t, func() {
+		renderIssueCollection(issues, true, false, issueCollectionOptions{summaryLabel: "issues", plaintextTable: true})
+	})
+	for _, want := range []string{"| ID ", "| ENG-1 ", `Handle a\|b in titles`, "Unassigned", "Total: 1 issues"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("plaintext table missing %q:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "## Handle") {
+		t.Fatalf("expected table instead of block output:\n%s", out)
+	}
+}
+
+func TestRenderIssueCollection_ShowAge(t *testing.T) {
+	issues := &api.Issues{Nodes: []api.Issue{{
+		Identifier: "ENG-1",
+		Title:      "Stale",
+		CreatedAt:  time.Now().Add(-3 * 24 * time.Hour),
+	}}}
+	out := captureStdout(t, func() {
+		renderIssueCollection(issues, true, false, issueCollectionOptions{summaryLabel: "issues", plaintextTable: true, showAge: true})
+	})
+	if !strings.Contains(out, " | Age | URL |") || !strings.Contains(out, "| 3d  |") {
+		t.Fatalf("expected an Age column after Created:\n%s", out)
+	}
+
+	out = captureStdout(t, func() {
+		renderIssueCollection(issues, true, false, issueCollectionOptions{summaryLabel: "issues", plaintextTable: true})
+	})
+	if strings.Contains(out, "Age") {
+		t.Fatalf("Age column should be opt-in:\n%s", out)
+	}
+}
+
+func TestRenderIssueCollection_EmptyCellsUsePlaceholder(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() {
+		output.SetEmptyCell("-")
+		color.NoColor = origNoColor
+	})
+	color.NoColor = true
+	output.SetEmptyCell("n/a")
+
+	issues := &api.Issues{Nodes: []api.Issue{{
+		Identifier: "ENG-1",
+		Title:      "Orphan",
+		State:      &api.State{Name: "Todo"},
+		Assignee:   &api.User{Name: "Ann"},
+		URL:        "https://linear.app/acme/issue/ENG-1",
+	}}}
+	out := captureStdout(t, func() {
+		renderIssueCollection(issues, false, false, issueCollectionOptions{summaryLabel: "issues"})
+	})
+
+	var row string
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "Orphan") {
+			row = line
+		}
+	}
+	// Team, Project, Parent and Labels are all empty.
+	if got := strings.Count(row, "n/a"); got != 4 {
+		t.Fatalf("expected the placeholder in 4 columns, got %d:\n%s", got, out)
+	}
+
+	issues.Nodes[0].State = nil
+	out = captureStdout(t, func() {
+		renderIssueCollection(issues, false, false, issueCollectionOptions{summaryLabel: "issues"})
+	})
+	for _, line := range strings.Split(out, "\n") {
+		if strings.Contains(line, "Orphan") {
+			row = line
+		}
+	}
+	if got := strings.Count(row, "n/a"); got != 5 {
+		t.Fatalf("expected the placeholder in the State column too, got %d:\n%s", got, out)
+	}
+}
+
+func TestRenderIssueCollection_MarkdownMode(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() {
+		output.SetMarkdown(false)
+		color.NoColor = origNoColor
+	})
+	output.SetMarkdown(true)
+
+	out := captureStdout(t, func() {
+		renderIssueCollection(&api.Issues{}, false, false, issueCollectionOptions{emptyMessage: "No issues found"})
+	})
+	if !strings.HasPrefix(out, "| ID ") || strings.Contains(out, "No issues found") {
+		t.Fatalf("expected a header-only markdown table for empty results, got:\n%s", out)
+	}
+}
+
+func TestHighlightTerms(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() { color.NoColor = origNoColor })
+	color.NoColor = false
+
+	em := color.New(color.Bold, color.Underline)
+	cases := []struct {
+		title, query, want string
+	}{
+		{"Login fails on Safari", "login", em.Sprint("Login") + " fails on Safari"},
+		{"Login fails on Safari", "SAFARI login", em.Sprint("Login") + " fails on " + em.Sprint("Safari")},
+		{"Logging is noisy", "log logging", em.Sprint("Logging") + " is noisy"},
+		{"Price is $5 (approx)", `"$5"`, "Price is " + em.Sprint("$5") + " (approx)"},
+		{"Exact phrase here", `"exact phrase" label:bug`, em.Sprint("Exact phrase") + " here"},
+		{"Nothing to see", "", "Nothing to see"},
+	}
+	for _, tc := range cases {
+		if got := highlightTerms(tc.title, tc.query); got != tc.want {
+			t.Errorf("highlightTerms(%q, %q) = %q, want %q", tc.title, tc.query, got, tc.want)
+		}
+	}
+
+	color.NoColor = true
+	if got := highlightTerms("Login fails", "login"); got != "Login fails" {
+		t.Errorf("expected no highlighting with colors disabled, got %q", got)
+	}
+}
+
+func TestWatchIssues_SkipsUnchangedResults(t *testing.T) {
+	polls := []*api.Issues{
+		{Nodes: []api.Issue{{Identifier: "ENG-1", Title: "First"}}},
+		{Nodes: []api.Issue{{Identifier: "ENG-1", Title: "First"}}},
+		{Nodes: []api.Issue{{Identifier: "ENG-1", Title: "First (renamed)"}}},
+		{Nodes: []api.Issue{{Identifier: "ENG-1", Title: "First (renamed)"}}},
+	}
+	stop := make(chan struct{})
+	loads, renders := 0, 0
+	err := watchIssues(time.Millisecond, stop, func() (*api.Issues, error) {
+		issues := polls[loads]
+		loads++
+		if loads == len(polls) {
+			close(stop)
+		}
+		return issues, nil
+	}, func(*api.Issues) { renders++ }, func(err error) { t.Fatalf("unexpected error: %v", err) })
+
+	if err != nil || loads != 4 || renders != 2 {
+		t.Fatalf("loads=%d renders=%d err=%v; want every poll fetched but only changes rendered", loads, renders, err)
+	}
+	if err := validateWatchInterval(time.Second); err == nil {
+		t.Fatalf("expected intervals under %s to be rejected", minWatchInterval)
+	}
+}
+
+func TestWatchIssues_KeepsWatchingAfterFailedRefresh(t *testing.T) {
+	blip := errors.New("Could not reach Linear API")
+	polls := []error{nil, blip, nil}
+	stop := make(chan struct{})
+	loads, renders := 0, 0
+	var reported []error
+	err := watchIssues(time.Millisecond, stop, func() (*api.Issues, error) {
+		err := polls[loads]
+		loads++
+		if loads == len(polls) {
+			close(stop)
+		}
+		if err != nil {
+			return nil, err
+		}
+		return &api.Issues{Nodes: []api.Issue{{Identifier: "ENG-1"}}}, nil
+	}, func(*api.Issues) { renders++ }, func(err error) { reported = append(reported, err) })
+
+	if err != nil || loads != 3 || renders != 1 || len(reported) != 1 {
+		t.Fatalf("err=%v loads=%d renders=%d reported=%v; want the failed refresh reported and the watch kept alive", err, loads, renders, reported)
+	}
+
+	// Without a first result there is nothing to keep showing
+	err = watchIssues(time.Millisecond, nil, func() (*api.Issues, error) { return nil, blip }, func(*api.Issues) {
+		t.Fatal("nothing should render")
+	}, func(error) { t.Fatal("a failed first load must be returned, not reported") })
+	if !errors.Is(err, blip) {
+		t.Fatalf("expected the first load's error, got %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package cmd
+
+import "github.com/raegislabs/linctl/pkg/api"
+
+// issueTotals is the summary --with-totals adds to issue list --json.
+type issueTotals struct {
+	Count          int            `json:"count"`
+	Estimate       float64        `json:"estimate"`       // sum of estimates
+	EstimatedCount int            `json:"estimatedCount"` // issues that have an estimate
+	ByStateType    map[string]int `json:"byStateType"`    // e.g. {"started": 3, "unstarted": 5}
+}
+
+// issueListWithTotals is the --with-totals JSON shape. Nodes holds the same
+// entries the plain --json array would (enriched with --enrich).
+type issueListWithTotals struct {
+	Nodes  interface{} `json:"nodes"`
+	Totals issueTotals `json:"totals"`
+}
+
+// summarizeIssues totals the fetched issues. Issues without a state are
+// counted under "unknown".
+func summarizeIssues(issues []api.Issue) issueTotals {
+	totals := issueTotals{Count: len(issues), ByStateType: map[string]int{}}
+	for _, issue := range issues {
+		if issue.Estimate != nil {
+			totals.Estimate += *issue.Estimate
+			totals.EstimatedCount++
+		}
+		stateType := "unknown"
+		if issue.State != nil && issue.State.Type != "" {
+			stateType = issue.State.Type
+		}
+		totals.ByStateType[stateType]++
+	}
+	return totals
+}
@@ -0,0 +1,50 @@
+package cmd
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestIssueList_WithTotals(t *testing.T) {
+	withIssueMockServer(t, func(query string, _ map[string]any) any {
+		if strings.Contains(query, "query Issues(") {
+			return map[string]any{"issues": map[string]any{"nodes": []any{
+				map[string]any{"id": "1", "identifier": "ENG-1", "estimate": 3, "state": map[string]any{"type": "started"}},
+				map[string]any{"id": "2", "identifier": "ENG-2", "estimate": 2.5, "state": map[string]any{"type": "unstarted"}},
+				map[string]any{"id": "3", "identifier": "ENG-3", "state": map[string]any{"type": "started"}},
+			}}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueListCmd)
+	t.Cleanup(func() { resetFlags(t, issueListCmd) })
+	viper.Set("json", true)
+
+	// Default output stays a bare array
+	out := captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+	if !strings.HasPrefix(strings.TrimSpace(out), "[") {
+		t.Fatalf("expected a JSON array without --with-totals, got %s", out)
+	}
+
+	_ = issueListCmd.Flags().Set("with-totals", "true")
+	_ = issueListCmd.Flags().Set("enrich", "true")
+	out = captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+	var got struct {
+		Nodes  []map[string]any `json:"nodes"`
+		Totals issueTotals      `json:"totals"`
+	}
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(got.Nodes) != 3 || got.Nodes[0]["ageDays"] == nil {
+		t.Fatalf("expected the three enriched issues under nodes, got %v", got.Nodes)
+	}
+	want := issueTotals{Count: 3, Estimate: 5.5, EstimatedCount: 2, ByStateType: map[string]int{"started": 2, "unstarted": 1}}
+	if got.Totals.Count != want.Count || got.Totals.Estimate != want.Estimate || got.Totals.EstimatedCount != want.EstimatedCount ||
+		len(got.Totals.ByStateType) != 2 || got.Totals.ByStateType["started"] != 2 || got.Totals.ByStateType["unstarted"] != 1 {
+		t.Fatalf("totals = %+v, want %+v", got.Totals, want)
+	}
+}
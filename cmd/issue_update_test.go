@@ -0,0 +1,265 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestIssueUpdate_Cycle(t *testing.T) {
+	cases := []struct {
+		value string
+		want  any
+	}{
+		{value: "current", want: "cycle-active"},
+		{value: "7", want: "cycle-7"},
+		{value: "none", want: nil},
+	}
+	for _, tc := range cases {
+		t.Run(tc.value, func(t *testing.T) {
+			var input map[string]any
+			var cycleNumber any
+			withIssueMockServer(t, func(query string, vars map[string]any) any {
+				switch {
+				case strings.Contains(query, "TeamActiveCycle"):
+					return map[string]any{"team": map[string]any{"activeCycle": map[string]any{"id": "cycle-active", "number": 8}}}
+				case strings.Contains(query, "TeamCycleByNumber"):
+					cycleNumber = vars["number"]
+					return map[string]any{"team": map[string]any{"cycles": map[string]any{"nodes": []any{
+						map[string]any{"id": "cycle-7", "number": 7},
+					}}}}
+				case strings.Contains(query, "issueUpdate"):
+					input, _ = vars["input"].(map[string]any)
+					return map[string]any{"issueUpdate": map[string]any{"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7"}}}
+				case strings.Contains(query, "query Issue("):
+					return map[string]any{"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7", "team": map[string]any{"key": "ENG"}}}
+				}
+				return map[string]any{}
+			})
+			resetFlags(t, issueUpdateCmd)
+			viper.Set("plaintext", true)
+			_ = issueUpdateCmd.Flags().Set("cycle", tc.value)
+
+			out := captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+
+			got, ok := input["cycleId"]
+			if !ok || got != tc.want {
+				t.Fatalf("expected cycleId %v, got %v (input %v)", tc.want, got, input)
+			}
+			if tc.value == "7" && cycleNumber != float64(7) {
+				t.Fatalf("expected cycle number 7 in query variables, got %v", cycleNumber)
+			}
+			if !strings.Contains(out, "Updated issue ENG-7") {
+				t.Fatalf("unexpected output: %s", out)
+			}
+		})
+	}
+}
+
+func TestResolveIssuePriority(t *testing.T) {
+	cases := []struct {
+		value   string
+		current int
+		want    int
+	}{
+		{value: "2", current: 4, want: 2},
+		{value: "urgent", current: 4, want: 1},
+		{value: "+1", current: 4, want: 3},
+		{value: "+1", current: 0, want: 4},
+		{value: "+2", current: 3, want: 1},
+		{value: "+1", current: 1, want: 1},
+		{value: "-1", current: 2, want: 3},
+		{value: "-1", current: 4, want: 0},
+		{value: "-3", current: 3, want: 0},
+	}
+	for _, tc := range cases {
+		t.Run(tc.value, func(t *testing.T) {
+			got, err := resolveIssuePriority(tc.value, func() int { return tc.current })
+			if err != nil {
+				t.Fatalf("resolveIssuePriority(%q): %v", tc.value, err)
+			}
+			if got != tc.want {
+				t.Fatalf("resolveIssuePriority(%q) from %d = %d, want %d", tc.value, tc.current, got, tc.want)
+			}
+		})
+	}
+
+	for _, bad := range []string{"5", "+x", "soon"} {
+		if _, err := resolveIssuePriority(bad, func() int { return 3 }); err == nil {
+			t.Fatalf("expected an error for %q", bad)
+		}
+	}
+}
+
+func TestIssueUpdate_RelativePriority(t *testing.T) {
+	var input map[string]any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "issueUpdate"):
+			input, _ = vars["input"].(map[string]any)
+			return map[string]any{"issueUpdate": map[string]any{"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7"}}}
+		case strings.Contains(query, "query Issue("):
+			return map[string]any{"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7", "priority": 3, "team": map[string]any{"key": "ENG"}}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueUpdateCmd)
+	viper.Set("plaintext", true)
+	_ = issueUpdateCmd.Flags().Parse([]string{"--priority", "-1"})
+
+	_ = captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+
+	if got := input["priority"]; got != float64(4) {
+		t.Fatalf("expected Normal -1 to become Low (4), got %v (input %v)", got, input)
+	}
+}
+
+func TestIssueUpdate_ConfirmsDestructiveChanges(t *testing.T) {
+	var updated bool
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		switch {
+		case strings.Contains(query, "issueUpdate"):
+			updated = true
+			return map[string]any{"issueUpdate": map[string]any{"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7"}}}
+		case strings.Contains(query, "query Issue("):
+			return map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-7",
+				"assignee": map[string]any{"id": "u1", "name": "Jane Doe"},
+				"labels":   map[string]any{"nodes": []any{map[string]any{"id": "L1", "name": "bug"}}},
+			}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueUpdateCmd)
+	viper.Set("plaintext", true)
+	prompt := withConfirmInput(t, true, "y\n")
+	_ = issueUpdateCmd.Flags().Set("assignee", "unassigned")
+	_ = issueUpdateCmd.Flags().Set("label", "")
+
+	captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+
+	if !updated {
+		t.Fatalf("expected the update to be applied after confirming")
+	}
+	for _, want := range []string{"Assignee: Jane Doe → (unassigned)", "Labels: bug → (none)", "[y/N]"} {
+		if !strings.Contains(prompt.String(), want) {
+			t.Fatalf("prompt missing %q:\n%s", want, prompt.String())
+		}
+	}
+
+	// --force and the global --yes skip the prompt, even without a terminal
+	for _, skip := range []string{"force", "yes"} {
+		updated = false
+		prompt = withConfirmInput(t, false, "")
+		if skip == "force" {
+			_ = issueUpdateCmd.Flags().Set("force", "true")
+		} else {
+			_ = issueUpdateCmd.Flags().Set("force", "false")
+			viper.Set("yes", true)
+		}
+		captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+		if !updated || prompt.Len() != 0 {
+			t.Fatalf("expected --%s to update without prompting (updated=%v, prompt=%q)", skip, updated, prompt.String())
+		}
+	}
+	viper.Set("yes", false)
+}
+
+func TestIssueUpdate_EchoesChanges(t *testing.T) {
+	requests := 0
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		requests++
+		switch {
+		case strings.Contains(query, "query Batch"):
+			return map[string]any{
+				"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7", "team": map[string]any{"key": "ENG"}},
+				"states": map[string]any{"team": map[string]any{"states": map[string]any{"nodes": []any{
+					map[string]any{"id": "st-2", "name": "In Progress", "type": "started"},
+				}}}},
+				"labels": map[string]any{"nodes": []any{
+					map[string]any{"id": "l-1", "name": "bug"},
+				}},
+			}
+		case strings.Contains(query, "issueUpdate"):
+			return map[string]any{"issueUpdate": map[string]any{"issue": map[string]any{
+				"id": "issue-1", "identifier": "ENG-7", "priority": 2,
+				"state":  map[string]any{"id": "st-2", "name": "In Progress"},
+				"labels": map[string]any{"nodes": []any{map[string]any{"id": "l-1", "name": "bug"}, map[string]any{"id": "l-0", "name": "ui"}}},
+			}}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, issueUpdateCmd)
+	viper.Set("plaintext", true)
+	_ = issueUpdateCmd.Flags().Set("state", "in progress")
+	_ = issueUpdateCmd.Flags().Set("priority", "high")
+	_ = issueUpdateCmd.Flags().Set("add-label", "bug")
+
+	out := captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+	want := "Updated issue ENG-7\n- State: In Progress\n- Priority: High\n- Labels added: bug\n- Labels: bug, ui\n"
+	if out != want {
+		t.Fatalf("unexpected summary:\n%s\nwant:\n%s", out, want)
+	}
+	// The issue, its team's states and the labels come back in one
+	// request, followed by the update itself
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+}
+
+func TestIssueUpdate_DueDate(t *testing.T) {
+	cases := []struct {
+		name    string
+		flags   map[string]string
+		want    any
+		present bool
+	}{
+		{name: "set", flags: map[string]string{"due-date": "2024-12-31"}, want: "2024-12-31", present: true},
+		{name: "set from timestamp", flags: map[string]string{"due-date": "2024-12-31T10:00:00Z"}, want: "2024-12-31", present: true},
+		{name: "none", flags: map[string]string{"due-date": "none"}, want: nil, present: true},
+		{name: "clear keyword", flags: map[string]string{"due-date": "Clear"}, want: nil, present: true},
+		{name: "empty", flags: map[string]string{"due-date": ""}, want: nil, present: true},
+		{name: "clear flag", flags: map[string]string{"clear-due-date": "true"}, want: nil, present: true},
+		{name: "left alone", flags: map[string]string{"title": "Renamed"}, present: false},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var input map[string]any
+			withIssueMockServer(t, func(query string, vars map[string]any) any {
+				if strings.Contains(query, "issueUpdate") {
+					input, _ = vars["input"].(map[string]any)
+					return map[string]any{"issueUpdate": map[string]any{"issue": map[string]any{"id": "issue-1", "identifier": "ENG-7"}}}
+				}
+				return map[string]any{}
+			})
+			resetFlags(t, issueUpdateCmd)
+			viper.Set("plaintext", true)
+			for name, value := range tc.flags {
+				_ = issueUpdateCmd.Flags().Set(name, value)
+			}
+
+			captureStdout(t, func() { issueUpdateCmd.Run(issueUpdateCmd, []string{"ENG-7"}) })
+
+			got, ok := input["dueDate"]
+			if ok != tc.present || got != tc.want {
+				t.Fatalf("dueDate = %v (sent %v), want %v (sent %v)", got, ok, tc.want, tc.present)
+			}
+		})
+	}
+}
+
+func TestIssueDueDateUpdate_Rejects(t *testing.T) {
+	for _, flags := range []map[string]string{
+		{"due-date": "2024-12-31", "clear-due-date": "true"},
+		{"due-date": "next friday"},
+	} {
+		resetFlags(t, issueUpdateCmd)
+		for name, value := range flags {
+			_ = issueUpdateCmd.Flags().Set(name, value)
+		}
+		if _, _, err := issueDueDateUpdate(issueUpdateCmd); err == nil {
+			t.Errorf("expected an error for %v", flags)
+		}
+	}
+}
@@ -0,0 +1,89 @@
+package cmd
+
+import (
+	"context"
+	"regexp"
+	"sync"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+// mentionPattern matches a user mention stored by ID, <@uuid>. Text that only
+// looks like one, such as a bare @uuid or an unclosed <@uuid, is left alone.
+var mentionPattern = regexp.MustCompile(`<@([0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12})>`)
+
+// userByIDLookup is the part of the API needed to resolve mentions.
+type userByIDLookup interface {
+	GetUsersByIDs(ctx context.Context, ids []string) ([]api.User, error)
+}
+
+// mentionNames caches user IDs resolved to display names for the life of the
+// process. IDs that matched no user map to "" so they aren't looked up again.
+var (
+	mentionNamesMu sync.Mutex
+	mentionNames   = map[string]string{}
+)
+
+// resolveMentions rewrites ID mentions in each text to @Name, looking up all
+// uncached IDs in one request. When the lookup fails the texts are left as
+// they are; mentions of unknown users are left as well.
+func resolveMentions(ctx context.Context, client userByIDLookup, texts ...*string) {
+	mentionNamesMu.Lock()
+	defer mentionNamesMu.Unlock()
+
+	var missing []string
+	queued := map[string]bool{}
+	for _, text := range texts {
+		for _, m := range mentionPattern.FindAllStringSubmatch(*text, -1) {
+			id := m[1]
+			if _, ok := mentionNames[id]; !ok && !queued[id] {
+				queued[id] = true
+				missing = append(missing, id)
+			}
+		}
+	}
+
+	if len(missing) > 0 {
+		users, err := client.GetUsersByIDs(ctx, missing)
+		if err == nil {
+			for _, id := range missing {
+				mentionNames[id] = ""
+			}
+			for _, u := range users {
+				name := u.DisplayName
+				if name == "" {
+					name = u.Name
+				}
+				mentionNames[u.ID] = name
+			}
+		}
+	}
+
+	for _, text := range texts {
+		*text = mentionPattern.ReplaceAllStringFunc(*text, func(token string) string {
+			id := mentionPattern.FindStringSubmatch(token)[1]
+			if name := mentionNames[id]; name != "" {
+				return "@" + name
+			}
+			return token
+		})
+	}
+}
+
+// resolveIssueMentions resolves mentions in an issue's description and in
+// every loaded comment and reply.
+func resolveIssueMentions(ctx context.Context, client userByIDLookup, issue *api.Issue) {
+	texts := []*string{&issue.Description}
+	if issue.Comments != nil {
+		for i := range issue.Comments.Nodes {
+			comment := &issue.Comments.Nodes[i]
+			texts = append(texts, &comment.Body)
+			if comment.Children != nil {
+				for j := range comment.Children.Nodes {
+					texts = append(texts, &comment.Children.Nodes[j].Body)
+				}
+			}
+		}
+	}
+	resolveMentions(ctx, client, texts...)
+}
@@ -0,0 +1,95 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+type fakeUserLookup struct {
+	users []api.User
+	err   error
+	calls [][]string
+}
+
+func (f *fakeUserLookup) GetUsersByIDs(_ context.Context, ids []string) ([]api.User, error) {
+	f.calls = append(f.calls, ids)
+	return f.users, f.err
+}
+
+const (
+	adaID   = "11111111-1111-4111-8111-111111111111"
+	graceID = "22222222-2222-4222-8222-222222222222"
+	ghostID = "33333333-3333-4333-8333-333333333333"
+)
+
+func resetMentionCache(t *testing.T) {
+	t.Helper()
+	mentionNames = map[string]string{}
+	t.Cleanup(func() { mentionNames = map[string]string{} })
+}
+
+func TestResolveIssueMentions_BatchesAndCaches(t *testing.T) {
+	resetMentionCache(t)
+	lookup := &fakeUserLookup{users: []api.User{
+		{ID: adaID, Name: "Ada Lovelace", DisplayName: "ada"},
+		{ID: graceID, Name: "Grace Hopper"},
+	}}
+	issue := &api.Issue{
+		Description: "cc <@" + adaID + "> and <@" + graceID + ">",
+		Comments: &api.Comments{Nodes: []api.Comment{{
+			Body:     "thanks <@" + adaID + ">",
+			Children: &api.Comments{Nodes: []api.Comment{{Body: "ping <@" + ghostID + ">"}}},
+		}}},
+	}
+
+	resolveIssueMentions(context.Background(), lookup, issue)
+
+	if issue.Description != "cc @ada and @Grace Hopper" {
+		t.Fatalf("unexpected description: %q", issue.Description)
+	}
+	if got := issue.Comments.Nodes[0].Body; got != "thanks @ada" {
+		t.Fatalf("unexpected comment: %q", got)
+	}
+	if got := issue.Comments.Nodes[0].Children.Nodes[0].Body; got != "ping <@"+ghostID+">" {
+		t.Fatalf("unknown users should stay as-is, got %q", got)
+	}
+	if len(lookup.calls) != 1 || len(lookup.calls[0]) != 3 {
+		t.Fatalf("expected one batched lookup of 3 IDs, got %v", lookup.calls)
+	}
+
+	again := "<@" + adaID + "> <@" + ghostID + ">"
+	resolveMentions(context.Background(), lookup, &again)
+	if again != "@ada <@"+ghostID+">" || len(lookup.calls) != 1 {
+		t.Fatalf("expected cached names without another lookup, got %q after %d calls", again, len(lookup.calls))
+	}
+}
+
+func TestResolveMentions_DegradesOnLookupFailure(t *testing.T) {
+	resetMentionCache(t)
+	lookup := &fakeUserLookup{err: errors.New("boom")}
+	text := "cc <@" + adaID + ">"
+
+	resolveMentions(context.Background(), lookup, &text)
+
+	if text != "cc <@"+adaID+">" {
+		t.Fatalf("text should be unchanged when lookup fails, got %q", text)
+	}
+	if _, cached := mentionNames[adaID]; cached {
+		t.Fatal("failed lookups should not be cached")
+	}
+}
+
+func TestResolveMentions_OnlyWrappedIDs(t *testing.T) {
+	resetMentionCache(t)
+	lookup := &fakeUserLookup{users: []api.User{{ID: adaID, Name: "Ada Lovelace"}}}
+	text := "see @" + adaID + " and <@" + adaID + " (unclosed)"
+
+	resolveMentions(context.Background(), lookup, &text)
+
+	if text != "see @"+adaID+" and <@"+adaID+" (unclosed)" || len(lookup.calls) != 0 {
+		t.Fatalf("only <@uuid> is a mention, got %q after %d lookups", text, len(lookup.calls))
+	}
+}
@@ -0,0 +1,87 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// issueFieldHint maps an issue input field Linear may reject to what the user
+// can do about it. keywords are matched against Linear's error text when it
+// doesn't name the field in invalidArgs.
+type issueFieldHint struct {
+	field    string
+	keywords []string
+	hint     string
+}
+
+var issueFieldHints = []issueFieldHint{
+	{"assigneeId", []string{"assigneeid", "assignee"}, "invalid assignee; the user may not exist or may not be able to take issues in this team (see 'linctl user list')"},
+	{"projectId", []string{"projectid", "project"}, "unknown project; check the name or ID with 'linctl project list'"},
+	{"labelIds", []string{"labelids", "label"}, "invalid label; labels must exist and belong to the issue's team or the workspace"},
+	{"stateId", []string{"stateid", "workflow state", "state"}, "state not allowed; it must be one of the team's states (see 'linctl team states TEAM-KEY')"},
+	{"parentId", []string{"parentid", "parent"}, "invalid parent issue; check the identifier with 'linctl issue get'"},
+	{"dueDate", []string{"duedate", "due date"}, "invalid due date; use YYYY-MM-DD"},
+	{"estimate", []string{"estimate"}, "estimate not allowed; use a value from the team's estimation scale"},
+}
+
+// describeIssueMutationError explains why Linear rejected an issue create or
+// update, naming the offending field when it can be identified from the
+// error's extensions or text. Errors that aren't GraphQL errors, or don't
+// point at a known field, keep Linear's own message. With --debug the raw
+// error is also written to stderr.
+func describeIssueMutationError(action string, err error) string {
+	if viper.GetBool("debug") {
+		fmt.Fprintf(os.Stderr, "[debug] raw error: %v\n", err)
+	}
+
+	var gqlErrs api.GraphQLErrors
+	if !errors.As(err, &gqlErrs) || len(gqlErrs) == 0 {
+		return fmt.Sprintf("Failed to %s: %v", action, err)
+	}
+
+	var parts []string
+	for _, gqlErr := range gqlErrs {
+		detail := gqlErr.Message
+		var invalidArgs []string
+		if ext := gqlErr.Extensions; ext != nil {
+			if ext.UserPresentableMessage != "" {
+				detail = ext.UserPresentableMessage
+			}
+			invalidArgs = ext.InvalidArgs
+		}
+		if hint, ok := matchIssueFieldHint(invalidArgs, gqlErr.Message+" "+detail); ok {
+			parts = append(parts, fmt.Sprintf("%s (%s: %s)", hint.hint, hint.field, detail))
+		} else {
+			parts = append(parts, detail)
+		}
+	}
+	return fmt.Sprintf("Failed to %s: %s", action, strings.Join(parts, "; "))
+}
+
+// matchIssueFieldHint prefers the fields Linear names in invalidArgs and
+// falls back to looking for field names in the error text.
+func matchIssueFieldHint(invalidArgs []string, text string) (issueFieldHint, bool) {
+	for _, arg := range invalidArgs {
+		// invalidArgs may be a path such as "input.labelIds"
+		arg = arg[strings.LastIndex(arg, ".")+1:]
+		for _, h := range issueFieldHints {
+			if strings.EqualFold(arg, h.field) {
+				return h, true
+			}
+		}
+	}
+	text = strings.ToLower(text)
+	for _, h := range issueFieldHints {
+		for _, kw := range h.keywords {
+			if strings.Contains(text, kw) {
+				return h, true
+			}
+		}
+	}
+	return issueFieldHint{}, false
+}
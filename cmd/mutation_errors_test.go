@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func TestDescribeIssueMutationError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want []string
+	}{
+		{
+			name: "field named in invalidArgs",
+			err: api.GraphQLErrors{{
+				Message: "Argument Validation Error",
+				Extensions: &api.GraphQLErrorExtensions{
+					UserPresentableMessage: "One of the given labels does not exist.",
+					InvalidArgs:            []string{"input.labelIds"},
+				},
+			}},
+			want: []string{"Failed to update issue: invalid label", "(labelIds: One of the given labels does not exist.)"},
+		},
+		{
+			name: "field found in the message",
+			err:  api.GraphQLErrors{{Message: "assigneeId must be a member of the team"}},
+			want: []string{"invalid assignee", "linctl user list", "(assigneeId: assigneeId must be a member of the team)"},
+		},
+		{
+			name: "workflow state",
+			err: api.GraphQLErrors{{
+				Message:    "Entity not found",
+				Extensions: &api.GraphQLErrorExtensions{UserPresentableMessage: "Could not find referenced workflow state."},
+			}},
+			want: []string{"state not allowed", "linctl team states"},
+		},
+		{
+			name: "unrecognised GraphQL error keeps Linear's message",
+			err: api.GraphQLErrors{{
+				Message:    "Forbidden",
+				Extensions: &api.GraphQLErrorExtensions{UserPresentableMessage: "You don't have access to this team."},
+			}},
+			want: []string{"Failed to update issue: You don't have access to this team."},
+		},
+		{
+			name: "wrapped errors are still recognised",
+			err:  fmt.Errorf("wrapped: %w", api.GraphQLErrors{{Message: "Invalid dueDate"}}),
+			want: []string{"invalid due date; use YYYY-MM-DD"},
+		},
+		{
+			name: "non-GraphQL errors are passed through",
+			err:  errors.New("request failed: connection refused"),
+			want: []string{"Failed to update issue: request failed: connection refused"},
+		},
+	}
+	for _, tc := range cases {
+		got := describeIssueMutationError("update issue", tc.err)
+		for _, want := range tc.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("%s: %q does not contain %q", tc.name, got, want)
+			}
+		}
+	}
+}
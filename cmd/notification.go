@@ -0,0 +1,229 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/auth"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// notificationCmd represents the notification command
+var notificationCmd = &cobra.Command{
+	Use:     "notification",
+	Aliases: []string{"notifications", "inbox"},
+	Short:   "Manage your Linear inbox",
+	Long: `List and mark notifications from your Linear inbox.
+
+Examples:
+  linctl notification list            # List notifications, unread first
+  linctl notification list --unread   # Only unread notifications
+  linctl notification read NOTIF-ID   # Mark one notification as read
+  linctl notification read-all        # Mark all notifications as read`,
+}
+
+var notificationListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List notifications",
+	Long:    `List notifications from your inbox. Unread notifications are shown first.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := api.NewClient(authHeader)
+
+		limit, _ := cmd.Flags().GetInt("limit")
+		unreadOnly, _ := cmd.Flags().GetBool("unread")
+
+		notifications, err := fetchNotifications(context.Background(), client, limit, unreadOnly)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list notifications: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		sortNotificationsUnreadFirst(notifications)
+
+		if jsonOut {
+			output.JSON(notifications)
+			return
+		}
+
+		if len(notifications) == 0 {
+			output.Info("No notifications found", plaintext, jsonOut)
+			return
+		}
+
+		if plaintext {
+			fmt.Println("ID\tType\tIssue\tActor\tCreated\tRead")
+			for _, n := range notifications {
+				fmt.Printf("%s\t%s\t%s\t%s\t%s\t%v\n",
+					n.ID,
+					n.Type,
+					notificationIssue(n),
+					notificationActor(n),
+					n.CreatedAt.Format("2006-01-02 15:04:05"),
+					n.ReadAt != nil,
+				)
+			}
+			return
+		}
+
+		headers := []string{"ID", "Type", "Issue", "Actor", "Created"}
+		rows := [][]string{}
+		unread := 0
+		for _, n := range notifications {
+			typ := n.Type
+			if n.ReadAt == nil {
+				unread++
+				typ = color.New(color.FgYellow, color.Bold).Sprint("● " + typ)
+			} else {
+				typ = color.New(color.FgWhite, color.Faint).Sprint(typ)
+			}
+			rows = append(rows, []string{
+				color.New(color.FgWhite, color.Faint).Sprint(n.ID),
+				typ,
+				truncateString(notificationIssue(n), 50),
+				notificationActor(n),
+				formatTimeAgo(n.CreatedAt),
+			})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		output.Summary("%d notifications (%d unread)", len(notifications), unread)
+	},
+}
+
+var notificationReadCmd = &cobra.Command{
+	Use:   "read NOTIFICATION-ID",
+	Short: "Mark a notification as read",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := api.NewClient(authHeader)
+
+		if err := client.MarkNotificationRead(context.Background(), args[0]); err != nil {
+			output.Error(fmt.Sprintf("Failed to mark notification read: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		output.Success(fmt.Sprintf("Marked notification %s as read", args[0]), plaintext, jsonOut)
+	},
+}
+
+var notificationReadAllCmd = &cobra.Command{
+	Use:   "read-all",
+	Short: "Mark all notifications as read",
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := api.NewClient(authHeader)
+
+		unread, err := fetchNotifications(context.Background(), client, 0, true)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list notifications: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		for _, n := range unread {
+			if err := client.MarkNotificationRead(context.Background(), n.ID); err != nil {
+				output.Error(fmt.Sprintf("Failed to mark notification %s read: %v", n.ID, err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
+		output.Success(fmt.Sprintf("Marked %d notifications as read", len(unread)), plaintext, jsonOut)
+	},
+}
+
+// fetchNotifications pages through notifications until limit matching entries
+// are collected. A limit of 0 fetches everything.
+func fetchNotifications(ctx context.Context, client *api.Client, limit int, unreadOnly bool) ([]api.Notification, error) {
+	var result []api.Notification
+	after := ""
+	for {
+		page, err := client.GetNotifications(ctx, 100, after)
+		if err != nil {
+			return nil, err
+		}
+		for _, n := range page.Nodes {
+			if unreadOnly && n.ReadAt != nil {
+				continue
+			}
+			result = append(result, n)
+			if limit > 0 && len(result) >= limit {
+				return result, nil
+			}
+		}
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return result, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
+
+// sortNotificationsUnreadFirst orders unread notifications before read ones,
+// newest first within each group.
+func sortNotificationsUnreadFirst(notifications []api.Notification) {
+	sort.SliceStable(notifications, func(i, j int) bool {
+		ui, uj := notifications[i].ReadAt == nil, notifications[j].ReadAt == nil
+		if ui != uj {
+			return ui
+		}
+		return notifications[i].CreatedAt.After(notifications[j].CreatedAt)
+	})
+}
+
+func notificationIssue(n api.Notification) string {
+	if n.Issue == nil {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", n.Issue.Identifier, n.Issue.Title)
+}
+
+func notificationActor(n api.Notification) string {
+	if n.Actor == nil {
+		return ""
+	}
+	return n.Actor.Name
+}
+
+func init() {
+	rootCmd.AddCommand(notificationCmd)
+	notificationCmd.AddCommand(notificationListCmd)
+	notificationCmd.AddCommand(notificationReadCmd)
+	notificationCmd.AddCommand(notificationReadAllCmd)
+
+	notificationListCmd.Flags().IntP("limit", "l", 50, "Maximum number of notifications to return")
+	notificationListCmd.Flags().Bool("unread", false, "Only show unread notifications")
+}
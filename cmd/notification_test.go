@@ -0,0 +1,28 @@
+package cmd
+
+import (
+	"testing"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func TestSortNotificationsUnreadFirst(t *testing.T) {
+	base := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	read := base
+	notifications := []api.Notification{
+		{ID: "read-new", CreatedAt: base.Add(3 * time.Hour), ReadAt: &read},
+		{ID: "unread-old", CreatedAt: base.Add(1 * time.Hour)},
+		{ID: "read-old", CreatedAt: base, ReadAt: &read},
+		{ID: "unread-new", CreatedAt: base.Add(2 * time.Hour)},
+	}
+
+	sortNotificationsUnreadFirst(notifications)
+
+	want := []string{"unread-new", "unread-old", "read-new", "read-old"}
+	for i, id := range want {
+		if notifications[i].ID != id {
+			t.Fatalf("position %d = %s, want %s", i, notifications[i].ID, id)
+		}
+	}
+}
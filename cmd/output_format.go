@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+// Output formats accepted by --output / LINCTL_OUTPUT.
+const (
+	formatTable     = "table"
+	formatJSON      = "json"
+	formatPlaintext = "plaintext"
+	formatCSV       = "csv"
+	formatMarkdown  = "markdown"
+)
+
+var outputFormats = []string{formatTable, formatJSON, formatPlaintext, formatCSV, formatMarkdown}
+
+// resolveOutputFormat picks the output format. An explicit --json,
+// --markdown or --plaintext alias wins, then --output, LINCTL_OUTPUT and the
+// config file's "output" key, all of which viper resolves for the "output"
+// key. The default is table. Asking for two different formats on the
+// command line is an error rather than a silent precedence rule.
+func resolveOutputFormat(flags *pflag.FlagSet) (string, error) {
+	var aliases []string
+	for _, alias := range []string{formatJSON, formatMarkdown, formatPlaintext} {
+		if f := flags.Lookup(alias); f != nil && f.Changed && f.Value.String() == "true" {
+			aliases = append(aliases, alias)
+		}
+	}
+	explicit := ""
+	if f := flags.Lookup("output"); f != nil && f.Changed {
+		explicit = strings.ToLower(strings.TrimSpace(f.Value.String()))
+	}
+
+	switch {
+	case len(aliases) > 1:
+		return "", fmt.Errorf("conflicting output flags --%s (choose one, or use --output)", strings.Join(aliases, " and --"))
+	case len(aliases) == 1 && explicit != "" && explicit != aliases[0]:
+		return "", fmt.Errorf("conflicting output flags --%s and --output %s (choose one)", aliases[0], explicit)
+	case len(aliases) == 1:
+		return aliases[0], nil
+	}
+
+	format := strings.ToLower(strings.TrimSpace(viper.GetString("output")))
+	if format == "" {
+		// Older configs may still set the boolean keys directly.
+		for _, alias := range []string{formatJSON, formatMarkdown, formatPlaintext} {
+			if viper.GetBool(alias) {
+				return alias, nil
+			}
+		}
+		return formatTable, nil
+	}
+	for _, valid := range outputFormats {
+		if format == valid {
+			return format, nil
+		}
+	}
+	return "", fmt.Errorf("invalid output format '%s' (valid: %s)", format, strings.Join(outputFormats, ", "))
+}
+
+// applyOutputFormat stores the resolved format in viper, which every command
+// reads, and configures the output package. Markdown and CSV also set
+// "plaintext" so commands skip decorative output around their tables.
+func applyOutputFormat(format string) {
+	viper.Set("output", format)
+	viper.Set("json", format == formatJSON)
+	viper.Set("plaintext", format == formatPlaintext || format == formatMarkdown || format == formatCSV)
+	viper.Set("markdown", format == formatMarkdown)
+	output.SetMarkdown(format == formatMarkdown)
+	output.SetCSV(format == formatCSV)
+}
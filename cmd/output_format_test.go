@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/spf13/pflag"
+	"github.com/spf13/viper"
+)
+
+func TestResolveOutputFormat(t *testing.T) {
+	cases := []struct {
+		name    string
+		args    []string
+		env     string
+		want    string
+		wantErr bool
+	}{
+		{name: "default", want: formatTable},
+		{name: "flag", args: []string{"-F", "csv"}, want: formatCSV},
+		{name: "env", env: "plaintext", want: formatPlaintext},
+		{name: "flag beats env", args: []string{"--output", "markdown"}, env: "json", want: formatMarkdown},
+		{name: "plaintext alias beats env", args: []string{"-p"}, env: "csv", want: formatPlaintext},
+		{name: "alias agreeing with flag", args: []string{"--output", "json", "-j"}, want: formatJSON},
+		{name: "plaintext and json conflict", args: []string{"--plaintext", "--json"}, wantErr: true},
+		{name: "json and markdown conflict", args: []string{"-j", "--markdown"}, wantErr: true},
+		{name: "alias conflicts with flag", args: []string{"--output", "csv", "--json"}, wantErr: true},
+		{name: "case insensitive", env: "JSON", want: formatJSON},
+		{name: "invalid", args: []string{"-F", "xml"}, wantErr: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("LINCTL_OUTPUT", tc.env)
+			viper.Reset()
+			t.Cleanup(viper.Reset)
+
+			flags := pflag.NewFlagSet("test", pflag.ContinueOnError)
+			flags.StringP("output", "F", "", "")
+			flags.BoolP("json", "j", false, "")
+			flags.BoolP("plaintext", "p", false, "")
+			flags.Bool("markdown", false, "")
+			if err := flags.Parse(tc.args); err != nil {
+				t.Fatal(err)
+			}
+			_ = viper.BindPFlag("output", flags.Lookup("output"))
+			_ = viper.BindEnv("output", "LINCTL_OUTPUT")
+
+			got, err := resolveOutputFormat(flags)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected an error, got %q", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tc.want {
+				t.Fatalf("resolveOutputFormat = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func captureStderr(t *testing.T, fn func()) string {
+	t.Helper()
+	old := os.Stderr
+	r, w, _ := os.Pipe()
+	os.Stderr = w
+	defer func() { os.Stderr = old }()
+	fn()
+	_ = w.Close()
+	var buf bytes.Buffer
+	_, _ = buf.ReadFrom(r)
+	return buf.String()
+}
+
+func withTruncatedIssueList(t *testing.T) {
+	t.Helper()
+	withIssueMockServer(t, func(query string, _ map[string]any) any {
+		return map[string]any{"issues": map[string]any{
+			"nodes": []any{
+				map[string]any{"id": "1", "identifier": "ENG-1", "title": "First", "createdAt": "2025-01-01T00:00:00Z"},
+			},
+			"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+		}}
+	})
+	resetFlags(t, issueListCmd)
+	_ = issueListCmd.Flags().Set("limit", "1")
+}
+
+func TestIssueList_JSONStdoutIsCleanJSON(t *testing.T) {
+	withTruncatedIssueList(t)
+	viper.Set("json", true)
+
+	var stdout string
+	stderr := captureStderr(t, func() {
+		stdout = captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+	})
+
+	var got []map[string]any
+	if err := json.Unmarshal([]byte(stdout), &got); err != nil {
+		t.Fatalf("stdout is not pure JSON %q: %v", stdout, err)
+	}
+	if len(got) != 1 || got[0]["identifier"] != "ENG-1" {
+		t.Fatalf("unexpected issues: %v", got)
+	}
+	if strings.Contains(stderr, "✓") || strings.Contains(stderr, "--limit") {
+		t.Fatalf("--json should print no summary at all, stderr: %q", stderr)
+	}
+}
+
+func TestIssueList_SummaryAndHintGoToStderr(t *testing.T) {
+	withTruncatedIssueList(t)
+
+	var stdout string
+	stderr := captureStderr(t, func() {
+		stdout = captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+	})
+
+	if !strings.Contains(stdout, "First") {
+		t.Fatalf("expected the table on stdout, got %q", stdout)
+	}
+	if strings.Contains(stdout, "1 issues") || strings.Contains(stdout, "--limit") {
+		t.Fatalf("summary leaked to stdout: %q", stdout)
+	}
+	if !strings.Contains(stderr, "1 issues") || !strings.Contains(stderr, "Use --limit") {
+		t.Fatalf("expected summary and pagination hint on stderr, got %q", stderr)
+	}
+}
+
+func TestIssueList_LabelPrecedenceWarningGoesToStderr(t *testing.T) {
+	withIssueMockServer(t, func(query string, _ map[string]any) any {
+		if strings.Contains(query, "issueLabels") {
+			return map[string]any{"issueLabels": map[string]any{"nodes": []any{
+				map[string]any{"id": "L_bug", "name": "Bug"},
+				map[string]any{"id": "L_api", "name": "API"},
+			}}}
+		}
+		return map[string]any{"issues": map[string]any{"nodes": []any{
+			map[string]any{"id": "1", "identifier": "ENG-1", "title": "First", "createdAt": "2025-01-01T00:00:00Z",
+				"labels": map[string]any{"nodes": []any{map[string]any{"id": "L_bug", "name": "Bug"}}}},
+		}}}
+	})
+	t.Cleanup(func() { applyOutputFormat(formatTable) })
+	for _, format := range []string{formatCSV, formatPlaintext} {
+		resetFlags(t, issueListCmd)
+		applyOutputFormat(format)
+		_ = issueListCmd.Flags().Set("label", "Bug")
+		_ = issueListCmd.Flags().Set("label-any", "API")
+
+		var stdout string
+		stderr := captureStderr(t, func() {
+			stdout = captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+		})
+
+		if strings.Contains(stdout, "Warning") || !strings.Contains(stdout, "First") {
+			t.Fatalf("%s: stdout must hold only the issues, got %q", format, stdout)
+		}
+		if !strings.Contains(stderr, "Warning: --label specified") {
+			t.Fatalf("%s: expected the precedence warning on stderr, got %q", format, stderr)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package cmd
+
+import (
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+)
+
+// fetchAllPageSize is the page size used when fetching every page.
+const fetchAllPageSize = 100
+
+// maxPageSize is the largest page Linear serves; larger `first` values are
+// capped, so bigger limits have to be fetched over several pages.
+const maxPageSize = 250
+
+// fetchAllCap is the safety cap on how many items an unbounded list
+// (--limit 0) will fetch, so a huge workspace can't keep the CLI paging
+// forever.
+var fetchAllCap = 5000
+
+// isUnboundedLimit reports whether a --limit value means "fetch all pages".
+func isUnboundedLimit(limit int) bool {
+	return limit <= 0
+}
+
+// fetchAllPages calls fetch with successive cursors until the last page has
+// been read or fetchAllCap items have been collected. hasMore reports whether
+// results were left behind because the cap was reached. Each page is shown
+// as a step of progress, which may be nil.
+func fetchAllPages[T any](progress *output.Progress, fetch func(first int, after string) ([]T, api.PageInfo, error)) (items []T, hasMore bool, err error) {
+	after := ""
+	for page := 1; ; page++ {
+		progress.Step("Fetching page %d…", page)
+		first := fetchAllPageSize
+		if remaining := fetchAllCap - len(items); remaining < first {
+			first = remaining
+		}
+		nodes, pageInfo, err := fetch(first, after)
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, nodes...)
+		if !pageInfo.HasNextPage || pageInfo.EndCursor == "" {
+			return items, false, nil
+		}
+		if len(items) >= fetchAllCap {
+			return items[:fetchAllCap], true, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+// fetchPages collects up to limit items, requesting at most maxPageSize per
+// page and following cursors until limit is met or the results run out. It
+// keeps going when the server returns short pages, so a limit above the
+// server's page size is honored rather than silently truncated. A limit <= 0
+// fetches every page (see fetchAllPages). hasMore reports whether items were
+// left behind.
+func fetchPages[T any](progress *output.Progress, limit int, fetch func(first int, after string) ([]T, api.PageInfo, error)) (items []T, hasMore bool, err error) {
+	if isUnboundedLimit(limit) {
+		return fetchAllPages(progress, fetch)
+	}
+	after := ""
+	for page := 1; ; page++ {
+		progress.Step("Fetching page %d…", page)
+		first := limit - len(items)
+		if first > maxPageSize {
+			first = maxPageSize
+		}
+		nodes, pageInfo, err := fetch(first, after)
+		if err != nil {
+			return nil, false, err
+		}
+		items = append(items, nodes...)
+		more := pageInfo.HasNextPage && pageInfo.EndCursor != ""
+		if len(items) >= limit {
+			return items[:limit], more || len(items) > limit, nil
+		}
+		if !more {
+			return items, false, nil
+		}
+		after = pageInfo.EndCursor
+	}
+}
+
+// fetchMatchingIssues pages through issues until limit of them pass keep, the
+// results are exhausted, or fetchAllCap issues have been scanned. This makes
+// --limit count matching issues rather than rows scanned when client-side
+// post-filters are in play. A limit <= 0 collects every match.
+func fetchMatchingIssues(progress *output.Progress, limit int, fetch func(first int, after string) (*api.Issues, error), keep func(*api.Issues) *api.Issues) (*api.Issues, error) {
+	pageSize := limit
+	if isUnboundedLimit(limit) {
+		pageSize = fetchAllPageSize
+	} else if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	result := &api.Issues{Nodes: []api.Issue{}}
+	scanned := 0
+	after := ""
+	for n := 1; ; n++ {
+		progress.Step("Fetching page %d…", n)
+		first := pageSize
+		if remaining := fetchAllCap - scanned; remaining < first {
+			first = remaining
+		}
+		page, err := fetch(first, after)
+		if err != nil {
+			return nil, err
+		}
+		scanned += len(page.Nodes)
+		more := page.PageInfo.HasNextPage && page.PageInfo.EndCursor != ""
+
+		matched := keep(&api.Issues{Nodes: page.Nodes, PageInfo: page.PageInfo}).Nodes
+		for _, issue := range matched {
+			if !isUnboundedLimit(limit) && len(result.Nodes) >= limit {
+				result.PageInfo.HasNextPage = true
+				return result, nil
+			}
+			result.Nodes = append(result.Nodes, issue)
+		}
+
+		if !more {
+			return result, nil
+		}
+		if (!isUnboundedLimit(limit) && len(result.Nodes) >= limit) || scanned >= fetchAllCap {
+			result.PageInfo.HasNextPage = true
+			return result, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
+}
@@ -0,0 +1,242 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// pagedFetcher serves total integers in pages, recording the page sizes asked for.
+func pagedFetcher(total int, sizes *[]int) func(first int, after string) ([]int, api.PageInfo, error) {
+	return func(first int, after string) ([]int, api.PageInfo, error) {
+		*sizes = append(*sizes, first)
+		start := 0
+		if after != "" {
+			fmt.Sscanf(after, "cursor-%d", &start)
+		}
+		end := start + first
+		if end > total {
+			end = total
+		}
+		var nodes []int
+		for i := start; i < end; i++ {
+			nodes = append(nodes, i)
+		}
+		info := api.PageInfo{HasNextPage: end < total}
+		if info.HasNextPage {
+			info.EndCursor = fmt.Sprintf("cursor-%d", end)
+		}
+		return nodes, info, nil
+	}
+}
+
+func TestFetchAllPages(t *testing.T) {
+	var sizes []int
+	items, hasMore, err := fetchAllPages(nil, pagedFetcher(250, &sizes))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 250 || hasMore {
+		t.Fatalf("expected 250 items and no more, got %d (hasMore=%v)", len(items), hasMore)
+	}
+	if len(sizes) != 3 {
+		t.Fatalf("expected 3 page requests, got %v", sizes)
+	}
+}
+
+func TestFetchAllPagesRespectsCap(t *testing.T) {
+	orig := fetchAllCap
+	fetchAllCap = 150
+	t.Cleanup(func() { fetchAllCap = orig })
+
+	var sizes []int
+	items, hasMore, err := fetchAllPages(nil, pagedFetcher(1000, &sizes))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 150 || !hasMore {
+		t.Fatalf("expected 150 items with more remaining, got %d (hasMore=%v)", len(items), hasMore)
+	}
+	if len(sizes) != 2 || sizes[1] != 50 {
+		t.Fatalf("expected the last page to be trimmed to the cap, got %v", sizes)
+	}
+}
+
+func TestIsUnboundedLimit(t *testing.T) {
+	for limit, want := range map[int]bool{-1: true, 0: true, 1: false, 50: false} {
+		if got := isUnboundedLimit(limit); got != want {
+			t.Errorf("isUnboundedLimit(%d) = %v, want %v", limit, got, want)
+		}
+	}
+}
+
+// issuePages serves total issues, where every third issue (ENG-0, ENG-3, …)
+// has a parent, in pages of whatever size is requested.
+func issuePages(total int, calls *int) func(first int, after string) (*api.Issues, error) {
+	return func(first int, after string) (*api.Issues, error) {
+		*calls++
+		start := 0
+		if after != "" {
+			fmt.Sscanf(after, "cursor-%d", &start)
+		}
+		page := &api.Issues{}
+		for i := start; i < start+first && i < total; i++ {
+			issue := api.Issue{ID: fmt.Sprintf("id-%d", i), Identifier: fmt.Sprintf("ENG-%d", i)}
+			if i%3 == 0 {
+				issue.Parent = &api.Issue{ID: "parent-1", Identifier: "ENG-999"}
+			}
+			page.Nodes = append(page.Nodes, issue)
+		}
+		if end := start + first; end < total {
+			page.PageInfo = api.PageInfo{HasNextPage: true, EndCursor: fmt.Sprintf("cursor-%d", end)}
+		}
+		return page, nil
+	}
+}
+
+func onlySubIssues(page *api.Issues) *api.Issues {
+	return filterIssuesByParent(page, "", true, false)
+}
+
+func TestFetchMatchingIssues_SpansPagesUntilLimit(t *testing.T) {
+	calls := 0
+	issues, err := fetchMatchingIssues(nil, 5, issuePages(100, &calls), onlySubIssues)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues.Nodes) != 5 {
+		t.Fatalf("expected 5 matching issues, got %d", len(issues.Nodes))
+	}
+	for i, want := range []string{"ENG-0", "ENG-3", "ENG-6", "ENG-9", "ENG-12"} {
+		if issues.Nodes[i].Identifier != want {
+			t.Fatalf("issue %d = %s, want %s", i, issues.Nodes[i].Identifier, want)
+		}
+	}
+	if calls != 3 {
+		t.Fatalf("expected 3 page requests (5 rows each), got %d", calls)
+	}
+	if !issues.PageInfo.HasNextPage {
+		t.Fatalf("expected HasNextPage when more matches remain")
+	}
+}
+
+func TestFetchMatchingIssues_StopsWhenExhausted(t *testing.T) {
+	calls := 0
+	issues, err := fetchMatchingIssues(nil, 50, issuePages(10, &calls), onlySubIssues)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues.Nodes) != 4 || issues.PageInfo.HasNextPage {
+		t.Fatalf("expected all 4 matches and no more pages, got %d (hasNext=%v)", len(issues.Nodes), issues.PageInfo.HasNextPage)
+	}
+	if calls != 1 {
+		t.Fatalf("expected a single request, got %d", calls)
+	}
+}
+
+func TestFetchMatchingIssues_UnboundedRespectsCap(t *testing.T) {
+	orig := fetchAllCap
+	fetchAllCap = 150
+	t.Cleanup(func() { fetchAllCap = orig })
+
+	calls := 0
+	issues, err := fetchMatchingIssues(nil, 0, issuePages(1000, &calls), onlySubIssues)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues.Nodes) != 50 || !issues.PageInfo.HasNextPage {
+		t.Fatalf("expected 50 matches from 150 scanned with more remaining, got %d (hasNext=%v)", len(issues.Nodes), issues.PageInfo.HasNextPage)
+	}
+}
+
+func TestFetchPages_SplitsLimitsAboveMaxPageSize(t *testing.T) {
+	var sizes []int
+	items, hasMore, err := fetchPages(nil, 600, pagedFetcher(1000, &sizes))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 600 || !hasMore {
+		t.Fatalf("expected 600 items with more remaining, got %d (hasMore=%v)", len(items), hasMore)
+	}
+	if fmt.Sprint(sizes) != "[250 250 100]" {
+		t.Fatalf("expected pages of at most %d, got %v", maxPageSize, sizes)
+	}
+}
+
+func TestFetchPages_KeepsGoingOnShortPages(t *testing.T) {
+	var sizes []int
+	serve := pagedFetcher(1000, &sizes)
+	// A server that caps every page at 100 regardless of what was asked for
+	capped := func(first int, after string) ([]int, api.PageInfo, error) {
+		if first > 100 {
+			first = 100
+		}
+		return serve(first, after)
+	}
+	items, _, err := fetchPages(nil, 300, capped)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 300 || items[299] != 299 {
+		t.Fatalf("expected 300 consecutive items, got %d", len(items))
+	}
+}
+
+func TestFetchPages_StopsWhenExhausted(t *testing.T) {
+	var sizes []int
+	items, hasMore, err := fetchPages(nil, 600, pagedFetcher(40, &sizes))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(items) != 40 || hasMore || len(sizes) != 1 {
+		t.Fatalf("expected all 40 items from one request, got %d (hasMore=%v, requests=%v)", len(items), hasMore, sizes)
+	}
+}
+
+func TestIssueList_LimitAboveServerPageSize(t *testing.T) {
+	var firsts []any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		firsts = append(firsts, vars["first"])
+		first := int(vars["first"].(float64))
+		if first > maxPageSize {
+			first = maxPageSize // what the server would do
+		}
+		start := 0
+		if after, _ := vars["after"].(string); after != "" {
+			fmt.Sscanf(after, "cursor-%d", &start)
+		}
+		nodes := []any{}
+		for i := start; i < start+first; i++ {
+			nodes = append(nodes, map[string]any{"id": fmt.Sprintf("id-%d", i), "identifier": fmt.Sprintf("ENG-%d", i)})
+		}
+		return map[string]any{"issues": map[string]any{
+			"nodes":    nodes,
+			"pageInfo": map[string]any{"hasNextPage": true, "endCursor": fmt.Sprintf("cursor-%d", start+first)},
+		}}
+	})
+	resetFlags(t, issueListCmd)
+	viper.Set("json", true)
+	_ = issueListCmd.Flags().Set("limit", "600")
+
+	out := captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+
+	var got []map[string]any
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if len(got) != 600 {
+		t.Fatalf("expected --limit 600 to return 600 issues, got %d", len(got))
+	}
+	for _, first := range firsts {
+		if first.(float64) > maxPageSize {
+			t.Fatalf("requested a page larger than %d: %v", maxPageSize, firsts)
+		}
+	}
+	if !strings.HasPrefix(fmt.Sprint(got[599]["identifier"]), "ENG-599") {
+		t.Fatalf("expected consecutive pages, last issue %v", got[599]["identifier"])
+	}
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// The --plaintext Markdown is a stable contract for scripts and LLM
+// pipelines. These tests pin it against golden files; a diff here is a
+// breaking change for consumers, so regenerate deliberately with
+//
+//	go test ./cmd -run TestPlaintextGolden -update
+var updateGolden = flag.Bool("update", false, "rewrite plaintext golden files")
+
+func loadPlaintextFixture(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "plaintext", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func fixtureResponse(t *testing.T, name string) map[string]any {
+	t.Helper()
+	var data map[string]any
+	if err := json.Unmarshal(loadPlaintextFixture(t, name), &data); err != nil {
+		t.Fatalf("parse %s: %v", name, err)
+	}
+	return data
+}
+
+func assertGolden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", "plaintext", name+".golden")
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run with -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Fatalf("plaintext output for %s changed; this format is a stable contract.\n--- got ---\n%s\n--- want ---\n%s", name, got, want)
+	}
+}
+
+func TestPlaintextGolden_IssueGet(t *testing.T) {
+	issue := fixtureResponse(t, "issue_get.json")
+	withIssueMockServer(t, func(query string, _ map[string]any) any {
+		if strings.Contains(query, "query Issue(") {
+			return issue
+		}
+		return map[string]any{}
+	})
+	viper.Set("plaintext", true)
+	resetFlags(t, issueGetCmd)
+
+	out := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-42"}) })
+	assertGolden(t, "issue_get", out)
+}
+
+func TestPlaintextGolden_IssueList(t *testing.T) {
+	issues := fixtureResponse(t, "issue_list.json")
+	withIssueMockServer(t, func(query string, _ map[string]any) any {
+		if strings.Contains(query, "issues(") {
+			return issues
+		}
+		return map[string]any{}
+	})
+	viper.Set("plaintext", true)
+	resetFlags(t, issueListCmd)
+
+	var out string
+	captureStderr(t, func() {
+		out = captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) })
+	})
+	assertGolden(t, "issue_list", out)
+}
+
+func TestPlaintextGolden_ProjectGet(t *testing.T) {
+	var project api.Project
+	if err := json.Unmarshal(loadPlaintextFixture(t, "project_get.json"), &project); err != nil {
+		t.Fatal(err)
+	}
+	viper.Set("plaintext", true)
+	t.Cleanup(func() { viper.Set("plaintext", false) })
+	resetFlags(t, projectGetCmd)
+
+	var out string
+	withInjectedProjectClient(t, &mockProjectClient{project: &project}, func() {
+		out = captureStdout(t, func() { projectGetCmd.Run(projectGetCmd, []string{"p-1"}) })
+	})
+	assertGolden(t, "project_get", out)
+}
+
+func TestPlaintext_AdversarialIssueText(t *testing.T) {
+	withIssueMockServer(t, func(query string, _ map[string]any) any {
+		if strings.Contains(query, "query Issue(") {
+			return map[string]any{"issue": map[string]any{
+				"id": "i-1", "identifier": "ENG-1", "title": "# Pwned | *bold* `tick`\n## Core Details",
+				"description": "Steps:\n# Comments\n```\n# kept in code\n```",
+				"createdAt":   "2025-03-01T10:00:00Z", "updatedAt": "2025-03-01T10:00:00Z",
+				"children": map[string]any{"nodes": []any{
+					map[string]any{"id": "i-2", "identifier": "ENG-2", "title": "- [x] fake checkbox"},
+				}},
+			}}
+		}
+		return map[string]any{}
+	})
+	viper.Set("plaintext", true)
+	resetFlags(t, issueGetCmd)
+
+	out := captureStdout(t, func() { issueGetCmd.Run(issueGetCmd, []string{"ENG-1"}) })
+	for _, want := range []string{
+		"# ENG-1 - \\# Pwned \\| \\*bold\\* \\`tick\\` ## Core Details\n",
+		"## Description\nSteps:\n### Comments\n```\n# kept in code\n```\n",
+		"- [ ] ENG-2: \\- \\[x\\] fake checkbox (Unassigned)\n",
+	} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+	// The only section headings are linctl's own
+	headings := []string{"## Description", "## Core Details", "## Status & Dates", "## Technical Details", "## Sub-issues"}
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "## ") && !slices.Contains(headings, line) {
+			t.Fatalf("user text produced a section heading %q:\n%s", line, out)
+		}
+	}
+}
@@ -1,10 +1,14 @@
 package cmd
 
 import (
+	"cmp"
 	"context"
 	"fmt"
+	"net/url"
 	"os"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 "github.com/raegislabs/linctl/pkg/api"
@@ -28,28 +32,142 @@ type projectAPI interface {
 	CreateProjectUpdate(ctx context.Context, input map[string]interface{}) (*api.ProjectUpdate, error)
 	ListProjectUpdates(ctx context.Context, projectID string) (*api.ProjectUpdates, error)
 	GetProjectUpdate(ctx context.Context, updateID string) (*api.ProjectUpdate, error)
+	GetProjectTemplates(ctx context.Context) ([]api.Template, error)
+	GetViewer(ctx context.Context) (*api.User, error)
+	GetProjectLabels(ctx context.Context) (*api.Labels, error)
 }
 
 // Injection points for testing
 var newAPIClient = func(authHeader string) projectAPI { return api.NewClient(authHeader) }
 var getAuthHeader = auth.GetAuthHeader
 
+// projectWorkspace returns the workspace slug to build project URLs with when
+// one of urls doesn't name it, asking the API for the viewer's organization.
+// It makes no request when every URL already names its workspace, and returns
+// "" when the workspace can't be determined.
+func projectWorkspace(ctx context.Context, client projectAPI, urls ...string) string {
+	for _, u := range urls {
+		if workspaceFromURL(u) != "" {
+			continue
+		}
+		viewer, err := client.GetViewer(ctx)
+		if err != nil || viewer == nil || viewer.Organization == nil {
+			return ""
+		}
+		return viewer.Organization.URLKey
+	}
+	return ""
+}
+
+// projectStateColor returns the display color for a project state, reusing
+// the theme's workflow state colors where the meanings line up.
+func projectStateColor(state string) *color.Color {
+	switch state {
+	case "planned":
+		return output.StateColor("backlog")
+	case "started":
+		return output.StateColor("started")
+	case "paused":
+		return output.Color(output.RoleWarning)
+	case "completed":
+		return output.StateColor("completed")
+	case "canceled":
+		return output.StateColor("canceled")
+	}
+	return output.Color(output.RoleSuccess)
+}
+
 // constructProjectURL constructs an ID-based project URL
-func constructProjectURL(projectID string, originalURL string) string {
-	// Extract workspace from the original URL
-	// Format: https://linear.app/{workspace}/project/{slug}
-	if originalURL == "" {
+// (https://linear.app/{workspace}/project/{id}). The workspace is taken from
+// originalURL when it can be parsed, otherwise workspace is used (see
+// projectWorkspace). If neither is available the original URL is returned
+// unchanged.
+func constructProjectURL(projectID, originalURL, workspace string) string {
+	if projectID == "" {
+		return originalURL
+	}
+
+	if fromURL := workspaceFromURL(originalURL); fromURL != "" {
+		workspace = fromURL
+	}
+	if workspace == "" {
+		return originalURL
+	}
+
+	return fmt.Sprintf("https://linear.app/%s/project/%s", url.PathEscape(workspace), url.PathEscape(projectID))
+}
+
+// workspaceFromURL extracts the workspace slug from a Linear URL such as
+// https://linear.app/{workspace}/project/{slug}. Trailing slashes, query
+// strings and fragments are ignored; a missing scheme is tolerated.
+func workspaceFromURL(raw string) string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
 		return ""
 	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
 
-	parts := strings.Split(originalURL, "/")
-	if len(parts) >= 5 {
-		workspace := parts[3]
-		return fmt.Sprintf("https://linear.app/%s/project/%s", workspace, projectID)
+	u, err := url.Parse(raw)
+	if err != nil || u.Host == "" {
+		return ""
 	}
 
-	// Fallback to original URL if we can't parse it
-	return originalURL
+	segments := strings.FieldsFunc(u.Path, func(r rune) bool { return r == '/' })
+	if len(segments) == 0 || segments[0] == "project" {
+		return ""
+	}
+	return segments[0]
+}
+
+// filterTemplatesByTeam keeps workspace-wide templates and those belonging to
+// the given team key. An empty key keeps everything.
+func filterTemplatesByTeam(templates []api.Template, teamKey string) []api.Template {
+	if teamKey == "" {
+		return templates
+	}
+	filtered := []api.Template{}
+	for _, t := range templates {
+		if t.Team == nil || strings.EqualFold(t.Team.Key, teamKey) {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+// findProjectTemplate resolves a template by ID or case-insensitive name,
+// suggesting close matches when nothing matches.
+func findProjectTemplate(templates []api.Template, nameOrID string) (*api.Template, error) {
+	names := make([]string, 0, len(templates))
+	for i := range templates {
+		if templates[i].ID == nameOrID || strings.EqualFold(templates[i].Name, nameOrID) {
+			return &templates[i], nil
+		}
+		names = append(names, templates[i].Name)
+	}
+	msg := fmt.Sprintf("project template not found: '%s'", nameOrID)
+	if suggestions := closestMatches(nameOrID, names, 3); len(suggestions) > 0 {
+		msg += fmt.Sprintf(" (did you mean: %s)", strings.Join(suggestions, ", "))
+	}
+	return nil, fmt.Errorf("%s", msg)
+}
+
+// projectSlackFlags maps the Slack notification flags to their input fields.
+var projectSlackFlags = []struct{ flag, field string }{
+	{"slack-new-issue", "slackNewIssue"},
+	{"slack-comments", "slackIssueComments"},
+	{"slack-statuses", "slackIssueStatuses"},
+}
+
+// applySlackFlags copies explicitly set Slack notification flags into input.
+func applySlackFlags(cmd *cobra.Command, input map[string]interface{}) {
+	for _, f := range projectSlackFlags {
+		if cmd.Flags().Changed(f.flag) {
+			v, _ := cmd.Flags().GetBool(f.flag)
+			input[f.field] = v
+		}
+	}
 }
 
 // validateHexColor validates a hex color code format
@@ -69,6 +187,32 @@ func validateHexColor(color string) error {
 	return nil
 }
 
+// validateProjectLinks trims and dedups --link values and checks each is an
+// absolute URL with a scheme and host. All malformed entries are reported
+// together.
+func validateProjectLinks(links []string) ([]string, error) {
+	seen := make(map[string]bool, len(links))
+	valid := make([]string, 0, len(links))
+	var invalid []string
+	for _, link := range links {
+		link = strings.TrimSpace(link)
+		if link == "" || seen[link] {
+			continue
+		}
+		seen[link] = true
+		u, err := url.Parse(link)
+		if err != nil || u.Scheme == "" || u.Host == "" {
+			invalid = append(invalid, fmt.Sprintf("'%s'", link))
+			continue
+		}
+		valid = append(valid, link)
+	}
+	if len(invalid) > 0 {
+		return nil, fmt.Errorf("invalid --link (expected a full URL such as https://example.com): %s", strings.Join(invalid, ", "))
+	}
+	return valid, nil
+}
+
 // lookupUserIDsByEmails looks up user IDs from comma-separated emails
 func lookupUserIDsByEmails(ctx context.Context, client projectAPI, emails string) ([]string, error) {
 	if emails == "" {
@@ -92,7 +236,7 @@ func lookupUserIDsByEmails(ctx context.Context, client projectAPI, emails string
 
 		user, err := fullClient.GetUser(ctx, email)
 		if err != nil {
-			return nil, fmt.Errorf("user not found with email '%s': %v", email, err)
+			return nil, fmt.Errorf("user not found with email '%s': %w", email, err)
 		}
 		userIDs = append(userIDs, user.ID)
 	}
@@ -100,50 +244,6 @@ func lookupUserIDsByEmails(ctx context.Context, client projectAPI, emails string
 	return userIDs, nil
 }
 
-// lookupLabelIDsByNames looks up project label IDs from comma-separated names
-func lookupLabelIDsByNames(ctx context.Context, client projectAPI, names string) ([]string, error) {
-	if names == "" {
-		return nil, nil
-	}
-
-	nameList := strings.Split(names, ",")
-	labelIDs := make([]string, 0, len(nameList))
-
-	// Type assert to get the full API client
-	fullClient, ok := client.(*api.Client)
-	if !ok {
-		return nil, fmt.Errorf("client type assertion failed")
-	}
-
-	// Get all project labels
-	labels, err := fullClient.GetProjectLabels(ctx)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get project labels: %v", err)
-	}
-
-	// Build a map of label names to IDs for quick lookup
-	labelMap := make(map[string]string)
-	for _, label := range labels.Nodes {
-		labelMap[strings.ToLower(label.Name)] = label.ID
-	}
-
-	// Look up each requested label
-	for _, name := range nameList {
-		name = strings.TrimSpace(name)
-		if name == "" {
-			continue
-		}
-
-		labelID, found := labelMap[strings.ToLower(name)]
-		if !found {
-			return nil, fmt.Errorf("project label not found: '%s'", name)
-		}
-		labelIDs = append(labelIDs, labelID)
-	}
-
-	return labelIDs, nil
-}
-
 // projectCmd represents the project command
 var projectCmd = &cobra.Command{
 	Use:   "project",
@@ -162,7 +262,16 @@ var projectListCmd = &cobra.Command{
 	Use:     "list",
 	Aliases: []string{"ls"},
 	Short:   "List projects",
-	Long:    `List all projects in your Linear workspace.`,
+	Long: `List all projects in your Linear workspace.
+
+--overdue is filtered by the API (target date before today, not completed or
+canceled). --at-risk is checked client-side: the latest project update of each
+fetched project is looked up, so it applies to the projects within --limit.
+
+Examples:
+  linctl project list --overdue
+  linctl project list --at-risk --limit 0
+  linctl project list --overdue --at-risk --team ENG`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -171,12 +280,19 @@ var projectListCmd = &cobra.Command{
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
 		client := newAPIClient(authHeader)
 
+		maxWidth, _ := cmd.Flags().GetString("max-width")
+		widths, err := parseMaxWidths(maxWidth, projectColumnWidths)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
 		// Get filters
 		teamKey, _ := cmd.Flags().GetString("team")
 		state, _ := cmd.Flags().GetString("state")
@@ -190,63 +306,123 @@ var projectListCmd = &cobra.Command{
 			team, err := client.GetTeam(context.Background(), teamKey)
 			if err != nil {
 				output.Error(fmt.Sprintf("Failed to find team '%s': %v", teamKey, err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			filter["team"] = map[string]interface{}{"id": team.ID}
 		}
+		overdue, _ := cmd.Flags().GetBool("overdue")
+		atRisk, _ := cmd.Flags().GetBool("at-risk")
 		if state != "" {
 			filter["state"] = map[string]interface{}{"eq": state}
-		} else if !includeCompleted {
-			// Only filter out completed projects if no specific state is requested
+		} else if !includeCompleted || overdue {
+			// Only filter out completed projects if no specific state is
+			// requested; finished projects are never overdue
 			filter["state"] = map[string]interface{}{
 				"nin": []string{"completed", "canceled"},
 			}
 		}
+		if overdue {
+			filter["targetDate"] = map[string]interface{}{"lt": time.Now().Format("2006-01-02")}
+		}
 
 		// Handle newer-than filter
 		newerThan, _ := cmd.Flags().GetString("newer-than")
 		createdAt, err := utils.ParseTimeExpression(newerThan)
 		if err != nil {
-			output.Error(fmt.Sprintf("Invalid newer-than value: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Invalid --newer-than value: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 		if createdAt != "" {
 			filter["createdAt"] = map[string]interface{}{"gte": createdAt}
 		}
 
-		// Get sort option
+		since, err := startSinceRun(cmd)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to read --since-last-run state: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if since != nil {
+			since.applyTo(filter)
+		}
+
+		// Get sort option. The API can only order by creation or update
+		// time, so progress and target date are sorted client-side.
 		sortBy, _ := cmd.Flags().GetString("sort")
 		orderBy := ""
+		clientSort := ""
 		if sortBy != "" {
 			switch sortBy {
 			case "created", "createdAt":
 				orderBy = "createdAt"
 			case "updated", "updatedAt":
 				orderBy = "updatedAt"
+			case "progress":
+				clientSort = "progress"
+			case "target", "targetDate":
+				clientSort = "target"
 			case "linear":
 				// Use empty string for Linear's default sort
 				orderBy = ""
 			default:
-				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated", sortBy), plaintext, jsonOut)
+				output.Error(fmt.Sprintf("Invalid sort option: %s. Valid options are: linear, created, updated, progress, target", sortBy), plaintext, jsonOut)
 				os.Exit(1)
 			}
 		}
 
 		// Get projects
-		projects, err := client.GetProjects(context.Background(), filter, limit, "", orderBy)
+		progress := output.NewProgress(plaintext, jsonOut)
+		nodes, hasMore, err := fetchPages(progress, limit, func(first int, after string) ([]api.Project, api.PageInfo, error) {
+			page, err := client.GetProjects(context.Background(), filter, first, after, orderBy)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		progress.Stop()
+		projects := &api.Projects{Nodes: nodes, PageInfo: api.PageInfo{HasNextPage: hasMore}}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list projects: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+		if atRisk {
+			projects.Nodes, err = filterAtRiskProjects(context.Background(), client, projects.Nodes)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to fetch project health: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+		sortProjects(projects.Nodes, clientSort)
+		if since != nil {
+			// Record after the listing is printed, so a failed run
+			// doesn't advance the marker
+			updated := make([]time.Time, 0, len(projects.Nodes))
+			for _, project := range projects.Nodes {
+				updated = append(updated, project.UpdatedAt)
+			}
+			defer finishSinceRun(since, updated, hasMore)
+		}
+
+		// Only needed for projects whose URL doesn't name the workspace
+		urls := make([]string, 0, len(projects.Nodes))
+		for _, project := range projects.Nodes {
+			urls = append(urls, project.URL)
 		}
+		workspace := projectWorkspace(context.Background(), client, urls...)
 
 		// Handle output
 		if jsonOut {
 			output.JSON(projects.Nodes)
 			return
+		} else if output.MarkdownMode() {
+			output.Markdown(projectExportTableData(projects.Nodes, workspace))
+			return
+		} else if output.CSVMode() {
+			output.CSV(projectExportTableData(projects.Nodes, workspace))
+			return
 		} else if plaintext {
 			fmt.Println("# Projects")
 			for _, project := range projects.Nodes {
-				fmt.Printf("## %s\n", project.Name)
+				fmt.Printf("## %s\n", output.EscapeMarkdownInline(project.Name))
 				fmt.Printf("- **ID**: %s\n", project.ID)
 				fmt.Printf("- **State**: %s\n", project.State)
 				if project.Priority > 0 {
@@ -254,7 +430,7 @@ var projectListCmd = &cobra.Command{
 				}
 				fmt.Printf("- **Progress**: %.0f%%\n", project.Progress*100)
 				if project.Lead != nil {
-					fmt.Printf("- **Lead**: %s\n", project.Lead.Name)
+					fmt.Printf("- **Lead**: %s\n", output.EscapeMarkdownInline(project.Lead.Name))
 				} else {
 					fmt.Printf("- **Lead**: Unassigned\n")
 				}
@@ -276,9 +452,9 @@ var projectListCmd = &cobra.Command{
 				}
 				fmt.Printf("- **Created**: %s\n", project.CreatedAt.Format("2006-01-02"))
 				fmt.Printf("- **Updated**: %s\n", project.UpdatedAt.Format("2006-01-02"))
-				fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL))
+				fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL, workspace))
 				if project.Description != "" {
-					fmt.Printf("- **Description**: %s\n", project.Description)
+					fmt.Printf("- **Description**: %s\n", indentListContinuation(output.NestMarkdown(project.Description, 2)))
 				}
 				fmt.Println()
 			}
@@ -290,9 +466,9 @@ var projectListCmd = &cobra.Command{
 			rows := [][]string{}
 
 			for _, project := range projects.Nodes {
-				lead := color.New(color.FgYellow).Sprint("Unassigned")
+				lead := output.Color(output.RoleWarning).Sprint(widths.truncate("lead", "Unassigned"))
 				if project.Lead != nil {
-					lead = project.Lead.Name
+					lead = widths.truncate("lead", project.Lead.Name)
 				}
 
 				teams := ""
@@ -305,35 +481,23 @@ var projectListCmd = &cobra.Command{
 					}
 				}
 
-				stateColor := color.New(color.FgGreen)
-				switch project.State {
-				case "planned":
-					stateColor = color.New(color.FgCyan)
-				case "started":
-					stateColor = color.New(color.FgBlue)
-				case "paused":
-					stateColor = color.New(color.FgYellow)
-				case "completed":
-					stateColor = color.New(color.FgGreen)
-				case "canceled":
-					stateColor = color.New(color.FgRed)
-				}
+				stateColor := projectStateColor(project.State)
 
 				// Format priority
 				priorityStr := fmt.Sprintf("%d", project.Priority)
 				if project.Priority == 0 {
-					priorityStr = "-"
+					priorityStr = ""
 				}
 
 				rows = append(rows, []string{
-					truncateString(project.Name, 25),
-					stateColor.Sprint(project.State),
-					priorityStr,
+					widths.truncate("name", project.Name),
+					stateColor.Sprint(widths.truncate("state", project.State)),
+					output.Cell(widths.truncate("priority", priorityStr)),
 					lead,
-					teams,
-					project.CreatedAt.Format("2006-01-02"),
-					project.UpdatedAt.Format("2006-01-02"),
-					constructProjectURL(project.ID, project.URL),
+					output.Cell(widths.truncate("teams", teams)),
+					widths.truncate("created", project.CreatedAt.Format("2006-01-02")),
+					widths.truncate("updated", project.UpdatedAt.Format("2006-01-02")),
+					widths.truncate("url", constructProjectURL(project.ID, project.URL, workspace)),
 				})
 			}
 
@@ -343,14 +507,187 @@ var projectListCmd = &cobra.Command{
 			}, plaintext, jsonOut)
 
 			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d projects\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(projects.Nodes))
+				output.Summary("%d projects", len(projects.Nodes))
 			}
 		}
 	},
 }
 
+// projectHealthConcurrency bounds the parallel update lookups made by
+// `project list --at-risk`.
+const projectHealthConcurrency = 4
+
+// filterAtRiskProjects keeps projects whose most recent project update reports
+// atRisk or offTrack health. Each project's updates are fetched separately, at
+// most projectHealthConcurrency at a time; projects without updates are dropped.
+func filterAtRiskProjects(ctx context.Context, client projectAPI, projects []api.Project) ([]api.Project, error) {
+	health := make([]string, len(projects))
+	errs := make([]error, len(projects))
+	sem := make(chan struct{}, projectHealthConcurrency)
+	var wg sync.WaitGroup
+	for i := range projects {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			updates, err := client.ListProjectUpdates(ctx, projects[i].ID)
+			if err != nil {
+				errs[i] = fmt.Errorf("%s: %w", projects[i].Name, err)
+				return
+			}
+			health[i] = latestProjectHealth(updates.Nodes)
+		}(i)
+	}
+	wg.Wait()
+
+	kept := []api.Project{}
+	for i, project := range projects {
+		if errs[i] != nil {
+			return nil, errs[i]
+		}
+		if health[i] == "atRisk" || health[i] == "offTrack" {
+			project.Health = health[i]
+			kept = append(kept, project)
+		}
+	}
+	return kept, nil
+}
+
+// latestProjectHealth returns the health of the newest update, or "" if there
+// are none.
+func latestProjectHealth(updates []api.ProjectUpdate) string {
+	var latest *api.ProjectUpdate
+	for i := range updates {
+		if latest == nil || updates[i].CreatedAt.After(latest.CreatedAt) {
+			latest = &updates[i]
+		}
+	}
+	if latest == nil {
+		return ""
+	}
+	return latest.Health
+}
+
+// newestProjectUpdates sorts updates newest first and keeps the first limit
+// of them (all when limit <= 0). With reverse the kept updates are returned
+// oldest first. Updates created at the same instant are ordered by ID so the
+// result doesn't depend on the order the API returned them in.
+func newestProjectUpdates(updates []api.ProjectUpdate, limit int, reverse bool) []api.ProjectUpdate {
+	sorted := append([]api.ProjectUpdate(nil), updates...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if !sorted[i].CreatedAt.Equal(sorted[j].CreatedAt) {
+			return sorted[i].CreatedAt.After(sorted[j].CreatedAt)
+		}
+		return sorted[i].ID > sorted[j].ID
+	})
+	if !isUnboundedLimit(limit) && len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+	if reverse {
+		for i, j := 0, len(sorted)-1; i < j; i, j = i+1, j-1 {
+			sorted[i], sorted[j] = sorted[j], sorted[i]
+		}
+	}
+	return sorted
+}
+
+// projectUpdatesPreview is how many updates GetProject embeds
+// (projectUpdates(first: 10)).
+const projectUpdatesPreview = 10
+
+// recentProjectUpdates returns the newest limit updates of project (all when
+// limit <= 0) and whether it has more. Limits beyond the updates embedded in
+// the project fetch the full list.
+func recentProjectUpdates(ctx context.Context, client projectAPI, project *api.Project, limit int) ([]api.ProjectUpdate, bool, error) {
+	var updates []api.ProjectUpdate
+	if project.ProjectUpdates != nil {
+		updates = project.ProjectUpdates.Nodes
+	}
+	if len(updates) >= projectUpdatesPreview && (isUnboundedLimit(limit) || limit >= len(updates)) {
+		all, err := client.ListProjectUpdates(ctx, project.ID)
+		if err != nil {
+			return nil, false, err
+		}
+		updates = all.Nodes
+	}
+	recent := newestProjectUpdates(updates, limit, false)
+	return recent, len(recent) < len(updates), nil
+}
+
+// projectUpdateAuthor names who posted update.
+func projectUpdateAuthor(update api.ProjectUpdate) string {
+	if update.User == nil {
+		return "Unknown"
+	}
+	return update.User.Name
+}
+
+// sortProjects orders projects for the client-side sort options: "progress"
+// puts the most complete first, "target" the earliest target date first with
+// undated projects last. Ties are ordered by name so the output is stable.
+func sortProjects(projects []api.Project, by string) {
+	switch by {
+	case "progress":
+		sortStable(projects, func(a, b api.Project) int {
+			return cmp.Compare(b.Progress, a.Progress)
+		}, compareProjectNames)
+	case "target":
+		sortStable(projects, func(a, b api.Project) int {
+			ta, tb := a.TargetDate, b.TargetDate
+			undatedA, undatedB := ta == nil || *ta == "", tb == nil || *tb == ""
+			switch {
+			case undatedA && undatedB:
+				return 0
+			case undatedA:
+				return 1
+			case undatedB:
+				return -1
+			}
+			return strings.Compare(*ta, *tb)
+		}, compareProjectNames)
+	}
+}
+
+// projectExportTableData builds an uncolored, untruncated table of projects
+// for Markdown and CSV output.
+func projectExportTableData(projects []api.Project, workspace string) output.TableData {
+	data := output.TableData{
+		Headers: []string{"Name", "State", "Priority", "Lead", "Teams", "Progress", "Target Date", "URL"},
+	}
+	for _, project := range projects {
+		lead := "Unassigned"
+		if project.Lead != nil {
+			lead = project.Lead.Name
+		}
+		var teams []string
+		if project.Teams != nil {
+			for _, team := range project.Teams.Nodes {
+				teams = append(teams, team.Key)
+			}
+		}
+		priority := "-"
+		if project.Priority > 0 {
+			priority = fmt.Sprintf("%d", project.Priority)
+		}
+		targetDate := ""
+		if project.TargetDate != nil {
+			targetDate = *project.TargetDate
+		}
+		data.Rows = append(data.Rows, []string{
+			project.Name,
+			project.State,
+			priority,
+			lead,
+			strings.Join(teams, ", "),
+			fmt.Sprintf("%.0f%%", project.Progress*100),
+			targetDate,
+			constructProjectURL(project.ID, project.URL, workspace),
+		})
+	}
+	return data
+}
+
 var projectGetCmd = &cobra.Command{
 	Use:     "get PROJECT-ID",
 	Aliases: []string{"show"},
@@ -366,7 +703,7 @@ var projectGetCmd = &cobra.Command{
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -376,21 +713,36 @@ var projectGetCmd = &cobra.Command{
 		project, err := client.GetProject(context.Background(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
+		workspace := projectWorkspace(context.Background(), client, project.URL)
 
 		// Handle output
+		var recentUpdates []api.ProjectUpdate
+		moreUpdates := false
+		if !jsonOut {
+			updatesLimit, _ := cmd.Flags().GetInt("updates-limit")
+			recentUpdates, moreUpdates, err = recentProjectUpdates(context.Background(), client, project, updatesLimit)
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to get project updates: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		}
+
 		if jsonOut {
 			output.JSON(project)
 		} else if plaintext {
-			fmt.Printf("# %s\n\n", project.Name)
+			// User text is escaped, and user Markdown nested below the
+			// section headings, so the document structure stays intact
+			esc := output.EscapeMarkdownInline
+			fmt.Printf("# %s\n\n", esc(project.Name))
 
 			if project.Description != "" {
-				fmt.Printf("## Description\n%s\n\n", project.Description)
+				fmt.Printf("## Description\n%s\n\n", output.NestMarkdown(project.Description, 2))
 			}
 
 			if project.Content != "" {
-				fmt.Printf("## Content\n%s\n\n", project.Content)
+				fmt.Printf("## Content\n%s\n\n", output.NestMarkdown(project.Content, 2))
 			}
 
 			fmt.Printf("## Core Details\n")
@@ -449,15 +801,15 @@ var projectGetCmd = &cobra.Command{
 
 			fmt.Printf("\n## People\n")
 			if project.Lead != nil {
-				fmt.Printf("- **Lead**: %s (%s)\n", project.Lead.Name, project.Lead.Email)
+				fmt.Printf("- **Lead**: %s (%s)\n", esc(project.Lead.Name), project.Lead.Email)
 				if project.Lead.DisplayName != "" && project.Lead.DisplayName != project.Lead.Name {
-					fmt.Printf("  - Display Name: %s\n", project.Lead.DisplayName)
+					fmt.Printf("  - Display Name: %s\n", esc(project.Lead.DisplayName))
 				}
 			} else {
 				fmt.Printf("- **Lead**: Unassigned\n")
 			}
 			if project.Creator != nil {
-				fmt.Printf("- **Creator**: %s (%s)\n", project.Creator.Name, project.Creator.Email)
+				fmt.Printf("- **Creator**: %s (%s)\n", esc(project.Creator.Name), project.Creator.Email)
 			}
 
 			fmt.Printf("\n## Slack Integration\n")
@@ -467,14 +819,14 @@ var projectGetCmd = &cobra.Command{
 
 			if project.ConvertedFromIssue != nil {
 				fmt.Printf("\n## Origin\n")
-				fmt.Printf("- **Converted from Issue**: %s - %s\n", project.ConvertedFromIssue.Identifier, project.ConvertedFromIssue.Title)
+				fmt.Printf("- **Converted from Issue**: %s - %s\n", project.ConvertedFromIssue.Identifier, esc(project.ConvertedFromIssue.Title))
 			}
 
 			if project.LastAppliedTemplate != nil {
 				fmt.Printf("\n## Template\n")
-				fmt.Printf("- **Last Applied**: %s\n", project.LastAppliedTemplate.Name)
+				fmt.Printf("- **Last Applied**: %s\n", esc(project.LastAppliedTemplate.Name))
 				if project.LastAppliedTemplate.Description != "" {
-					fmt.Printf("  - Description: %s\n", project.LastAppliedTemplate.Description)
+					fmt.Printf("  - Description: %s\n", esc(project.LastAppliedTemplate.Description))
 				}
 			}
 
@@ -482,24 +834,24 @@ var projectGetCmd = &cobra.Command{
 			if project.Teams != nil && len(project.Teams.Nodes) > 0 {
 				fmt.Printf("\n## Teams\n")
 				for _, team := range project.Teams.Nodes {
-					fmt.Printf("- **%s** (%s)\n", team.Name, team.Key)
+					fmt.Printf("- **%s** (%s)\n", esc(team.Name), team.Key)
 					if team.Description != "" {
-						fmt.Printf("  - Description: %s\n", team.Description)
+						fmt.Printf("  - Description: %s\n", esc(team.Description))
 					}
 					fmt.Printf("  - Cycles Enabled: %v\n", team.CyclesEnabled)
 				}
 			}
 
 			fmt.Printf("\n## URL\n")
-			fmt.Printf("- %s\n", constructProjectURL(project.ID, project.URL))
+			fmt.Printf("- %s\n", constructProjectURL(project.ID, project.URL, workspace))
 
 			// Show members if available
 			if project.Members != nil && len(project.Members.Nodes) > 0 {
 				fmt.Printf("\n## Members\n")
 				for _, member := range project.Members.Nodes {
-					fmt.Printf("- %s (%s)", member.Name, member.Email)
+					fmt.Printf("- %s (%s)", esc(member.Name), member.Email)
 					if member.DisplayName != "" && member.DisplayName != member.Name {
-						fmt.Printf(" - %s", member.DisplayName)
+						fmt.Printf(" - %s", esc(member.DisplayName))
 					}
 					if member.Admin {
 						fmt.Printf(" [Admin]")
@@ -512,15 +864,18 @@ var projectGetCmd = &cobra.Command{
 			}
 
 			// Project Updates
-			if project.ProjectUpdates != nil && len(project.ProjectUpdates.Nodes) > 0 {
+			if len(recentUpdates) > 0 {
 				fmt.Printf("\n## Recent Project Updates\n")
-				for _, update := range project.ProjectUpdates.Nodes {
-					fmt.Printf("\n### %s by %s\n", update.CreatedAt.Format("2006-01-02 15:04"), update.User.Name)
+				for _, update := range recentUpdates {
+					fmt.Printf("\n### %s by %s\n", update.CreatedAt.Format("2006-01-02 15:04"), esc(projectUpdateAuthor(update)))
 					if update.EditedAt != nil {
 						fmt.Printf("*(edited %s)*\n", update.EditedAt.Format("2006-01-02 15:04"))
 					}
 					fmt.Printf("- **Health**: %s\n", update.Health)
-					fmt.Printf("\n%s\n", update.Body)
+					fmt.Printf("\n%s\n", output.NestMarkdown(update.Body, 3))
+				}
+				if moreUpdates {
+					fmt.Printf("\n*Showing the %d most recent updates; run `linctl project update-post list %s` to see all.*\n", len(recentUpdates), project.ID)
 				}
 			}
 
@@ -528,16 +883,16 @@ var projectGetCmd = &cobra.Command{
 			if project.Documents != nil && len(project.Documents.Nodes) > 0 {
 				fmt.Printf("\n## Documents\n")
 				for _, doc := range project.Documents.Nodes {
-					fmt.Printf("\n### %s\n", doc.Title)
+					fmt.Printf("\n### %s\n", esc(doc.Title))
 					if doc.Icon != nil && *doc.Icon != "" {
 						fmt.Printf("- **Icon**: %s\n", *doc.Icon)
 					}
 					fmt.Printf("- **Color**: %s\n", doc.Color)
-					fmt.Printf("- **Created**: %s by %s\n", doc.CreatedAt.Format("2006-01-02"), doc.Creator.Name)
+					fmt.Printf("- **Created**: %s by %s\n", doc.CreatedAt.Format("2006-01-02"), esc(doc.Creator.Name))
 					if doc.UpdatedBy != nil {
-						fmt.Printf("- **Updated**: %s by %s\n", doc.UpdatedAt.Format("2006-01-02"), doc.UpdatedBy.Name)
+						fmt.Printf("- **Updated**: %s by %s\n", doc.UpdatedAt.Format("2006-01-02"), esc(doc.UpdatedBy.Name))
 					}
-					fmt.Printf("\n%s\n", doc.Content)
+					fmt.Printf("\n%s\n", output.NestMarkdown(doc.Content, 3))
 				}
 			}
 
@@ -567,7 +922,7 @@ var projectGetCmd = &cobra.Command{
 					}
 
 					fmt.Printf("\n### %s %s (#%d)\n", stateStr, issue.Identifier, issue.Number)
-					fmt.Printf("**%s**\n", issue.Title)
+					fmt.Printf("**%s**\n", esc(issue.Title))
 					fmt.Printf("- Assignee: %s\n", assignee)
 					fmt.Printf("- Priority: %s\n", priorityToString(issue.Priority))
 					if issue.Estimate != nil {
@@ -605,41 +960,30 @@ var projectGetCmd = &cobra.Command{
 		} else {
 			// Formatted output
 			fmt.Println()
-			fmt.Printf("%s %s\n", color.New(color.FgCyan, color.Bold).Sprint("📁 Project:"), project.Name)
+			fmt.Printf("%s %s\n", output.Color(output.RoleIdentifier).Sprint("📁 Project:"), project.Name)
 			fmt.Println(strings.Repeat("─", 50))
 
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("ID:"), project.ID)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("ID:"), project.ID)
 
 			if project.Description != "" {
-				fmt.Printf("\n%s\n%s\n", color.New(color.Bold).Sprint("Description:"), project.Description)
+				fmt.Printf("\n%s\n%s\n", output.Color(output.RoleLabel).Sprint("Description:"),
+					maybeRenderMarkdown(project.Description, markdownRenderingRequested(cmd, plaintext, jsonOut)))
 			}
 
-			stateColor := color.New(color.FgGreen)
-			switch project.State {
-			case "planned":
-				stateColor = color.New(color.FgCyan)
-			case "started":
-				stateColor = color.New(color.FgBlue)
-			case "paused":
-				stateColor = color.New(color.FgYellow)
-			case "completed":
-				stateColor = color.New(color.FgGreen)
-			case "canceled":
-				stateColor = color.New(color.FgRed)
-			}
-			fmt.Printf("\n%s %s\n", color.New(color.Bold).Sprint("State:"), stateColor.Sprint(project.State))
+			stateColor := projectStateColor(project.State)
+			fmt.Printf("\n%s %s\n", output.Color(output.RoleLabel).Sprint("State:"), stateColor.Sprint(project.State))
 
 			if project.Priority > 0 {
-				fmt.Printf("%s %d\n", color.New(color.Bold).Sprint("Priority:"), project.Priority)
+				fmt.Printf("%s %d\n", output.Color(output.RoleLabel).Sprint("Priority:"), project.Priority)
 			}
 
-			progressColor := color.New(color.FgRed)
+			progressColor := output.Color(output.RoleError)
 			if project.Progress >= 0.75 {
-				progressColor = color.New(color.FgGreen)
+				progressColor = output.Color(output.RoleSuccess)
 			} else if project.Progress >= 0.5 {
-				progressColor = color.New(color.FgYellow)
+				progressColor = output.Color(output.RoleWarning)
 			}
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Progress:"), progressColor.Sprintf("%.0f%%", project.Progress*100))
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Progress:"), progressColor.Sprintf("%.0f%%", project.Progress*100))
 
 			if project.Initiatives != nil && len(project.Initiatives.Nodes) > 0 {
 				initiatives := ""
@@ -649,7 +993,7 @@ var projectGetCmd = &cobra.Command{
 					}
 					initiatives += initiative.Name
 				}
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Initiatives:"), initiatives)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Initiatives:"), initiatives)
 			}
 
 			if project.Labels != nil && len(project.Labels.Nodes) > 0 {
@@ -660,48 +1004,48 @@ var projectGetCmd = &cobra.Command{
 					}
 					labels += label.Name
 				}
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Labels:"), labels)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Labels:"), labels)
 			}
 
 			if project.StartDate != nil || project.TargetDate != nil {
 				fmt.Println()
 				if project.StartDate != nil {
-					fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Start Date:"), *project.StartDate)
+					fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Start Date:"), *project.StartDate)
 				}
 				if project.TargetDate != nil {
-					fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Target Date:"), *project.TargetDate)
+					fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Target Date:"), *project.TargetDate)
 				}
 			}
 
 			if project.Lead != nil {
 				fmt.Printf("\n%s %s (%s)\n",
-					color.New(color.Bold).Sprint("Lead:"),
+					output.Color(output.RoleLabel).Sprint("Lead:"),
 					project.Lead.Name,
-					color.New(color.FgCyan).Sprint(project.Lead.Email))
+					output.Color(output.RoleName).Sprint(project.Lead.Email))
 			}
 
 			if project.Teams != nil && len(project.Teams.Nodes) > 0 {
-				fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Teams:"))
+				fmt.Printf("\n%s\n", output.Color(output.RoleLabel).Sprint("Teams:"))
 				for _, team := range project.Teams.Nodes {
 					fmt.Printf("  • %s - %s\n",
-						color.New(color.FgCyan).Sprint(team.Key),
+						output.Color(output.RoleName).Sprint(team.Key),
 						team.Name)
 				}
 			}
 
 			// Show members if available
 			if project.Members != nil && len(project.Members.Nodes) > 0 {
-				fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Members:"))
+				fmt.Printf("\n%s\n", output.Color(output.RoleLabel).Sprint("Members:"))
 				for _, member := range project.Members.Nodes {
 					fmt.Printf("  • %s (%s)\n",
 						member.Name,
-						color.New(color.FgCyan).Sprint(member.Email))
+						output.Color(output.RoleName).Sprint(member.Email))
 				}
 			}
 
 			// Show sample issues if available
 			if project.Issues != nil && len(project.Issues.Nodes) > 0 {
-				fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Recent Issues:"))
+				fmt.Printf("\n%s\n", output.Color(output.RoleLabel).Sprint("Recent Issues:"))
 				for i, issue := range project.Issues.Nodes {
 					if i >= 5 {
 						break // Show only first 5
@@ -710,11 +1054,11 @@ var projectGetCmd = &cobra.Command{
 					if issue.State != nil {
 						switch issue.State.Type {
 						case "completed":
-							stateIcon = color.New(color.FgGreen).Sprint("✓")
+							stateIcon = output.StateColor("completed").Sprint("✓")
 						case "started":
-							stateIcon = color.New(color.FgBlue).Sprint("◐")
+							stateIcon = output.StateColor("started").Sprint("◐")
 						case "canceled":
-							stateIcon = color.New(color.FgRed).Sprint("✗")
+							stateIcon = output.StateColor("canceled").Sprint("✗")
 						}
 					}
 					assignee := "Unassigned"
@@ -723,14 +1067,34 @@ var projectGetCmd = &cobra.Command{
 					}
 					fmt.Printf("  %s %s %s (%s)\n",
 						stateIcon,
-						color.New(color.FgCyan).Sprint(issue.Identifier),
+						output.Color(output.RoleName).Sprint(issue.Identifier),
 						issue.Title,
-						color.New(color.FgWhite, color.Faint).Sprint(assignee))
+						output.Color(output.RoleMuted).Sprint(assignee))
+				}
+			}
+
+			// Show the most recent updates
+			if len(recentUpdates) > 0 {
+				fmt.Printf("\n%s\n", output.Color(output.RoleLabel).Sprint("Recent Updates:"))
+				for _, update := range recentUpdates {
+					fmt.Printf("  %s %s %s\n",
+						output.Color(output.RoleMuted).Sprint(update.CreatedAt.Format("2006-01-02 15:04")),
+						output.Color(output.RoleName).Sprint(projectUpdateAuthor(update)),
+						output.Color(output.RoleMuted).Sprintf("[%s]", update.Health))
+					body := maybeRenderMarkdown(strings.TrimRight(update.Body, "\n"), markdownRenderingRequested(cmd, plaintext, jsonOut))
+					for _, line := range strings.Split(body, "\n") {
+						fmt.Printf("     %s\n", line)
+					}
+				}
+				if moreUpdates {
+					fmt.Printf("\n  %s Use 'linctl project update-post list %s' to see all updates\n",
+						output.Color(output.RoleMuted).Sprint("→"),
+						project.ID)
 				}
 			}
 
 			// Show timestamps
-			fmt.Printf("\n%s\n", color.New(color.Bold).Sprint("Timeline:"))
+			fmt.Printf("\n%s\n", output.Color(output.RoleLabel).Sprint("Timeline:"))
 			fmt.Printf("  Created: %s\n", project.CreatedAt.Format("2006-01-02"))
 			fmt.Printf("  Updated: %s\n", project.UpdatedAt.Format("2006-01-02"))
 			if project.CompletedAt != nil {
@@ -743,8 +1107,8 @@ var projectGetCmd = &cobra.Command{
 			// Show URL
 			if project.URL != "" {
 				fmt.Printf("\n%s %s\n",
-					color.New(color.Bold).Sprint("URL:"),
-					color.New(color.FgBlue, color.Underline).Sprint(constructProjectURL(project.ID, project.URL)))
+					output.Color(output.RoleLabel).Sprint("URL:"),
+					output.Color(output.RoleLink).Sprint(constructProjectURL(project.ID, project.URL, workspace)))
 			}
 
 			fmt.Println()
@@ -767,7 +1131,10 @@ Examples:
   linctl project create --name "Test Project" --team ENG --state started --priority 1 --description "Test project for validation"
 
   # Create project with target date
-  linctl project create --name "Launch" --team PROD --state planned --target-date 2024-12-31`,
+  linctl project create --name "Launch" --team PROD --state planned --target-date 2024-12-31
+
+  # Create project from a template (see 'linctl project templates')
+  linctl project create --team PROD --template "Launch Plan" --name "Mobile Launch"`,
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
@@ -776,8 +1143,10 @@ Examples:
 		name, _ := cmd.Flags().GetString("name")
 		teamKey, _ := cmd.Flags().GetString("team")
 
-		// Validate required fields
-		if name == "" || teamKey == "" {
+		templateName, _ := cmd.Flags().GetString("template")
+
+		// Validate required fields (a template supplies a default name)
+		if (name == "" && templateName == "") || teamKey == "" {
 			output.Error("Both --name and --team are required", plaintext, jsonOut)
 			os.Exit(1)
 		}
@@ -786,7 +1155,7 @@ Examples:
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -795,8 +1164,26 @@ Examples:
 		// Resolve team key to team UUID
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
-			output.Error(fmt.Sprintf("Team '%s' not found. Use 'linctl team list' to see available teams.", teamKey), plaintext, jsonOut)
-			os.Exit(1)
+			output.Error(fmt.Sprintf("Failed to find team: %v. Use 'linctl team list' to see available teams.", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		// Resolve template; explicit flags override what it provides
+		var template *api.Template
+		if templateName != "" {
+			templates, err := client.GetProjectTemplates(context.Background())
+			if err != nil {
+				output.Error(fmt.Sprintf("Failed to list project templates: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			template, err = findProjectTemplate(filterTemplatesByTeam(templates, team.Key), templateName)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			if name == "" {
+				name = template.Name
+			}
 		}
 
 		// Get optional fields
@@ -857,7 +1244,12 @@ Examples:
 		// Validate color format
 		if err := validateHexColor(projectColor); err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+		links, err = validateProjectLinks(links)
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
 		}
 
 		// Look up lead user ID
@@ -866,7 +1258,7 @@ Examples:
 			user, err := client.(*api.Client).GetUser(context.Background(), leadEmail)
 			if err != nil {
 				output.Error(fmt.Sprintf("Lead user not found with email '%s': %v", leadEmail, err), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			leadID = user.ID
 		}
@@ -875,14 +1267,14 @@ Examples:
 		memberIDs, err := lookupUserIDsByEmails(context.Background(), client, members)
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Look up label IDs
-		labelIDs, err := lookupLabelIDsByNames(context.Background(), client, labelNames)
+		labelIDs, err := lookupLabelIDsByNames(context.Background(), "project", client.GetProjectLabels, labelNames)
 		if err != nil {
 			output.Error(err.Error(), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Build input map
@@ -891,6 +1283,10 @@ Examples:
 			"teamIds": []string{team.ID},
 		}
 
+		if template != nil {
+			input["templateId"] = template.ID
+		}
+		applySlackFlags(cmd, input)
 		if description != "" {
 			input["description"] = description
 		}
@@ -922,8 +1318,7 @@ Examples:
 			input["color"] = projectColor
 		}
 		if len(links) > 0 {
-			// For now, we'll just add the first link as a simple string
-			// TODO: Investigate if Linear supports multiple links or structured link objects
+			// TODO: Investigate if Linear supports structured link objects
 			input["links"] = links
 		}
 
@@ -931,19 +1326,22 @@ Examples:
 		project, err := client.CreateProject(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
+		workspace := projectWorkspace(context.Background(), client, project.URL)
+
+		copyFlagResult(cmd, project.ID, constructProjectURL(project.ID, project.URL, workspace), jsonOut)
 
 		// Handle output
 		if jsonOut {
 			output.JSON(project)
 		} else if plaintext {
 			fmt.Printf("# Project Created\n\n")
-			fmt.Printf("- **Name**: %s\n", project.Name)
+			fmt.Printf("- **Name**: %s\n", output.EscapeMarkdownInline(project.Name))
 			fmt.Printf("- **ID**: %s\n", project.ID)
 			fmt.Printf("- **State**: %s\n", project.State)
 			if project.Description != "" {
-				fmt.Printf("- **Description**: %s\n", project.Description)
+				fmt.Printf("- **Description**: %s\n", indentListContinuation(output.NestMarkdown(project.Description, 1)))
 			}
 			if project.Teams != nil && len(project.Teams.Nodes) > 0 {
 				teams := ""
@@ -955,23 +1353,85 @@ Examples:
 				}
 				fmt.Printf("- **Teams**: %s\n", teams)
 			}
-			fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL))
+			fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL, workspace))
 		} else {
 			fmt.Println()
-			fmt.Printf("%s Project created successfully\n", color.New(color.FgGreen).Sprint("✓"))
+			fmt.Printf("%s Project created successfully\n", output.Color(output.RoleSuccess).Sprint("✓"))
 			fmt.Println()
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Name:"), project.Name)
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("ID:"), project.ID)
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("State:"), project.State)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Name:"), project.Name)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("ID:"), project.ID)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("State:"), project.State)
 			if project.Teams != nil && len(project.Teams.Nodes) > 0 {
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Team:"), project.Teams.Nodes[0].Key)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Team:"), project.Teams.Nodes[0].Key)
 			}
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("URL:"), color.New(color.FgBlue, color.Underline).Sprint(constructProjectURL(project.ID, project.URL)))
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("URL:"), output.Color(output.RoleLink).Sprint(constructProjectURL(project.ID, project.URL, workspace)))
 			fmt.Println()
 		}
 	},
 }
 
+var projectTemplatesCmd = &cobra.Command{
+	Use:   "templates",
+	Short: "List project templates",
+	Long: `List project templates available in the workspace.
+
+With --team, only workspace-wide templates and that team's templates are shown.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		// Get auth header
+		authHeader, err := getAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		// Create API client
+		client := newAPIClient(authHeader)
+
+		templates, err := client.GetProjectTemplates(context.Background())
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to list project templates: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		teamKey, _ := cmd.Flags().GetString("team")
+		templates = filterTemplatesByTeam(templates, teamKey)
+
+		if jsonOut {
+			output.JSON(templates)
+			return
+		}
+		if len(templates) == 0 {
+			output.Info("No project templates found", plaintext, jsonOut)
+			return
+		}
+
+		headers := []string{"Name", "Team", "Description", "ID"}
+		rows := [][]string{}
+		for _, t := range templates {
+			team := "Workspace"
+			if t.Team != nil {
+				team = t.Team.Key
+			}
+			description := t.Description
+			if !plaintext {
+				description = truncateString(description, 50)
+			}
+			rows = append(rows, []string{t.Name, team, description, t.ID})
+		}
+
+		output.Table(output.TableData{
+			Headers: headers,
+			Rows:    rows,
+		}, plaintext, jsonOut)
+
+		if !plaintext {
+			output.Summary("%d templates", len(templates))
+		}
+	},
+}
+
 var projectArchiveCmd = &cobra.Command{
 	Use:   "archive PROJECT-UUID",
 	Short: "Archive a project",
@@ -995,7 +1455,7 @@ Examples:
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -1005,7 +1465,7 @@ Examples:
 		success, err := client.ArchiveProject(context.Background(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to archive project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Try to fetch project details to include the name in output (best effort)
@@ -1035,12 +1495,12 @@ Examples:
 			fmt.Printf("- **Status**: Archived\n")
 		} else {
 			fmt.Println()
-			fmt.Printf("%s Project archived successfully\n", color.New(color.FgGreen).Sprint("✓"))
+			fmt.Printf("%s Project archived successfully\n", output.Color(output.RoleSuccess).Sprint("✓"))
 			fmt.Println()
 			if projectName != "" {
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Name:"), projectName)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Name:"), projectName)
 			}
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Project ID:"), projectID)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Project ID:"), projectID)
 			fmt.Println()
 		}
 	},
@@ -1064,7 +1524,11 @@ Examples:
   linctl project update abc-123 --description "Full description" --summary "Short summary"
 
   # Update with labels
-  linctl project update abc-123 --label "urgent,backend"`,
+  linctl project update abc-123 --label "urgent,backend"
+
+  # Move or clear the target date
+  linctl project update abc-123 --target-date 2025-03-31
+  linctl project update abc-123 --target-date ""`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -1081,7 +1545,7 @@ Examples:
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -1120,13 +1584,26 @@ Examples:
 			}
 			input["startDate"] = startDate
 		}
+		if cmd.Flags().Changed("target-date") {
+			targetDate, _ := cmd.Flags().GetString("target-date")
+			if targetDate == "" {
+				// Empty string clears the target date
+				input["targetDate"] = nil
+			} else {
+				if _, err := time.Parse("2006-01-02", targetDate); err != nil {
+					output.Error("Invalid --target-date format. Expected YYYY-MM-DD", plaintext, jsonOut)
+					os.Exit(1)
+				}
+				input["targetDate"] = targetDate
+			}
+		}
 		if cmd.Flags().Changed("lead") {
 			leadEmail, _ := cmd.Flags().GetString("lead")
 			if leadEmail != "" {
 				user, err := client.(*api.Client).GetUser(context.Background(), leadEmail)
 				if err != nil {
 					output.Error(fmt.Sprintf("Lead user not found with email '%s': %v", leadEmail, err), plaintext, jsonOut)
-					os.Exit(1)
+					os.Exit(exitCode(err))
 				}
 				input["leadId"] = user.ID
 			}
@@ -1136,7 +1613,7 @@ Examples:
 			memberIDs, err := lookupUserIDsByEmails(context.Background(), client, members)
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			if len(memberIDs) > 0 {
 				input["memberIds"] = memberIDs
@@ -1144,10 +1621,10 @@ Examples:
 		}
 		if cmd.Flags().Changed("label") {
 			labelNames, _ := cmd.Flags().GetString("label")
-			labelIDs, err := lookupLabelIDsByNames(context.Background(), client, labelNames)
+			labelIDs, err := lookupLabelIDsByNames(context.Background(), "project", client.GetProjectLabels, labelNames)
 			if err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			if len(labelIDs) > 0 {
 				input["labelIds"] = labelIDs
@@ -1161,16 +1638,22 @@ Examples:
 			projectColor, _ := cmd.Flags().GetString("color")
 			if err := validateHexColor(projectColor); err != nil {
 				output.Error(err.Error(), plaintext, jsonOut)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			input["color"] = projectColor
 		}
 		if cmd.Flags().Changed("link") {
 			links, _ := cmd.Flags().GetStringArray("link")
+			links, err := validateProjectLinks(links)
+			if err != nil {
+				output.Error(err.Error(), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
 			if len(links) > 0 {
 				input["links"] = links
 			}
 		}
+		applySlackFlags(cmd, input)
 
 		// Validate at least one field provided
 		if len(input) == 0 {
@@ -1206,15 +1689,16 @@ Examples:
 		project, err := client.UpdateProject(context.Background(), projectID, input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to update project: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
+		workspace := projectWorkspace(context.Background(), client, project.URL)
 
 		// Handle output
 		if jsonOut {
 			output.JSON(project)
 		} else if plaintext {
 			fmt.Printf("# Project Updated\n\n")
-			fmt.Printf("- **Name**: %s\n", project.Name)
+			fmt.Printf("- **Name**: %s\n", output.EscapeMarkdownInline(project.Name))
 			fmt.Printf("- **ID**: %s\n", project.ID)
 			if project.State != "" {
 				fmt.Printf("- **State**: %s\n", project.State)
@@ -1223,22 +1707,22 @@ Examples:
 				fmt.Printf("- **Priority**: %d\n", project.Priority)
 			}
 			if project.Description != "" {
-				fmt.Printf("- **Description**: %s\n", project.Description)
+				fmt.Printf("- **Description**: %s\n", indentListContinuation(output.NestMarkdown(project.Description, 1)))
 			}
-			fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL))
+			fmt.Printf("- **URL**: %s\n", constructProjectURL(project.ID, project.URL, workspace))
 		} else {
 			fmt.Println()
-			fmt.Printf("%s Project updated successfully\n", color.New(color.FgGreen).Sprint("✓"))
+			fmt.Printf("%s Project updated successfully\n", output.Color(output.RoleSuccess).Sprint("✓"))
 			fmt.Println()
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Name:"), project.Name)
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("ID:"), project.ID)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Name:"), project.Name)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("ID:"), project.ID)
 			if project.State != "" {
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("State:"), project.State)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("State:"), project.State)
 			}
 			if project.Priority > 0 {
-				fmt.Printf("%s %d\n", color.New(color.Bold).Sprint("Priority:"), project.Priority)
+				fmt.Printf("%s %d\n", output.Color(output.RoleLabel).Sprint("Priority:"), project.Priority)
 			}
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("URL:"), color.New(color.FgBlue, color.Underline).Sprint(constructProjectURL(project.ID, project.URL)))
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("URL:"), output.Color(output.RoleLink).Sprint(constructProjectURL(project.ID, project.URL, workspace)))
 			fmt.Println()
 		}
 	},
@@ -1253,27 +1737,71 @@ var projectUpdatePostCmd = &cobra.Command{
 }
 
 var projectUpdatePostCreateCmd = &cobra.Command{
-	Use:   "create PROJECT-UUID",
+	Use:   "create [PROJECT-UUID]",
 	Short: "Create a project update post",
 	Long: `Create a new update post for a project.
 
-The project UUID is required as the first argument.
+Give the project UUID as the argument, or post the same update to several
+projects with --projects p1,p2,p3. Several projects are posted to a few at a
+time; when Linear rate-limits a request, every post waits and retries with
+increasing delays. Each project gets a result line, and the command exits
+with status 1 if any post failed. --dry-run checks that every project exists
+and lists where the update would go without posting.
+
+The body comes from exactly one of --body, --body-file, --body - (stdin) or
+--edit, which opens $EDITOR. It is read once and reused for every project.
 
 Examples:
   linctl project update-post create PROJECT-UUID --body "Monthly update..."
-  linctl project update-post create PROJECT-UUID --body "Q1 progress" --health "onTrack"`,
-	Args: cobra.ExactArgs(1),
+  linctl project update-post create PROJECT-UUID --body "Q1 progress" --health "onTrack"
+  linctl project update-post create PROJECT-UUID --body-file march.md
+  generate-report | linctl project update-post create PROJECT-UUID --body -
+  linctl project update-post create PROJECT-UUID --edit
+  linctl project update-post create --projects p1,p2,p3 --body-file monthly.md --health onTrack
+  linctl project update-post create --projects p1,p2,p3 --body-file monthly.md --dry-run`,
+	Args: cobra.MaximumNArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
 		jsonOut := viper.GetBool("json")
 
-		projectID := args[0]
-		body, _ := cmd.Flags().GetString("body")
 		health, _ := cmd.Flags().GetString("health")
+		projectsFlag, _ := cmd.Flags().GetString("projects")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+
+		// --projects and --dry-run go through the batch path, which reports
+		// a result per project
+		var projectID string
+		var projectIDs []string
+		switch {
+		case len(args) == 1 && projectsFlag != "":
+			output.Error("Give the project as an argument or with --projects, not both", plaintext, jsonOut)
+			os.Exit(1)
+		case projectsFlag != "":
+			seen := map[string]bool{}
+			for _, id := range splitCSV(projectsFlag) {
+				if !seen[id] {
+					seen[id] = true
+					projectIDs = append(projectIDs, id)
+				}
+			}
+		case len(args) == 1:
+			projectID = args[0]
+			if dryRun {
+				projectIDs = args
+			}
+		default:
+			output.Error("A project is required: pass PROJECT-UUID or --projects", plaintext, jsonOut)
+			os.Exit(1)
+		}
 
 		// Validate body is provided
-		if body == "" {
-			output.Error("--body is required", plaintext, jsonOut)
+		body, _, err := readBodyInput(cmd, "body")
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if strings.TrimSpace(body) == "" {
+			output.Error("A body is required: use --body, --body-file, --body - or --edit", plaintext, jsonOut)
 			os.Exit(1)
 		}
 
@@ -1297,12 +1825,20 @@ Examples:
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
 		client := newAPIClient(authHeader)
 
+		if projectIDs != nil {
+			results := postProjectUpdates(context.Background(), client, projectIDs, body, health, dryRun)
+			if failed := renderProjectPostResults(results, dryRun, plaintext, jsonOut); failed > 0 {
+				os.Exit(1)
+			}
+			return
+		}
+
 		// Build input
 		input := map[string]interface{}{
 			"projectId": projectID,
@@ -1316,7 +1852,7 @@ Examples:
 		update, err := client.CreateProjectUpdate(context.Background(), input)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to create project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -1330,16 +1866,16 @@ Examples:
 			fmt.Printf("Created: %s\n", update.CreatedAt.Format("2006-01-02 15:04:05"))
 		} else {
 			fmt.Println()
-			fmt.Printf("%s Project update created successfully\n", color.New(color.FgGreen).Sprint("✓"))
+			fmt.Printf("%s Project update created successfully\n", output.Color(output.RoleSuccess).Sprint("✓"))
 			fmt.Println()
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("ID:"), update.ID)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("ID:"), update.ID)
 			if update.User != nil {
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Author:"), update.User.Name)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Author:"), update.User.Name)
 			}
 			if update.Health != "" {
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Health:"), update.Health)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Health:"), update.Health)
 			}
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Created:"), update.CreatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Created:"), update.CreatedAt.Format("2006-01-02 15:04:05"))
 			fmt.Println()
 		}
 	},
@@ -1348,10 +1884,14 @@ Examples:
 var projectUpdatePostListCmd = &cobra.Command{
 	Use:   "list PROJECT-UUID",
 	Short: "List project update posts",
-	Long: `List all update posts for a project.
+	Long: `List update posts for a project, newest first.
+
+Every page of updates is fetched, sorted by creation time and listed in full.
+--limit keeps only the most recent N and --reverse prints them oldest first.
 
 Examples:
   linctl project update-post list PROJECT-UUID
+  linctl project update-post list PROJECT-UUID --limit 5 --reverse
   linctl project update-post list PROJECT-UUID --json`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -1364,7 +1904,7 @@ Examples:
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -1374,8 +1914,11 @@ Examples:
 		updates, err := client.ListProjectUpdates(context.Background(), projectID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list project updates: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
+		limit, _ := cmd.Flags().GetInt("limit")
+		reverse, _ := cmd.Flags().GetBool("reverse")
+		updates.Nodes = newestProjectUpdates(updates.Nodes, limit, reverse)
 
 		if len(updates.Nodes) == 0 {
 			if jsonOut {
@@ -1441,7 +1984,7 @@ Examples:
 		authHeader, err := getAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -1451,7 +1994,7 @@ Examples:
 		update, err := client.GetProjectUpdate(context.Background(), updateID)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get project update: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if jsonOut {
@@ -1478,20 +2021,20 @@ Examples:
 			fmt.Println(update.Body)
 		} else {
 			fmt.Println()
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("ID:"), update.ID)
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("ID:"), update.ID)
 			if update.User != nil {
-				fmt.Printf("%s %s (%s)\n", color.New(color.Bold).Sprint("Author:"), update.User.Name, update.User.Email)
+				fmt.Printf("%s %s (%s)\n", output.Color(output.RoleLabel).Sprint("Author:"), update.User.Name, update.User.Email)
 			}
 			if update.Health != "" {
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Health:"), update.Health)
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Health:"), update.Health)
 			}
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Created:"), update.CreatedAt.Format("2006-01-02 15:04:05"))
-			fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Updated:"), update.UpdatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Created:"), update.CreatedAt.Format("2006-01-02 15:04:05"))
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Updated:"), update.UpdatedAt.Format("2006-01-02 15:04:05"))
 			if update.EditedAt != nil {
-				fmt.Printf("%s %s\n", color.New(color.Bold).Sprint("Edited:"), update.EditedAt.Format("2006-01-02 15:04:05"))
+				fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Edited:"), update.EditedAt.Format("2006-01-02 15:04:05"))
 			}
 			fmt.Println()
-			fmt.Println(color.New(color.Bold).Sprint("Body:"))
+			fmt.Println(output.Color(output.RoleLabel).Sprint("Body:"))
 			fmt.Println(update.Body)
 			fmt.Println()
 		}
@@ -1506,23 +2049,32 @@ func init() {
 	projectCmd.AddCommand(projectArchiveCmd)
 	projectCmd.AddCommand(projectUpdateCmd)
 	projectCmd.AddCommand(projectUpdatePostCmd)
+	projectCmd.AddCommand(projectTemplatesCmd)
 
 	// Project update-post subcommands
 	projectUpdatePostCmd.AddCommand(projectUpdatePostCreateCmd)
 	projectUpdatePostCmd.AddCommand(projectUpdatePostListCmd)
 	projectUpdatePostCmd.AddCommand(projectUpdatePostGetCmd)
 
+	projectTemplatesCmd.Flags().StringP("team", "t", "", "Only show workspace templates and templates for this team key")
+	projectGetCmd.Flags().Bool("render-markdown", false, "Render the description and updates as Markdown (headings, lists, code blocks) in table output")
+	projectGetCmd.Flags().Int("updates-limit", 3, "Number of recent project updates to show (0 shows all; see 'project update-post list')")
+
 	// List command flags
-	projectListCmd.Flags().StringP("team", "t", "", "Filter by team key")
+	projectListCmd.Flags().StringP("team", "t", "", "Filter by team key or name")
 	projectListCmd.Flags().StringP("state", "s", "", "Filter by state (planned, started, paused, completed, canceled)")
-	projectListCmd.Flags().IntP("limit", "l", 50, "Maximum number of projects to return")
+	projectListCmd.Flags().IntP("limit", "l", 50, "Maximum number of projects to return (0 fetches all pages)")
 	projectListCmd.Flags().BoolP("include-completed", "c", false, "Include completed and canceled projects")
-	projectListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
-	projectListCmd.Flags().StringP("newer-than", "n", "", "Show projects created after this time (default: 6_months_ago, use 'all_time' for no filter)")
+	projectListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated, progress, target (progress and target sort the fetched projects; use --limit 0 to sort all)")
+	addMaxWidthFlag(projectListCmd, projectColumnWidths)
+	projectListCmd.Flags().Bool("overdue", false, "Only projects whose target date has passed and that are not completed or canceled")
+	projectListCmd.Flags().Bool("at-risk", false, "Only projects whose latest update is At Risk or Off Track (checked per fetched project)")
+	projectListCmd.Flags().StringP("newer-than", "n", "", "Show projects created after this time: N_days_ago, N_weeks_ago, YYYY-MM-DD, ISO timestamp or all_time (default 6_months_ago; see --help-time)")
+	addSinceFlags(projectListCmd, "projects")
 
 	// Create command flags
 	projectCreateCmd.Flags().String("name", "", "Project name (required)")
-	projectCreateCmd.Flags().String("team", "", "Team key (required)")
+	projectCreateCmd.Flags().String("team", "", "Team key or name (required)")
 	projectCreateCmd.Flags().String("description", "", "Project description")
 	projectCreateCmd.Flags().String("state", "", "Project state (planned|started|paused|completed|canceled)")
 	projectCreateCmd.Flags().Int("priority", 0, "Priority (0-4: None, Urgent, High, Normal, Low)")
@@ -1534,6 +2086,11 @@ func init() {
 	projectCreateCmd.Flags().String("icon", "", "Project icon (emoji)")
 	projectCreateCmd.Flags().String("color", "", "Project color (hex code, e.g., #ff6b6b)")
 	projectCreateCmd.Flags().StringArray("link", []string{}, "External link URL (can be specified multiple times)")
+	projectCreateCmd.Flags().Bool("slack-new-issue", false, "Send new issue notifications to Slack")
+	projectCreateCmd.Flags().Bool("slack-comments", false, "Send issue comment notifications to Slack")
+	projectCreateCmd.Flags().Bool("slack-statuses", false, "Send issue status change notifications to Slack")
+	projectCreateCmd.Flags().String("template", "", "Project template name or ID (flags override template values; --name defaults to the template name)")
+	addCopyFlag(projectCreateCmd, "project")
 
 	// Update command flags
 	projectUpdateCmd.Flags().String("name", "", "Project name")
@@ -1542,14 +2099,22 @@ func init() {
 	projectUpdateCmd.Flags().String("state", "", "Project state (planned|started|paused|completed|canceled)")
 	projectUpdateCmd.Flags().Int("priority", 0, "Priority (0-4: None, Urgent, High, Normal, Low)")
 	projectUpdateCmd.Flags().String("start-date", "", "Start date (YYYY-MM-DD)")
+	projectUpdateCmd.Flags().String("target-date", "", "Target date (YYYY-MM-DD, or empty to clear)")
 	projectUpdateCmd.Flags().String("lead", "", "Project lead (email)")
 	projectUpdateCmd.Flags().String("members", "", "Project members (comma-separated emails)")
 	projectUpdateCmd.Flags().String("label", "", "Project labels (comma-separated names)")
 	projectUpdateCmd.Flags().String("icon", "", "Project icon (emoji)")
 	projectUpdateCmd.Flags().String("color", "", "Project color (hex code, e.g., #ff6b6b)")
 	projectUpdateCmd.Flags().StringArray("link", []string{}, "External link URL (can be specified multiple times)")
+	projectUpdateCmd.Flags().Bool("slack-new-issue", false, "Send new issue notifications to Slack")
+	projectUpdateCmd.Flags().Bool("slack-comments", false, "Send issue comment notifications to Slack")
+	projectUpdateCmd.Flags().Bool("slack-statuses", false, "Send issue status change notifications to Slack")
 
 	// Project update-post create flags
-	projectUpdatePostCreateCmd.Flags().String("body", "", "Update post body (required)")
+	addBodyInputFlags(projectUpdatePostCreateCmd, "body", "Update post body in Markdown")
+	projectUpdatePostListCmd.Flags().IntP("limit", "l", 0, "Maximum number of updates to show, newest first (0 for all)")
+	projectUpdatePostListCmd.Flags().Bool("reverse", false, "Show the selected updates oldest first")
 	projectUpdatePostCreateCmd.Flags().String("health", "", "Project health (onTrack|atRisk|offTrack)")
+	projectUpdatePostCreateCmd.Flags().String("projects", "", "Post the same update to each of these projects (comma-separated UUIDs)")
+	projectUpdatePostCreateCmd.Flags().Bool("dry-run", false, "Check the projects and list where the update would be posted, without posting")
 }
@@ -3,9 +3,13 @@ package cmd
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
+	"sort"
+	"strings"
 	"testing"
+	"time"
 
 "github.com/raegislabs/linctl/pkg/api"
 	"github.com/spf13/viper"
@@ -13,9 +17,18 @@ import (
 
 type mockProjectClient struct {
 	created        *api.Project
+	createInput    map[string]interface{}
+	updateInput    map[string]interface{}
+	templates      []api.Template
+	projects       []api.Project
+	projectsFilter map[string]interface{}
 	archived       bool
 	projectUpdates map[string]*api.ProjectUpdate
+	updatesByID    map[string][]api.ProjectUpdate // per-project updates, when set
 	updateCounter  int
+	project        *api.Project // returned by GetProject, when set
+	labels         []api.Label  // returned by GetProjectLabels
+	viewerCalls    int
 }
 
 func (m *mockProjectClient) GetTeam(ctx context.Context, key string) (*api.Team, error) {
@@ -23,10 +36,12 @@ func (m *mockProjectClient) GetTeam(ctx context.Context, key string) (*api.Team,
 }
 
 func (m *mockProjectClient) GetProjects(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*api.Projects, error) {
-	return &api.Projects{}, nil
+	m.projectsFilter = filter
+	return &api.Projects{Nodes: m.projects}, nil
 }
 
 func (m *mockProjectClient) CreateProject(ctx context.Context, input map[string]interface{}) (*api.Project, error) {
+	m.createInput = input
 	name, _ := input["name"].(string)
 	m.created = &api.Project{ID: "p1", Name: name, State: fmt.Sprint(input["state"])}
 	return m.created, nil
@@ -38,6 +53,7 @@ func (m *mockProjectClient) ArchiveProject(ctx context.Context, id string) (bool
 }
 
 func (m *mockProjectClient) UpdateProject(ctx context.Context, id string, input map[string]interface{}) (*api.Project, error) {
+	m.updateInput = input
 	project := &api.Project{ID: id, Name: "Alpha"}
 	if name, ok := input["name"].(string); ok {
 		project.Name = name
@@ -52,6 +68,9 @@ func (m *mockProjectClient) UpdateProject(ctx context.Context, id string, input
 }
 
 func (m *mockProjectClient) GetProject(ctx context.Context, id string) (*api.Project, error) {
+	if m.project != nil {
+		return m.project, nil
+	}
 	return &api.Project{ID: id, Name: "Alpha"}, nil
 }
 
@@ -73,10 +92,21 @@ func (m *mockProjectClient) CreateProjectUpdate(ctx context.Context, input map[s
 }
 
 func (m *mockProjectClient) ListProjectUpdates(ctx context.Context, projectID string) (*api.ProjectUpdates, error) {
+	if m.updatesByID != nil {
+		return &api.ProjectUpdates{Nodes: m.updatesByID[projectID]}, nil
+	}
+	// Like the API with orderBy: createdAt, return newest first rather than in
+	// map iteration order
 	updates := []api.ProjectUpdate{}
 	for _, u := range m.projectUpdates {
 		updates = append(updates, *u)
 	}
+	sort.Slice(updates, func(i, j int) bool {
+		if !updates[i].CreatedAt.Equal(updates[j].CreatedAt) {
+			return updates[i].CreatedAt.After(updates[j].CreatedAt)
+		}
+		return updates[i].ID > updates[j].ID
+	})
 	return &api.ProjectUpdates{Nodes: updates}, nil
 }
 
@@ -90,6 +120,19 @@ func (m *mockProjectClient) GetProjectUpdate(ctx context.Context, updateID strin
 	return &api.ProjectUpdate{ID: updateID, Body: "Test update body"}, nil
 }
 
+func (m *mockProjectClient) GetProjectTemplates(ctx context.Context) ([]api.Template, error) {
+	return m.templates, nil
+}
+
+func (m *mockProjectClient) GetViewer(ctx context.Context) (*api.User, error) {
+	m.viewerCalls++
+	return &api.User{ID: "viewer-1", Organization: &api.Organization{URLKey: "acme"}}, nil
+}
+
+func (m *mockProjectClient) GetProjectLabels(ctx context.Context) (*api.Labels, error) {
+	return &api.Labels{Nodes: m.labels}, nil
+}
+
 func withInjectedProjectClient(t *testing.T, mc *mockProjectClient, fn func()) {
 	t.Helper()
 	oldNew := newAPIClient
@@ -106,10 +149,16 @@ func captureStdout(t *testing.T, fn func()) string {
 	r, w, _ := os.Pipe()
 	os.Stdout = w
 	defer func() { os.Stdout = old }()
+	// Drain concurrently so output larger than the pipe buffer can't block fn
+	done := make(chan struct{})
+	var buf bytes.Buffer
+	go func() {
+		_, _ = buf.ReadFrom(r)
+		close(done)
+	}()
 	fn()
 	_ = w.Close()
-	var buf bytes.Buffer
-	_, _ = buf.ReadFrom(r)
+	<-done
 	return buf.String()
 }
 
@@ -161,5 +210,383 @@ func TestProjectUpdatePostCreate(t *testing.T) {
 	})
 }
 
+func TestProjectUpdatePostList_NewestFirst(t *testing.T) {
+	base := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	mc := &mockProjectClient{projectUpdates: map[string]*api.ProjectUpdate{
+		"u1": {ID: "u1", Body: "kickoff", CreatedAt: base},
+		"u2": {ID: "u2", Body: "midpoint", CreatedAt: base.AddDate(0, 0, 7)},
+		"u3": {ID: "u3", Body: "launch", CreatedAt: base.AddDate(0, 0, 14)},
+		"u4": {ID: "u4", Body: "retro", CreatedAt: base.AddDate(0, 0, 21)},
+	}}
+	list := func(flags map[string]string) []string {
+		resetFlags(t, projectUpdatePostListCmd)
+		for k, v := range flags {
+			_ = projectUpdatePostListCmd.Flags().Set(k, v)
+		}
+		out := captureStdout(t, func() {
+			projectUpdatePostListCmd.Run(projectUpdatePostListCmd, []string{"proj-123"})
+		})
+		var updates []api.ProjectUpdate
+		if err := json.Unmarshal([]byte(out), &updates); err != nil {
+			t.Fatalf("invalid JSON %q: %v", out, err)
+		}
+		ids := []string{}
+		for _, u := range updates {
+			ids = append(ids, u.ID)
+		}
+		return ids
+	}
+
+	if def := projectUpdatePostListCmd.Flags().Lookup("limit").DefValue; def != "0" {
+		t.Fatalf("update-post list must list every update by default, --limit defaults to %s", def)
+	}
+	withInjectedProjectClient(t, mc, func() {
+		viper.Set("plaintext", false)
+		viper.Set("json", true)
+		t.Cleanup(func() { viper.Set("json", false) })
+
+		for i := 0; i < 5; i++ {
+			if got := strings.Join(list(nil), ","); got != "u4,u3,u2,u1" {
+				t.Fatalf("expected newest first, got %s", got)
+			}
+		}
+		if got := strings.Join(list(map[string]string{"reverse": "true"}), ","); got != "u1,u2,u3,u4" {
+			t.Fatalf("expected chronological order with --reverse, got %s", got)
+		}
+		if got := strings.Join(list(map[string]string{"limit": "2", "reverse": "true"}), ","); got != "u3,u4" {
+			t.Fatalf("expected the two newest, oldest first, got %s", got)
+		}
+	})
+}
+
+func projectUpdatesFixture(n int) []api.ProjectUpdate {
+	base := time.Date(2025, 3, 1, 9, 0, 0, 0, time.UTC)
+	updates := make([]api.ProjectUpdate, n)
+	for i := range updates {
+		updates[i] = api.ProjectUpdate{
+			ID:        fmt.Sprintf("u%02d", i+1),
+			Body:      fmt.Sprintf("Update body %02d", i+1),
+			CreatedAt: base.AddDate(0, 0, i),
+			Health:    "onTrack",
+			User:      &api.User{Name: "Ada"},
+		}
+	}
+	return updates
+}
+
+func TestProjectGet_CapsRecentUpdates(t *testing.T) {
+	mc := &mockProjectClient{project: &api.Project{
+		ID: "p1", Name: "Alpha",
+		ProjectUpdates: &api.ProjectUpdates{Nodes: projectUpdatesFixture(5)},
+	}}
+	resetFlags(t, projectGetCmd)
+	viper.Set("plaintext", true)
+	t.Cleanup(func() { viper.Set("plaintext", false) })
+
+	var out string
+	withInjectedProjectClient(t, mc, func() {
+		out = captureStdout(t, func() { projectGetCmd.Run(projectGetCmd, []string{"p1"}) })
+	})
+	for _, want := range []string{"Update body 05", "Update body 04", "Update body 03", "project update-post list p1"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("expected %q in output:\n%s", want, out)
+		}
+	}
+	if strings.Contains(out, "Update body 02") {
+		t.Fatalf("expected only the 3 newest updates by default:\n%s", out)
+	}
+	if strings.Index(out, "Update body 05") > strings.Index(out, "Update body 03") {
+		t.Fatalf("expected newest first:\n%s", out)
+	}
+}
+
+func TestProjectGet_UpdatesLimitBeyondPreviewFetchesAll(t *testing.T) {
+	all := projectUpdatesFixture(12)
+	mc := &mockProjectClient{
+		project: &api.Project{
+			ID: "p1", Name: "Alpha",
+			ProjectUpdates: &api.ProjectUpdates{Nodes: all[2:]},
+		},
+		updatesByID: map[string][]api.ProjectUpdate{"p1": all},
+	}
+	resetFlags(t, projectGetCmd)
+	_ = projectGetCmd.Flags().Set("updates-limit", "0")
+	viper.Set("plaintext", true)
+	t.Cleanup(func() { viper.Set("plaintext", false) })
+
+	var out string
+	withInjectedProjectClient(t, mc, func() {
+		out = captureStdout(t, func() { projectGetCmd.Run(projectGetCmd, []string{"p1"}) })
+	})
+	if !strings.Contains(out, "Update body 01") || strings.Contains(out, "to see all") {
+		t.Fatalf("expected every update and no see-all note:\n%s", out)
+	}
+}
+
+func TestNewestProjectUpdates_StableAcrossInputOrders(t *testing.T) {
+	base := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	updates := []api.ProjectUpdate{
+		{ID: "a", CreatedAt: base},
+		{ID: "b", CreatedAt: base.Add(time.Hour)},
+		{ID: "c", CreatedAt: base.Add(time.Hour)}, // same instant as b
+		{ID: "d", CreatedAt: base.Add(2 * time.Hour)},
+		{ID: "e", CreatedAt: base.Add(-time.Hour)},
+	}
+	want := "d,c,b,a,e"
+
+	// Every rotation and its reverse stands in for whatever order the API or
+	// a map happens to produce
+	for shift := range updates {
+		for _, reversed := range []bool{false, true} {
+			in := append(updates[shift:len(updates):len(updates)], updates[:shift]...)
+			if reversed {
+				for i, j := 0, len(in)-1; i < j; i, j = i+1, j-1 {
+					in[i], in[j] = in[j], in[i]
+				}
+			}
+			ids := []string{}
+			for _, u := range newestProjectUpdates(in, 0, false) {
+				ids = append(ids, u.ID)
+			}
+			if got := strings.Join(ids, ","); got != want {
+				t.Fatalf("shift %d reversed %v: got %s, want %s", shift, reversed, got, want)
+			}
+		}
+	}
+}
+
 // Skipping validation error tests as os.Exit() can't be easily tested
 // The validation logic works but testing it requires refactoring os.Exit() calls
+
+func TestFindProjectTemplate(t *testing.T) {
+	templates := []api.Template{
+		{ID: "t1", Name: "Launch Plan"},
+		{ID: "t2", Name: "Quarterly Goals"},
+	}
+	if got, err := findProjectTemplate(templates, "launch plan"); err != nil || got.ID != "t1" {
+		t.Fatalf("by name: got %+v, err=%v", got, err)
+	}
+	if got, err := findProjectTemplate(templates, "t2"); err != nil || got.Name != "Quarterly Goals" {
+		t.Fatalf("by ID: got %+v, err=%v", got, err)
+	}
+	_, err := findProjectTemplate(templates, "Lanch Plan")
+	if err == nil || !contains(err.Error(), "did you mean: Launch Plan") {
+		t.Fatalf("expected suggestion, got %v", err)
+	}
+}
+
+func TestProjectCreate_FromTemplate(t *testing.T) {
+	mc := &mockProjectClient{templates: []api.Template{
+		{ID: "tmpl-1", Name: "Launch Plan"},
+		{ID: "tmpl-2", Name: "Launch Plan", Team: &api.Team{Key: "OPS"}},
+	}}
+	resetFlags(t, projectCreateCmd)
+	t.Cleanup(func() { resetFlags(t, projectCreateCmd) })
+	withInjectedProjectClient(t, mc, func() {
+		viper.Set("plaintext", true)
+		viper.Set("json", false)
+		_ = projectCreateCmd.Flags().Set("team", "ENG")
+		_ = projectCreateCmd.Flags().Set("template", "launch plan")
+		_ = projectCreateCmd.Flags().Set("target-date", "2025-06-30")
+		_ = captureStdout(t, func() { projectCreateCmd.Run(projectCreateCmd, nil) })
+	})
+	if mc.createInput["templateId"] != "tmpl-1" {
+		t.Fatalf("expected templateId tmpl-1, got %v", mc.createInput["templateId"])
+	}
+	if mc.createInput["name"] != "Launch Plan" || mc.createInput["targetDate"] != "2025-06-30" {
+		t.Fatalf("expected template name and overridden target date, got %v", mc.createInput)
+	}
+}
+
+func TestProjectCreate_DedupsLabels(t *testing.T) {
+	mc := &mockProjectClient{labels: []api.Label{{ID: "PL_urgent", Name: "Urgent"}}}
+	resetFlags(t, projectCreateCmd)
+	t.Cleanup(func() { resetFlags(t, projectCreateCmd) })
+	withInjectedProjectClient(t, mc, func() {
+		viper.Set("plaintext", true)
+		viper.Set("json", false)
+		_ = projectCreateCmd.Flags().Set("name", "Alpha")
+		_ = projectCreateCmd.Flags().Set("team", "ENG")
+		_ = projectCreateCmd.Flags().Set("label", "urgent, Urgent ,URGENT")
+		_ = captureStdout(t, func() { projectCreateCmd.Run(projectCreateCmd, nil) })
+	})
+	ids, _ := mc.createInput["labelIds"].([]string)
+	if len(ids) != 1 || ids[0] != "PL_urgent" {
+		t.Fatalf("expected a single labelIds entry, got %v", mc.createInput["labelIds"])
+	}
+}
+
+func TestProjectUpdate_SlackFlagsOnlyWhenChanged(t *testing.T) {
+	mc := &mockProjectClient{}
+	resetFlags(t, projectUpdateCmd)
+	t.Cleanup(func() { resetFlags(t, projectUpdateCmd) })
+	withInjectedProjectClient(t, mc, func() {
+		viper.Set("plaintext", true)
+		viper.Set("json", false)
+		_ = projectUpdateCmd.Flags().Set("slack-comments", "false")
+		_ = projectUpdateCmd.Flags().Set("slack-statuses", "true")
+		_ = captureStdout(t, func() { projectUpdateCmd.Run(projectUpdateCmd, []string{"p1"}) })
+	})
+	if v, ok := mc.updateInput["slackIssueComments"]; !ok || v != false {
+		t.Fatalf("expected slackIssueComments=false, got %v", mc.updateInput)
+	}
+	if mc.updateInput["slackIssueStatuses"] != true {
+		t.Fatalf("expected slackIssueStatuses=true, got %v", mc.updateInput)
+	}
+	if _, ok := mc.updateInput["slackNewIssue"]; ok {
+		t.Fatalf("slackNewIssue should be omitted when the flag is unset: %v", mc.updateInput)
+	}
+}
+
+func TestProjectUpdate_TargetDate(t *testing.T) {
+	cases := []struct {
+		value string
+		want  interface{}
+	}{
+		{value: "2025-03-31", want: "2025-03-31"},
+		{value: "", want: nil},
+	}
+	for _, tc := range cases {
+		mc := &mockProjectClient{}
+		resetFlags(t, projectUpdateCmd)
+		withInjectedProjectClient(t, mc, func() {
+			viper.Set("plaintext", true)
+			viper.Set("json", false)
+			_ = projectUpdateCmd.Flags().Set("target-date", tc.value)
+			_ = captureStdout(t, func() { projectUpdateCmd.Run(projectUpdateCmd, []string{"p1"}) })
+		})
+		got, ok := mc.updateInput["targetDate"]
+		if !ok || got != tc.want {
+			t.Fatalf("--target-date %q: expected targetDate=%v in input, got %v", tc.value, tc.want, mc.updateInput)
+		}
+	}
+	resetFlags(t, projectUpdateCmd)
+}
+
+func TestProjectList_PlaintextNestsUserText(t *testing.T) {
+	mc := &mockProjectClient{projects: []api.Project{{
+		ID:          "a",
+		Name:        "Auth",
+		Description: "Rebuild sign-in\n## Not a project",
+		Lead:        &api.User{Name: "# Ann"},
+	}}}
+	withInjectedProjectClient(t, mc, func() {
+		resetFlags(t, projectListCmd)
+		viper.Set("plaintext", true)
+		t.Cleanup(func() { viper.Set("plaintext", false) })
+
+		out := captureStdout(t, func() { projectListCmd.Run(projectListCmd, nil) })
+
+		for _, want := range []string{"- **Lead**: \\# Ann\n", "- **Description**: Rebuild sign-in\n  #### Not a project\n"} {
+			if !strings.Contains(out, want) {
+				t.Fatalf("expected %q in output:\n%s", want, out)
+			}
+		}
+	})
+}
+
+func TestProjectList_SortByProgressAndTarget(t *testing.T) {
+	date := func(s string) *string { return &s }
+	mc := &mockProjectClient{projects: []api.Project{
+		{ID: "a", Name: "Undated", Progress: 0.9},
+		{ID: "b", Name: "Later", Progress: 0.2, TargetDate: date("2025-09-01")},
+		{ID: "c", Name: "Sooner", Progress: 0.5, TargetDate: date("2025-07-01")},
+	}}
+	cases := []struct {
+		sort string
+		want []string
+	}{
+		{sort: "progress", want: []string{"Undated", "Sooner", "Later"}},
+		{sort: "target", want: []string{"Sooner", "Later", "Undated"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.sort, func(t *testing.T) {
+			withInjectedProjectClient(t, mc, func() {
+				resetFlags(t, projectListCmd)
+				viper.Set("plaintext", false)
+				viper.Set("json", true)
+				t.Cleanup(func() { viper.Set("json", false) })
+				_ = projectListCmd.Flags().Set("sort", tc.sort)
+
+				out := captureStdout(t, func() { projectListCmd.Run(projectListCmd, nil) })
+
+				var got []api.Project
+				if err := json.Unmarshal([]byte(out), &got); err != nil {
+					t.Fatalf("invalid JSON %q: %v", out, err)
+				}
+				var names []string
+				for _, p := range got {
+					names = append(names, p.Name)
+				}
+				if strings.Join(names, ",") != strings.Join(tc.want, ",") {
+					t.Fatalf("sort %s: got %v, want %v", tc.sort, names, tc.want)
+				}
+			})
+		})
+	}
+}
+
+func TestProjectList_OverdueAndAtRisk(t *testing.T) {
+	now := time.Now()
+	mc := &mockProjectClient{
+		projects: []api.Project{{ID: "a", Name: "Slipping"}, {ID: "b", Name: "Fine"}, {ID: "c", Name: "Quiet"}},
+		updatesByID: map[string][]api.ProjectUpdate{
+			"a": {{Health: "onTrack", CreatedAt: now.Add(-48 * time.Hour)}, {Health: "offTrack", CreatedAt: now}},
+			"b": {{Health: "atRisk", CreatedAt: now.Add(-48 * time.Hour)}, {Health: "onTrack", CreatedAt: now}},
+		},
+	}
+	withInjectedProjectClient(t, mc, func() {
+		resetFlags(t, projectListCmd)
+		viper.Set("plaintext", false)
+		viper.Set("json", true)
+		t.Cleanup(func() { viper.Set("json", false) })
+		_ = projectListCmd.Flags().Set("include-completed", "true")
+		_ = projectListCmd.Flags().Set("overdue", "true")
+		_ = projectListCmd.Flags().Set("at-risk", "true")
+
+		out := captureStdout(t, func() { projectListCmd.Run(projectListCmd, nil) })
+
+		target, _ := mc.projectsFilter["targetDate"].(map[string]interface{})
+		if target["lt"] != now.Format("2006-01-02") {
+			t.Fatalf("expected targetDate.lt today in filter, got %v", mc.projectsFilter)
+		}
+		if _, ok := mc.projectsFilter["state"]; !ok {
+			t.Fatalf("--overdue should exclude completed projects even with --include-completed: %v", mc.projectsFilter)
+		}
+		var got []api.Project
+		if err := json.Unmarshal([]byte(out), &got); err != nil {
+			t.Fatalf("invalid JSON %q: %v", out, err)
+		}
+		if len(got) != 1 || got[0].Name != "Slipping" || got[0].Health != "offTrack" {
+			t.Fatalf("expected only the off-track project, got %+v", got)
+		}
+	})
+}
+
+func TestValidateProjectLinks(t *testing.T) {
+	links, err := validateProjectLinks([]string{
+		"https://example.com/spec",
+		" https://example.com/spec ",
+		"http://figma.com/file/abc",
+		"",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(links) != 2 || links[0] != "https://example.com/spec" || links[1] != "http://figma.com/file/abc" {
+		t.Fatalf("expected trimmed, deduped links, got %v", links)
+	}
+
+	_, err = validateProjectLinks([]string{"https://ok.example.com", "example.com/docs", "https//typo", "https://"})
+	if err == nil {
+		t.Fatal("expected malformed links to be rejected")
+	}
+	for _, bad := range []string{"'example.com/docs'", "'https//typo'", "'https://'"} {
+		if !strings.Contains(err.Error(), bad) {
+			t.Fatalf("expected %s in error, got %v", bad, err)
+		}
+	}
+	if strings.Contains(err.Error(), "ok.example.com") {
+		t.Fatalf("valid link listed as invalid: %v", err)
+	}
+}
@@ -0,0 +1,141 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/raegislabs/linctl/pkg/utils"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// openBrowser is an injection point for tests.
+var openBrowser = utils.OpenURL
+
+var projectOpenCmd = &cobra.Command{
+	Use:     "open PROJECT",
+	Aliases: []string{"view"},
+	Short:   "Open a project in the browser",
+	Long: `Open a project in Linear in your default browser.
+
+PROJECT may be the project's UUID, its URL slug (e.g. mobile-app-3f2a1b9c)
+or its name. Without a browser (e.g. over SSH) the URL is printed instead.
+
+Examples:
+  linctl project open 3f2a1b9c-...            # By UUID
+  linctl project open "Mobile App"            # By name
+  linctl project open mobile-app-3f2a1b9c --print
+  linctl project open "Mobile App" --copy`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		authHeader, err := getAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := newAPIClient(authHeader)
+
+		project, err := resolveProjectRef(context.Background(), client, args[0])
+		if err != nil {
+			output.Error(err.Error(), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		projectURL := constructProjectURL(project.ID, project.URL, projectWorkspace(context.Background(), client, project.URL))
+		if projectURL == "" {
+			output.Error(fmt.Sprintf("Could not determine a URL for project '%s'", project.Name), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		printOnly, _ := cmd.Flags().GetBool("print")
+		copyURL, _ := cmd.Flags().GetBool("copy")
+
+		result := map[string]interface{}{
+			"id":   project.ID,
+			"name": project.Name,
+			"url":  projectURL,
+		}
+
+		switch {
+		case printOnly:
+			if jsonOut {
+				output.JSON(result)
+				return
+			}
+			fmt.Println(projectURL)
+		case copyURL:
+			err := copyToClipboard(projectURL)
+			result["copied"] = err == nil
+			if jsonOut {
+				output.JSON(result)
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Clipboard unavailable (%v); project URL:\n", err)
+				fmt.Println(projectURL)
+				return
+			}
+			output.Success(fmt.Sprintf("Copied %s URL to clipboard: %s", project.Name, projectURL), plaintext, jsonOut)
+		default:
+			err := openBrowser(projectURL)
+			result["opened"] = err == nil
+			if jsonOut {
+				output.JSON(result)
+				return
+			}
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Could not open a browser (%v); project URL:\n", err)
+				fmt.Println(projectURL)
+				return
+			}
+			output.Success(fmt.Sprintf("Opened %s in your browser: %s", project.Name, projectURL), plaintext, jsonOut)
+		}
+	},
+}
+
+// resolveProjectRef finds a project by UUID, URL slug or name. Slugs such as
+// mobile-app-3f2a1b9c are looked up by their trailing slug ID.
+func resolveProjectRef(ctx context.Context, client projectAPI, ref string) (*api.Project, error) {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return nil, fmt.Errorf("Project is required")
+	}
+
+	if isValidUUID(ref) {
+		project, err := client.GetProject(ctx, ref)
+		if err != nil {
+			return nil, fmt.Errorf("Project '%s' not found: %w", ref, err)
+		}
+		return project, nil
+	}
+
+	if i := strings.LastIndex(ref, "-"); i >= 0 && !strings.ContainsAny(ref, " \t") {
+		if project, err := client.GetProject(ctx, ref[i+1:]); err == nil && project != nil {
+			return project, nil
+		}
+	}
+
+	id, err := resolveProjectByName(ctx, client, ref, "")
+	if err != nil {
+		return nil, err
+	}
+	project, err := client.GetProject(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get project: %w", err)
+	}
+	return project, nil
+}
+
+func init() {
+	projectCmd.AddCommand(projectOpenCmd)
+
+	projectOpenCmd.Flags().Bool("print", false, "Print the project URL instead of opening it")
+	projectOpenCmd.Flags().Bool("copy", false, "Copy the project URL to the clipboard instead of opening it")
+}
@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+const testProjectUUID = "123e4567-e89b-12d3-a456-426614174000"
+
+func withProjectOpenStubs(t *testing.T, openErr error) *[]string {
+	t.Helper()
+	var opened []string
+	origOpen := openBrowser
+	openBrowser = func(u string) error {
+		opened = append(opened, u)
+		return openErr
+	}
+	t.Cleanup(func() {
+		openBrowser = origOpen
+		resetFlags(t, projectOpenCmd)
+		viper.Set("plaintext", false)
+		viper.Set("json", false)
+	})
+	return &opened
+}
+
+func TestProjectOpen_OpensConstructedURL(t *testing.T) {
+	opened := withProjectOpenStubs(t, nil)
+	want := "https://linear.app/acme/project/" + testProjectUUID
+	withInjectedProjectClient(t, &mockProjectClient{}, func() {
+		viper.Set("plaintext", true)
+		out := captureStdout(t, func() { projectOpenCmd.Run(projectOpenCmd, []string{testProjectUUID}) })
+		if len(*opened) != 1 || (*opened)[0] != want {
+			t.Fatalf("expected %s to be opened, got %v", want, *opened)
+		}
+		if !strings.Contains(out, "Opened Alpha") {
+			t.Fatalf("unexpected output: %s", out)
+		}
+	})
+}
+
+func TestProjectOpen_PrintAndHeadlessFallback(t *testing.T) {
+	opened := withProjectOpenStubs(t, errors.New("no display"))
+	want := "https://linear.app/acme/project/" + testProjectUUID
+	withInjectedProjectClient(t, &mockProjectClient{}, func() {
+		_ = projectOpenCmd.Flags().Set("print", "true")
+		out := captureStdout(t, func() { projectOpenCmd.Run(projectOpenCmd, []string{testProjectUUID}) })
+		if out != want+"\n" || len(*opened) != 0 {
+			t.Fatalf("--print should only print the URL, got %q (opened %v)", out, *opened)
+		}
+
+		resetFlags(t, projectOpenCmd)
+		out = captureStdout(t, func() { projectOpenCmd.Run(projectOpenCmd, []string{testProjectUUID}) })
+		if out != want+"\n" {
+			t.Fatalf("expected the URL to be printed when no browser is available, got %q", out)
+		}
+	})
+}
+
+func TestResolveProjectRef_ByName(t *testing.T) {
+	mc := &mockProjectClient{projects: []api.Project{
+		{ID: testProjectUUID, Name: "Mobile App"},
+		{ID: "other", Name: "Mobile App v2"},
+	}}
+	project, err := resolveProjectRef(context.Background(), mc, "mobile app")
+	if err != nil || project.ID != testProjectUUID {
+		t.Fatalf("expected project %s, got %+v, %v", testProjectUUID, project, err)
+	}
+}
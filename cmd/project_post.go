@@ -0,0 +1,207 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+)
+
+// projectPostConcurrency bounds the parallel posts made by
+// `project update-post create --projects`.
+const projectPostConcurrency = 3
+
+// projectPostAttempts is how often a rate-limited request is tried before
+// the project is reported as failed.
+const projectPostAttempts = 4
+
+// projectPostBackoff is the first wait after Linear rate-limits a request;
+// it doubles on every retry. Tests shorten it.
+var projectPostBackoff = 2 * time.Second
+
+// projectPostResult is the outcome of posting the update to one project.
+type projectPostResult struct {
+	Project  string `json:"project"` // as given on the command line
+	Name     string `json:"name,omitempty"`
+	Status   string `json:"status"` // posted, would-post or failed
+	UpdateID string `json:"updateId,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// isRateLimitError reports whether Linear refused a request for exceeding
+// its rate limit, either as a RATELIMITED GraphQL error or an HTTP 429.
+func isRateLimitError(err error) bool {
+	var gqlErrs api.GraphQLErrors
+	if errors.As(err, &gqlErrs) {
+		for _, e := range gqlErrs {
+			if e.Extensions != nil && strings.EqualFold(e.Extensions.Code, "RATELIMITED") {
+				return true
+			}
+		}
+	}
+	msg := err.Error()
+	return strings.Contains(msg, "RATELIMITED") || strings.Contains(msg, "status 429")
+}
+
+// rateGate makes concurrent workers back off together: once one of them is
+// rate-limited, none sends another request until the wait is over.
+type rateGate struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+func (g *rateGate) wait(ctx context.Context) error {
+	g.mu.Lock()
+	d := time.Until(g.until)
+	g.mu.Unlock()
+	if d <= 0 {
+		return nil
+	}
+	select {
+	case <-time.After(d):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (g *rateGate) pause(d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if until := time.Now().Add(d); until.After(g.until) {
+		g.until = until
+	}
+}
+
+// do runs fn, retrying with exponential backoff while it is rate-limited.
+func (g *rateGate) do(ctx context.Context, fn func() error) error {
+	backoff := projectPostBackoff
+	for attempt := 1; ; attempt++ {
+		if err := g.wait(ctx); err != nil {
+			return err
+		}
+		err := fn()
+		if err == nil || !isRateLimitError(err) || attempt == projectPostAttempts {
+			return err
+		}
+		g.pause(backoff)
+		backoff *= 2
+	}
+}
+
+// postProjectUpdates posts the same update to every project, at most
+// projectPostConcurrency at a time. Each project is looked up first so
+// results show its name and a wrong ID fails before anything is posted to
+// it; with dryRun nothing is posted. Results keep the order of projectIDs.
+func postProjectUpdates(ctx context.Context, client projectAPI, projectIDs []string, body, health string, dryRun bool) []projectPostResult {
+	results := make([]projectPostResult, len(projectIDs))
+	gate := &rateGate{}
+	sem := make(chan struct{}, projectPostConcurrency)
+	var wg sync.WaitGroup
+	for i, id := range projectIDs {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, id string) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			result := projectPostResult{Project: id}
+			defer func() { results[i] = result }()
+
+			var project *api.Project
+			err := gate.do(ctx, func() (err error) {
+				project, err = client.GetProject(ctx, id)
+				return err
+			})
+			if err != nil {
+				result.Status, result.Error = "failed", fmt.Sprintf("project not found: %v", err)
+				return
+			}
+			result.Name = project.Name
+			if dryRun {
+				result.Status = "would-post"
+				return
+			}
+
+			input := map[string]interface{}{"projectId": project.ID, "body": body}
+			if health != "" {
+				input["health"] = health
+			}
+			var update *api.ProjectUpdate
+			err = gate.do(ctx, func() (err error) {
+				update, err = client.CreateProjectUpdate(ctx, input)
+				return err
+			})
+			if err != nil {
+				result.Status, result.Error = "failed", err.Error()
+				return
+			}
+			result.Status, result.UpdateID = "posted", update.ID
+		}(i, id)
+	}
+	wg.Wait()
+	return results
+}
+
+// renderProjectPostResults prints one line per project and a summary, and
+// returns the number of failures.
+func renderProjectPostResults(results []projectPostResult, dryRun, plaintext, jsonOut bool) int {
+	counts := map[string]int{}
+	for _, r := range results {
+		counts[r.Status]++
+	}
+
+	if jsonOut {
+		output.JSON(results)
+		return counts["failed"]
+	}
+
+	for _, r := range results {
+		name := r.Project
+		if r.Name != "" {
+			name = fmt.Sprintf("%s (%s)", r.Name, r.Project)
+		}
+		if plaintext {
+			switch r.Status {
+			case "failed":
+				fmt.Printf("Failed %s: %s\n", name, r.Error)
+			case "would-post":
+				fmt.Printf("Would post to %s\n", name)
+			default:
+				fmt.Printf("Posted to %s: %s\n", name, r.UpdateID)
+			}
+			continue
+		}
+		switch r.Status {
+		case "failed":
+			fmt.Printf("%s %s: %s\n",
+				output.Color(output.RoleError).Sprint("✗"),
+				output.Color(output.RoleProject).Sprint(name),
+				output.Color(output.RoleError).Sprint(r.Error))
+		case "would-post":
+			fmt.Printf("%s %s\n",
+				output.Color(output.RoleMuted).Sprint("•"),
+				output.Color(output.RoleProject).Sprint(name))
+		default:
+			fmt.Printf("%s %s %s\n",
+				output.Color(output.RoleSuccess).Sprint("✓"),
+				output.Color(output.RoleProject).Sprint(name),
+				output.Color(output.RoleMuted).Sprint(r.UpdateID))
+		}
+	}
+
+	failed := ""
+	if n := counts["failed"]; n > 0 {
+		failed = fmt.Sprintf(" (%d failed)", n)
+	}
+	if dryRun {
+		fmt.Printf("\nDry run: would post to %d of %d projects%s\n", counts["would-post"], len(results), failed)
+	} else {
+		fmt.Printf("\nPosted the update to %d of %d projects%s\n", counts["posted"], len(results), failed)
+	}
+	return counts["failed"]
+}
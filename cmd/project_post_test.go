@@ -0,0 +1,123 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/spf13/viper"
+)
+
+// postingProjectClient records update posts from concurrent workers and
+// rate-limits the first rateLimited of them.
+type postingProjectClient struct {
+	mockProjectClient
+	mu          sync.Mutex
+	rateLimited int
+	attempts    int
+	posted      map[string]string // project ID → body
+}
+
+func (m *postingProjectClient) GetProject(ctx context.Context, id string) (*api.Project, error) {
+	if id == "missing" {
+		return nil, errors.New("Entity not found: Project")
+	}
+	return &api.Project{ID: id, Name: "Project " + id}, nil
+}
+
+func (m *postingProjectClient) CreateProjectUpdate(ctx context.Context, input map[string]interface{}) (*api.ProjectUpdate, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.attempts++
+	if m.rateLimited > 0 {
+		m.rateLimited--
+		return nil, api.GraphQLErrors{{Message: "Rate limit exceeded", Extensions: &api.GraphQLErrorExtensions{Code: "RATELIMITED"}}}
+	}
+	if m.posted == nil {
+		m.posted = map[string]string{}
+	}
+	id := input["projectId"].(string)
+	m.posted[id] = input["body"].(string)
+	return &api.ProjectUpdate{ID: "update-" + id}, nil
+}
+
+func TestPostProjectUpdates_RetriesRateLimitedPosts(t *testing.T) {
+	orig := projectPostBackoff
+	projectPostBackoff = time.Millisecond
+	t.Cleanup(func() { projectPostBackoff = orig })
+
+	client := &postingProjectClient{rateLimited: 2}
+	results := postProjectUpdates(context.Background(), client, []string{"p1", "missing", "p2"}, "Monthly", "onTrack", false)
+
+	got := fmt.Sprintf("%s %s %s", results[0].Status, results[1].Status, results[2].Status)
+	if got != "posted failed posted" || results[0].UpdateID != "update-p1" || results[2].Name != "Project p2" {
+		t.Fatalf("unexpected results: %+v", results)
+	}
+	if client.attempts != 4 {
+		t.Fatalf("expected 2 rate-limited attempts and 2 posts, got %d attempts", client.attempts)
+	}
+	if client.posted["p1"] != "Monthly" || client.posted["p2"] != "Monthly" {
+		t.Fatalf("expected the same body on every project, got %v", client.posted)
+	}
+}
+
+func TestPostProjectUpdates_GivesUpAfterRepeatedRateLimits(t *testing.T) {
+	orig := projectPostBackoff
+	projectPostBackoff = time.Millisecond
+	t.Cleanup(func() { projectPostBackoff = orig })
+
+	client := &postingProjectClient{rateLimited: projectPostAttempts}
+	results := postProjectUpdates(context.Background(), client, []string{"p1"}, "Monthly", "", false)
+	if results[0].Status != "failed" || client.attempts != projectPostAttempts {
+		t.Fatalf("expected failure after %d attempts, got %+v after %d", projectPostAttempts, results[0], client.attempts)
+	}
+}
+
+func TestProjectUpdatePostCreate_DryRunDoesNotPost(t *testing.T) {
+	client := &postingProjectClient{}
+	resetFlags(t, projectUpdatePostCreateCmd)
+	viper.Set("json", true)
+	t.Cleanup(func() { viper.Set("json", false) })
+	_ = projectUpdatePostCreateCmd.Flags().Set("projects", "p1, p2,p1")
+	_ = projectUpdatePostCreateCmd.Flags().Set("body", "Monthly")
+	_ = projectUpdatePostCreateCmd.Flags().Set("dry-run", "true")
+
+	var out string
+	withInjectedProjectClient(t, &client.mockProjectClient, func() {
+		newAPIClient = func(string) projectAPI { return client }
+		out = captureStdout(t, func() { projectUpdatePostCreateCmd.Run(projectUpdatePostCreateCmd, nil) })
+	})
+
+	var results []projectPostResult
+	if err := json.Unmarshal([]byte(out), &results); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, out)
+	}
+	if len(results) != 2 || results[0].Status != "would-post" || results[1].Project != "p2" {
+		t.Fatalf("unexpected dry-run results: %+v", results)
+	}
+	if client.attempts != 0 {
+		t.Fatalf("--dry-run must not post, got %d attempts", client.attempts)
+	}
+}
+
+func TestIsRateLimitError(t *testing.T) {
+	cases := []struct {
+		err  error
+		want bool
+	}{
+		{api.GraphQLErrors{{Message: "slow down", Extensions: &api.GraphQLErrorExtensions{Code: "RATELIMITED"}}}, true},
+		{errors.New(`API request failed with status 400: {"errors":[{"extensions":{"code":"RATELIMITED"}}]}`), true},
+		{errors.New("API request failed with status 429: Too Many Requests"), true},
+		{errors.New("Entity not found: Project"), false},
+	}
+	for _, tc := range cases {
+		if got := isRateLimitError(tc.err); got != tc.want {
+			t.Errorf("isRateLimitError(%v) = %v, want %v", tc.err, got, tc.want)
+		}
+	}
+}
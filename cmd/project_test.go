@@ -1,19 +1,50 @@
 package cmd
 
-import "testing"
+import (
+	"context"
+	"testing"
+)
 
 func TestConstructProjectURL(t *testing.T) {
-	// Happy path: workspace URL should be preserved, slug replaced by ID
-	id := "1234"
-	original := "https://linear.app/acme/project/some-project-slug"
-	want := "https://linear.app/acme/project/1234"
-	got := constructProjectURL(id, original)
-	if got != want {
-		t.Fatalf("constructProjectURL mismatch: got %q want %q", got, want)
+	cases := []struct {
+		name      string
+		id        string
+		original  string
+		workspace string // from projectWorkspace
+		want      string
+	}{
+		{name: "canonical URL", id: "1234", original: "https://linear.app/acme/project/some-project-slug", want: "https://linear.app/acme/project/1234"},
+		{name: "trailing slash", id: "1234", original: "https://linear.app/acme/project/some-slug/", want: "https://linear.app/acme/project/1234"},
+		{name: "query and fragment", id: "1234", original: "https://linear.app/acme/project/some-slug?tab=updates#top", want: "https://linear.app/acme/project/1234"},
+		{name: "workspace only", id: "1234", original: "https://linear.app/acme", want: "https://linear.app/acme/project/1234"},
+		{name: "missing scheme", id: "1234", original: "linear.app/acme/project/slug", want: "https://linear.app/acme/project/1234"},
+		{name: "surrounding whitespace", id: "1234", original: "  https://linear.app/acme/project/slug  ", want: "https://linear.app/acme/project/1234"},
+		{name: "empty URL uses viewer workspace", id: "1234", original: "", workspace: "acme", want: "https://linear.app/acme/project/1234"},
+		{name: "empty URL without workspace", id: "1234", original: "", want: ""},
+		{name: "host only falls back to viewer", id: "1234", original: "https://linear.app/", workspace: "acme", want: "https://linear.app/acme/project/1234"},
+		{name: "unparseable URL is returned", id: "1234", original: "://bad", want: "://bad"},
+		{name: "no workspace anywhere keeps original", id: "1234", original: "https://linear.app/project/slug", want: "https://linear.app/project/slug"},
+		{name: "empty ID keeps original", id: "", original: "https://linear.app/acme/project/slug", want: "https://linear.app/acme/project/slug"},
+		{name: "empty ID and URL", id: "", original: "", workspace: "acme", want: ""},
 	}
 
-	// Empty original → empty result
-	if s := constructProjectURL("abc", ""); s != "" {
-		t.Fatalf("expected empty for empty original URL, got %q", s)
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := constructProjectURL(tc.id, tc.original, tc.workspace); got != tc.want {
+				t.Fatalf("constructProjectURL(%q, %q) = %q, want %q", tc.id, tc.original, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestProjectWorkspace_OnlyAsksWhenAURLLacksIt(t *testing.T) {
+	client := &mockProjectClient{}
+	ctx := context.Background()
+
+	if got := projectWorkspace(ctx, client, "https://linear.app/acme/project/a", "https://linear.app/acme/project/b"); got != "" || client.viewerCalls != 0 {
+		t.Fatalf("URLs naming the workspace need no lookup, got %q after %d calls", got, client.viewerCalls)
+	}
+	if got := projectWorkspace(ctx, client, "https://linear.app/acme/project/a", "", ""); got != "acme" || client.viewerCalls != 1 {
+		t.Fatalf("expected one viewer lookup for the URLs without a workspace, got %q after %d calls", got, client.viewerCalls)
 	}
 }
@@ -1,19 +1,30 @@
 package cmd
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
 	"github.com/fatih/color"
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/raegislabs/linctl/pkg/utils"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
 var (
-	cfgFile   string
-	plaintext bool
-	jsonOut   bool
+	cfgFile      string
+	outputFormat string
+	plaintext    bool
+	jsonOut      bool
+	markdown     bool
+	noColor      bool
+	theme        string
+	quiet        bool
+	assumeYes    bool
+	debug        bool
 )
 
 // version is set at build time via -ldflags
@@ -70,6 +81,42 @@ var rootCmd = &cobra.Command{
 	Short:   "A comprehensive Linear CLI tool",
 	Long:    color.New(color.FgCyan).Sprintf("%s\nA comprehensive CLI tool for Linear's API featuring:\n• Issue management (create, list, update, archive)\n• Project tracking and collaboration  \n• Team and user management\n• Comments and attachments\n• Webhook configuration\n• Table/plaintext/JSON output formats\n", generateHeader()),
 	Version: version,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		if helpTime, _ := cmd.Flags().GetBool("help-time"); helpTime {
+			fmt.Println(utils.TimeExpressionHelp)
+			os.Exit(0)
+		}
+		format, err := resolveOutputFormat(cmd.Flags())
+		if err != nil {
+			output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+			os.Exit(exitCode(err))
+		}
+		applyOutputFormat(format)
+		applyConfigDefaults(cmd)
+		applyBranchTeamDefault(cmd)
+		if err := output.SetTheme(viper.GetString("theme")); err != nil {
+			output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+			os.Exit(exitCode(err))
+		}
+		if err := api.SetProxy(viper.GetString("proxy")); err != nil {
+			output.Error(err.Error(), viper.GetBool("plaintext"), viper.GetBool("json"))
+			os.Exit(exitCode(err))
+		}
+	},
+}
+
+// exitCodeNetwork is the exit status when the Linear API could not be
+// reached at all, so scripts can tell outages from other failures.
+const exitCodeNetwork = 3
+
+// exitCode is the status to exit with after reporting err: exitCodeNetwork
+// when the API could not be reached, 1 otherwise.
+func exitCode(err error) int {
+	var netErr *api.NetworkError
+	if errors.As(err, &netErr) {
+		return exitCodeNetwork
+	}
+	return 1
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
@@ -90,12 +137,34 @@ func init() {
 
 	// Global flags
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.linctl.yaml)")
-	rootCmd.PersistentFlags().BoolVarP(&plaintext, "plaintext", "p", false, "plaintext output (non-interactive)")
-	rootCmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "JSON output")
+	rootCmd.PersistentFlags().StringVarP(&outputFormat, "output", "F", "", "output format: table, json, plaintext, csv, markdown (default table, or $LINCTL_OUTPUT)")
+	rootCmd.PersistentFlags().BoolVarP(&plaintext, "plaintext", "p", false, "plaintext output (alias for --output plaintext)")
+	rootCmd.PersistentFlags().BoolVarP(&jsonOut, "json", "j", false, "JSON output (alias for --output json)")
+	rootCmd.PersistentFlags().BoolVar(&markdown, "markdown", false, "Markdown table output (alias for --output markdown)")
+	rootCmd.PersistentFlags().BoolVar(&noColor, "no-color", false, "disable colored output (also honors NO_COLOR)")
+	rootCmd.PersistentFlags().StringVar(&theme, "theme", "", "color theme: default, colorblind, mono (also $LINCTL_THEME)")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "suppress progress indicators")
+	rootCmd.PersistentFlags().BoolVar(&debug, "debug", false, "log GraphQL requests and responses to stderr (API key redacted)")
+	rootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "answer yes to all confirmation prompts (also $LINCTL_ASSUME_YES)")
+	rootCmd.PersistentFlags().String("proxy", "", "route API requests through this proxy URL, overriding HTTPS_PROXY/HTTP_PROXY/NO_PROXY")
+	rootCmd.PersistentFlags().Bool("help-time", false, "show the time expressions accepted by --newer-than")
+	_ = rootCmd.PersistentFlags().MarkHidden("help-time")
 
 	// Bind flags to viper
+	_ = viper.BindPFlag("output", rootCmd.PersistentFlags().Lookup("output"))
+	_ = viper.BindEnv("output", "LINCTL_OUTPUT")
 	_ = viper.BindPFlag("plaintext", rootCmd.PersistentFlags().Lookup("plaintext"))
 	_ = viper.BindPFlag("json", rootCmd.PersistentFlags().Lookup("json"))
+	_ = viper.BindPFlag("markdown", rootCmd.PersistentFlags().Lookup("markdown"))
+	_ = viper.BindPFlag("no-color", rootCmd.PersistentFlags().Lookup("no-color"))
+	_ = viper.BindPFlag("theme", rootCmd.PersistentFlags().Lookup("theme"))
+	_ = viper.BindEnv("theme", "LINCTL_THEME")
+	_ = viper.BindEnv("empty_cell", "LINCTL_EMPTY_CELL")
+	_ = viper.BindPFlag("quiet", rootCmd.PersistentFlags().Lookup("quiet"))
+	_ = viper.BindPFlag("debug", rootCmd.PersistentFlags().Lookup("debug"))
+	_ = viper.BindPFlag("yes", rootCmd.PersistentFlags().Lookup("yes"))
+	_ = viper.BindEnv("yes", "LINCTL_ASSUME_YES")
+	_ = viper.BindPFlag("proxy", rootCmd.PersistentFlags().Lookup("proxy"))
 }
 
 // initConfig reads in config file and ENV variables if set.
@@ -118,8 +187,19 @@ func initConfig() {
 
 	// If a config file is found, read it in.
 	if err := viper.ReadInConfig(); err == nil {
-		if !plaintext && !jsonOut {
+		if !plaintext && !jsonOut && !markdown {
 			fmt.Fprintln(os.Stderr, color.New(color.FgGreen).Sprintf("✅ Using config file: %s", viper.ConfigFileUsed()))
 		}
 	}
+
+	if viper.GetBool("no-color") {
+		color.NoColor = true
+	}
+	output.SetQuiet(viper.GetBool("quiet"))
+	if viper.IsSet("empty_cell") {
+		output.SetEmptyCell(viper.GetString("empty_cell"))
+	}
+	if viper.GetBool("debug") {
+		api.SetDebug(os.Stderr)
+	}
 }
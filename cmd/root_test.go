@@ -0,0 +1,26 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func TestExitCode(t *testing.T) {
+	netErr := &api.NetworkError{Host: "api.linear.app", Detail: "connection refused"}
+	cases := []struct {
+		err  error
+		want int
+	}{
+		{netErr, exitCodeNetwork},
+		{fmt.Errorf("Failed to find team 'ENG': %w", netErr), exitCodeNetwork},
+		{errors.New("Entity not found: Team"), 1},
+	}
+	for _, tc := range cases {
+		if got := exitCode(tc.err); got != tc.want {
+			t.Errorf("exitCode(%v) = %d, want %d", tc.err, got, tc.want)
+		}
+	}
+}
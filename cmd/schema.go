@@ -0,0 +1,252 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// schemaTypes maps the names accepted by `linctl schema` to the structs the
+// CLI emits with --json.
+var schemaTypes = map[string]reflect.Type{
+	"issue":          reflect.TypeOf(api.Issue{}),
+	"project":        reflect.TypeOf(api.Project{}),
+	"project-update": reflect.TypeOf(api.ProjectUpdate{}),
+	"team":           reflect.TypeOf(api.Team{}),
+	"user":           reflect.TypeOf(api.User{}),
+	"comment":        reflect.TypeOf(api.Comment{}),
+	"cycle":          reflect.TypeOf(api.Cycle{}),
+	"label":          reflect.TypeOf(api.Label{}),
+	"notification":   reflect.TypeOf(api.Notification{}),
+	"favorite":       reflect.TypeOf(api.Favorite{}),
+}
+
+var schemaCmd = &cobra.Command{
+	Use:   "schema [TYPE]",
+	Short: "Describe the JSON fields emitted with --json",
+	Long: `Print the JSON field names and types that --json output uses for a type,
+derived from the CLI's own data structures. Without a TYPE, list the types
+that can be described.
+
+Types are string, integer, number, boolean, datetime (RFC 3339), any,
+array<T>, map<string, T>, or the name of another object. Nullable fields may
+be null; optional fields may be missing entirely. Objects referenced by the
+type are listed under "definitions" in --json output and with --all otherwise.
+
+Examples:
+  linctl schema
+  linctl schema issue
+  linctl schema project --all
+  linctl schema issue --json > issue.schema.json`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		if len(args) == 0 {
+			names := schemaTypeNames()
+			if jsonOut {
+				output.JSON(names)
+				return
+			}
+			if plaintext {
+				fmt.Println("# Types")
+				for _, name := range names {
+					fmt.Printf("- %s\n", name)
+				}
+				return
+			}
+			fmt.Printf("%s %s\n", output.Color(output.RoleLabel).Sprint("Types:"), strings.Join(names, ", "))
+			fmt.Println("Run 'linctl schema TYPE' to see its fields.")
+			return
+		}
+
+		t, ok := schemaTypes[strings.ToLower(args[0])]
+		if !ok {
+			output.Error(fmt.Sprintf("Unknown type '%s'. Available types: %s", args[0], strings.Join(schemaTypeNames(), ", ")), plaintext, jsonOut)
+			os.Exit(1)
+		}
+		schema := describeSchema(t)
+
+		if jsonOut {
+			output.JSON(schema)
+			return
+		}
+
+		all, _ := cmd.Flags().GetBool("all")
+		names := []string{schema.Type}
+		if all {
+			names = append(names, sortedKeys(schema.Definitions)...)
+		}
+		fieldsOf := func(name string) []schemaField {
+			if name == schema.Type {
+				return schema.Fields
+			}
+			return schema.Definitions[name]
+		}
+
+		if plaintext {
+			for i, name := range names {
+				level := "#"
+				if i > 0 {
+					level = "##"
+				}
+				fmt.Printf("%s %s\n", level, name)
+				for _, f := range fieldsOf(name) {
+					fmt.Printf("- **%s**: %s\n", f.Name, schemaFieldType(f))
+				}
+				fmt.Println()
+			}
+			return
+		}
+
+		for i, name := range names {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Println(output.Color(output.RoleIdentifier).Sprint(name))
+			rows := [][]string{}
+			for _, f := range fieldsOf(name) {
+				rows = append(rows, []string{f.Name, schemaFieldType(f)})
+			}
+			output.Table(output.TableData{Headers: []string{"Field", "Type"}, Rows: rows}, plaintext, jsonOut)
+		}
+		if !all && len(schema.Definitions) > 0 {
+			output.Summary("%d fields; references %s (use --all to expand)",
+				len(schema.Fields), strings.Join(sortedKeys(schema.Definitions), ", "))
+		}
+	},
+}
+
+// schemaDoc describes one type and every object type it references.
+type schemaDoc struct {
+	Type        string                   `json:"type"`
+	Fields      []schemaField            `json:"fields"`
+	Definitions map[string][]schemaField `json:"definitions"`
+}
+
+type schemaField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Nullable bool   `json:"nullable"`
+	Optional bool   `json:"optional"`
+}
+
+// describeSchema walks t with reflect, following the same json tags
+// encoding/json uses. Referenced structs are described once each, which also
+// keeps self-referencing types such as Issue.parent finite.
+func describeSchema(t reflect.Type) schemaDoc {
+	defs := map[string][]schemaField{t.Name(): nil}
+	fields := describeStructFields(t, defs)
+	delete(defs, t.Name())
+	return schemaDoc{Type: t.Name(), Fields: fields, Definitions: defs}
+}
+
+func describeStructFields(t reflect.Type, defs map[string][]schemaField) []schemaField {
+	var fields []schemaField
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			fields = append(fields, describeStructFields(sf.Type, defs)...)
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		typ, nullable := schemaTypeName(sf.Type, defs)
+		fields = append(fields, schemaField{
+			Name:     name,
+			Type:     typ,
+			Nullable: nullable,
+			Optional: strings.Contains(","+opts+",", ",omitempty,"),
+		})
+	}
+	return fields
+}
+
+// schemaTypeName names the JSON type of t and reports whether its zero value
+// encodes as null. Struct types are added to defs on first sight.
+func schemaTypeName(t reflect.Type, defs map[string][]schemaField) (string, bool) {
+	switch t.Kind() {
+	case reflect.Ptr:
+		name, _ := schemaTypeName(t.Elem(), defs)
+		return name, true
+	case reflect.Slice, reflect.Array:
+		elem, _ := schemaTypeName(t.Elem(), defs)
+		return "array<" + elem + ">", t.Kind() == reflect.Slice
+	case reflect.Map:
+		elem, _ := schemaTypeName(t.Elem(), defs)
+		return "map<string, " + elem + ">", true
+	case reflect.Interface:
+		return "any", true
+	case reflect.String:
+		return "string", false
+	case reflect.Bool:
+		return "boolean", false
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "integer", false
+	case reflect.Float32, reflect.Float64:
+		return "number", false
+	case reflect.Struct:
+		if t == reflect.TypeOf(time.Time{}) {
+			return "datetime", false
+		}
+		if _, seen := defs[t.Name()]; !seen {
+			defs[t.Name()] = nil
+			defs[t.Name()] = describeStructFields(t, defs)
+		}
+		return t.Name(), false
+	}
+	return "any", false
+}
+
+func schemaFieldType(f schemaField) string {
+	typ := f.Type
+	if f.Nullable {
+		typ += " | null"
+	}
+	if f.Optional {
+		typ += " (optional)"
+	}
+	return typ
+}
+
+func schemaTypeNames() []string {
+	names := make([]string, 0, len(schemaTypes))
+	for name := range schemaTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func sortedKeys(m map[string][]schemaField) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func init() {
+	rootCmd.AddCommand(schemaCmd)
+	schemaCmd.Flags().Bool("all", false, "Also describe every referenced object type")
+}
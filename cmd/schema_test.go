@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type schemaTestNode struct {
+	ID       string            `json:"id"`
+	Count    int               `json:"count,omitempty"`
+	Score    *float64          `json:"score"`
+	At       time.Time         `json:"at"`
+	Tags     []string          `json:"tags"`
+	Meta     map[string]any    `json:"meta"`
+	Parent   *schemaTestNode   `json:"parent"`
+	Owner    schemaTestOwner   `json:"owner"`
+	Secret   string            `json:"-"`
+	internal string            //nolint:unused // unexported fields are skipped
+	Extra    map[string]string `json:"extra,omitempty"`
+}
+
+type schemaTestOwner struct {
+	Name string
+}
+
+func TestDescribeSchema(t *testing.T) {
+	doc := describeSchema(reflect.TypeOf(schemaTestNode{}))
+
+	want := []schemaField{
+		{Name: "id", Type: "string"},
+		{Name: "count", Type: "integer", Optional: true},
+		{Name: "score", Type: "number", Nullable: true},
+		{Name: "at", Type: "datetime"},
+		{Name: "tags", Type: "array<string>", Nullable: true},
+		{Name: "meta", Type: "map<string, any>", Nullable: true},
+		{Name: "parent", Type: "schemaTestNode", Nullable: true},
+		{Name: "owner", Type: "schemaTestOwner"},
+		{Name: "extra", Type: "map<string, string>", Nullable: true, Optional: true},
+	}
+	if !reflect.DeepEqual(doc.Fields, want) {
+		t.Fatalf("unexpected fields:\n%+v\nwant:\n%+v", doc.Fields, want)
+	}
+	if _, ok := doc.Definitions["schemaTestNode"]; ok {
+		t.Fatal("the described type should not repeat itself under definitions")
+	}
+	owner := doc.Definitions["schemaTestOwner"]
+	if len(owner) != 1 || owner[0].Name != "Name" {
+		t.Fatalf("expected owner definition with untagged field name, got %+v", owner)
+	}
+}
+
+func TestSchemaTypesResolve(t *testing.T) {
+	for name, typ := range schemaTypes {
+		if doc := describeSchema(typ); len(doc.Fields) == 0 {
+			t.Errorf("schema %s has no fields", name)
+		}
+	}
+}
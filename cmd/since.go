@@ -0,0 +1,183 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// sinceStatePath returns the file holding --since-last-run markers; tests
+// replace it.
+var sinceStatePath = func() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("cannot locate home directory: %w", err)
+	}
+	return filepath.Join(home, ".linctl-state.json"), nil
+}
+
+// sinceIgnoredFlags only change how results are shown or fetched, so they do
+// not start a separate --since-last-run marker. Global flags such as --json
+// or --debug are never part of the key (see sinceKey).
+var sinceIgnoredFlags = map[string]bool{
+	"since-last-run": true, "reset-since": true, "limit": true, "sort": true,
+	"plaintext-table": true, "show-age": true, "max-width": true, "enrich": true,
+	"with-totals": true, "watch": true,
+}
+
+// sinceMarker is the stored state of one command and filter combination.
+type sinceMarker struct {
+	Command   string    `json:"command"`
+	Filter    string    `json:"filter"` // the filter flags, for people reading the file
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// sinceRun tracks --since-last-run for one invocation.
+type sinceRun struct {
+	key    string
+	marker sinceMarker
+	since  time.Time // zero on the first run
+}
+
+// sinceKey identifies a command and the filters it runs with. Filters are
+// the command's own flags at their effective values, so a --team filled in
+// from defaults.team or --from-branch counts the same as one typed out, and
+// a flag given at its default value is the same as leaving it out. The flag
+// values rather than the API filter are used because the filter holds
+// absolute times derived from relative ones like --newer-than 2_weeks_ago.
+func sinceKey(cmd *cobra.Command) (key, filter string) {
+	var parts []string
+	cmd.LocalFlags().VisitAll(func(f *pflag.Flag) {
+		if !sinceIgnoredFlags[f.Name] && f.Value.String() != f.DefValue {
+			parts = append(parts, f.Name+"="+f.Value.String())
+		}
+	})
+	sort.Strings(parts)
+	filter = strings.Join(parts, " ")
+	sum := sha256.Sum256([]byte(cmd.CommandPath() + "\n" + filter))
+	return hex.EncodeToString(sum[:8]), filter
+}
+
+func loadSinceMarkers() (map[string]sinceMarker, error) {
+	path, err := sinceStatePath()
+	if err != nil {
+		return nil, err
+	}
+	markers := map[string]sinceMarker{}
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return markers, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &markers); err != nil {
+		return nil, fmt.Errorf("cannot read %s: %w", path, err)
+	}
+	return markers, nil
+}
+
+func saveSinceMarkers(markers map[string]sinceMarker) error {
+	path, err := sinceStatePath()
+	if err != nil {
+		return err
+	}
+	data, err := json.MarshalIndent(markers, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0600)
+}
+
+// startSinceRun handles --reset-since and --since-last-run. It returns nil
+// when --since-last-run is not set; otherwise the run's updatedAt lower
+// bound, if a previous run stored one, is in since.
+func startSinceRun(cmd *cobra.Command) (*sinceRun, error) {
+	reset, _ := cmd.Flags().GetBool("reset-since")
+	enabled, _ := cmd.Flags().GetBool("since-last-run")
+	if !reset && !enabled {
+		return nil, nil
+	}
+	key, filter := sinceKey(cmd)
+	markers, err := loadSinceMarkers()
+	if err != nil {
+		return nil, err
+	}
+	if reset {
+		delete(markers, key)
+		if err := saveSinceMarkers(markers); err != nil {
+			return nil, err
+		}
+	}
+	if !enabled {
+		return nil, nil
+	}
+	run := &sinceRun{key: key, marker: sinceMarker{Command: cmd.CommandPath(), Filter: filter}}
+	if previous, ok := markers[key]; ok {
+		run.since = previous.UpdatedAt
+		run.marker.UpdatedAt = previous.UpdatedAt
+	}
+	return run, nil
+}
+
+// applyTo narrows filter to items updated after the previous run.
+func (r *sinceRun) applyTo(filter map[string]interface{}) {
+	if r.since.IsZero() {
+		return
+	}
+	updatedAt, _ := filter["updatedAt"].(map[string]interface{})
+	if updatedAt == nil {
+		updatedAt = map[string]interface{}{}
+	}
+	updatedAt["gt"] = r.since.UTC().Format(time.RFC3339Nano)
+	filter["updatedAt"] = updatedAt
+}
+
+// finish stores the newest updatedAt seen. A truncated result keeps the old
+// marker: the items beyond --limit have not been seen yet.
+func (r *sinceRun) finish(updated []time.Time, truncated bool) error {
+	if truncated {
+		return fmt.Errorf("more items matched than --limit allows; the --since-last-run marker was not advanced (raise --limit or use --limit 0)")
+	}
+	for _, t := range updated {
+		if t.After(r.marker.UpdatedAt) {
+			r.marker.UpdatedAt = t
+		}
+	}
+	if r.marker.UpdatedAt.IsZero() {
+		return nil
+	}
+	markers, err := loadSinceMarkers()
+	if err != nil {
+		return err
+	}
+	markers[r.key] = r.marker
+	return saveSinceMarkers(markers)
+}
+
+// finishSinceRun records the run, warning on stderr when the marker could not
+// be advanced; the listing itself has already been printed.
+func finishSinceRun(r *sinceRun, updated []time.Time, truncated bool) {
+	if r == nil {
+		return
+	}
+	if err := r.finish(updated, truncated); err != nil {
+		output.Hint(err.Error())
+	}
+}
+
+// addSinceFlags registers --since-last-run and --reset-since on cmd.
+func addSinceFlags(cmd *cobra.Command, noun string) {
+	cmd.Flags().Bool("since-last-run", false, "Only "+noun+" updated since the last --since-last-run with the same filters (markers are kept in ~/.linctl-state.json)")
+	cmd.Flags().Bool("reset-since", false, "Forget the stored --since-last-run marker for these filters")
+}
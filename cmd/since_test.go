@@ -0,0 +1,114 @@
+package cmd
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func withSinceState(t *testing.T) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "state.json")
+	orig := sinceStatePath
+	sinceStatePath = func() (string, error) { return path, nil }
+	t.Cleanup(func() { sinceStatePath = orig })
+}
+
+func TestIssueList_SinceLastRun(t *testing.T) {
+	withSinceState(t)
+	var filter map[string]any
+	nodes := []any{
+		map[string]any{"id": "1", "identifier": "ENG-1", "updatedAt": "2025-03-02T10:00:00Z"},
+		map[string]any{"id": "2", "identifier": "ENG-2", "updatedAt": "2025-03-04T12:30:00Z"},
+	}
+	withIssueMockServer(t, func(query string, v map[string]any) any {
+		if strings.Contains(query, "query Issues(") {
+			filter, _ = v["filter"].(map[string]any)
+		}
+		return map[string]any{"issues": map[string]any{"nodes": nodes}}
+	})
+	resetFlags(t, issueListCmd)
+	t.Cleanup(func() { resetFlags(t, issueListCmd) })
+	viper.Set("json", true)
+	_ = issueListCmd.Flags().Set("since-last-run", "true")
+	_ = issueListCmd.Flags().Set("team", "ENG")
+	run := func() { captureStdout(t, func() { issueListCmd.Run(issueListCmd, nil) }) }
+
+	run()
+	if _, ok := filter["updatedAt"]; ok {
+		t.Fatalf("the first run must fetch everything, got %v", filter["updatedAt"])
+	}
+
+	nodes = []any{}
+	run()
+	updated, _ := filter["updatedAt"].(map[string]any)
+	if updated["gt"] != "2025-03-04T12:30:00Z" {
+		t.Fatalf("expected updatedAt.gt from the newest issue of the last run, got %v", filter["updatedAt"])
+	}
+
+	// Other filters keep their own marker
+	_ = issueListCmd.Flags().Set("team", "OPS")
+	run()
+	if _, ok := filter["updatedAt"]; ok {
+		t.Fatalf("a different --team must not reuse ENG's marker, got %v", filter["updatedAt"])
+	}
+
+	_ = issueListCmd.Flags().Set("team", "ENG")
+	_ = issueListCmd.Flags().Set("reset-since", "true")
+	run()
+	if _, ok := filter["updatedAt"]; ok {
+		t.Fatalf("--reset-since must clear the marker, got %v", filter["updatedAt"])
+	}
+}
+
+func TestSinceRun_TruncatedKeepsMarker(t *testing.T) {
+	withSinceState(t)
+	resetFlags(t, projectListCmd)
+	t.Cleanup(func() { resetFlags(t, projectListCmd) })
+	_ = projectListCmd.Flags().Set("since-last-run", "true")
+
+	run, err := startSinceRun(projectListCmd)
+	if err != nil || run == nil {
+		t.Fatalf("startSinceRun: %v, %v", run, err)
+	}
+	if err := run.finish(nil, true); err == nil || !strings.Contains(err.Error(), "--limit") {
+		t.Fatalf("expected a truncated run to be reported, got %v", err)
+	}
+	run, _ = startSinceRun(projectListCmd)
+	if !run.since.IsZero() {
+		t.Fatalf("a truncated run must not store a marker, got %v", run.since)
+	}
+}
+
+func TestSinceKey_UsesEffectiveFilterValues(t *testing.T) {
+	issueListCmd.InheritedFlags() // merge the global flags, as parsing does
+	resetFlags(t, issueListCmd)
+	t.Cleanup(func() { resetFlags(t, issueListCmd) })
+
+	_ = issueListCmd.Flags().Set("team", "ENG")
+	typed, filter := sinceKey(issueListCmd)
+	if filter != "team=ENG" {
+		t.Fatalf("filter = %q, want team=ENG", filter)
+	}
+
+	// Output and other global flags don't start a separate marker
+	_ = issueListCmd.Flags().Set("json", "true")
+	_ = issueListCmd.Flags().Set("debug", "true")
+	if key, _ := sinceKey(issueListCmd); key != typed {
+		t.Fatalf("global flags changed the key: %q", filter)
+	}
+
+	// A team filled in from defaults.team or --from-branch isn't marked as
+	// changed, but it is the same filter
+	resetFlags(t, issueListCmd)
+	_ = issueListCmd.Flags().Lookup("team").Value.Set("ENG")
+	if key, _ := sinceKey(issueListCmd); key != typed {
+		t.Fatal("a defaulted --team must share the typed --team's marker")
+	}
+	_ = issueListCmd.Flags().Lookup("team").Value.Set("OPS")
+	if key, _ := sinceKey(issueListCmd); key == typed {
+		t.Fatal("a different default team must not reuse ENG's marker")
+	}
+}
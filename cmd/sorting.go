@@ -0,0 +1,103 @@
+package cmd
+
+import (
+	"cmp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+// sortStable orders items by primary and breaks ties with tiebreak, so lists
+// sorted client-side come out the same on every run regardless of the order
+// the API returned them in.
+func sortStable[T any](items []T, primary, tiebreak func(a, b T) int) {
+	slices.SortStableFunc(items, func(a, b T) int {
+		if c := primary(a, b); c != 0 {
+			return c
+		}
+		return tiebreak(a, b)
+	})
+}
+
+// compareIssueIdentifiers orders issues by team key, then numerically by
+// issue number (ENG-9 before ENG-10), then by ID.
+func compareIssueIdentifiers(a, b api.Issue) int {
+	keyA, numA := splitIssueIdentifier(a.Identifier)
+	keyB, numB := splitIssueIdentifier(b.Identifier)
+	return cmp.Or(
+		strings.Compare(keyA, keyB),
+		cmp.Compare(numA, numB),
+		strings.Compare(a.ID, b.ID),
+	)
+}
+
+func splitIssueIdentifier(identifier string) (string, int) {
+	key, num, ok := strings.Cut(identifier, "-")
+	if !ok {
+		return identifier, 0
+	}
+	n, _ := strconv.Atoi(num)
+	return key, n
+}
+
+// compareProjectNames orders projects by name, case-insensitively, then by ID.
+func compareProjectNames(a, b api.Project) int {
+	return cmp.Or(
+		strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)),
+		strings.Compare(a.ID, b.ID),
+	)
+}
+
+// issueStateRank orders state types along the workflow; issues without a
+// state sort last.
+var issueStateRank = map[string]int{
+	"triage":    0,
+	"backlog":   1,
+	"unstarted": 2,
+	"started":   3,
+	"completed": 4,
+	"canceled":  5,
+}
+
+func stateRank(state *api.State) int {
+	if state == nil {
+		return len(issueStateRank) + 1
+	}
+	if rank, ok := issueStateRank[state.Type]; ok {
+		return rank
+	}
+	return len(issueStateRank)
+}
+
+// sortIssues applies the client-side issue sorts: "priority" puts Urgent
+// first and No priority last; "state" follows the workflow from triage to
+// canceled, then the team's state order. Ties are broken by identifier.
+func sortIssues(issues []api.Issue, by string) {
+	switch by {
+	case "priority":
+		sortStable(issues, func(a, b api.Issue) int {
+			return cmp.Compare(priorityRank(a.Priority), priorityRank(b.Priority))
+		}, compareIssueIdentifiers)
+	case "state":
+		sortStable(issues, func(a, b api.Issue) int {
+			if c := cmp.Compare(stateRank(a.State), stateRank(b.State)); c != 0 || a.State == nil || b.State == nil {
+				return c
+			}
+			return cmp.Or(
+				cmp.Compare(a.State.Position, b.State.Position),
+				strings.Compare(a.State.Name, b.State.Name),
+			)
+		}, compareIssueIdentifiers)
+	}
+}
+
+// priorityRank maps Linear's priority (0 none, 1 urgent … 4 low) to a rank
+// where none sorts after low.
+func priorityRank(p int) int {
+	if p == 0 {
+		return 5
+	}
+	return p
+}
@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func issueIdentifiers(issues []api.Issue) string {
+	ids := make([]string, len(issues))
+	for i, issue := range issues {
+		ids[i] = issue.Identifier
+	}
+	return strings.Join(ids, ",")
+}
+
+// rotations returns every rotation of items, standing in for the different
+// orders the API may return tied results in.
+func rotations[T any](items []T) [][]T {
+	var out [][]T
+	for shift := range items {
+		out = append(out, append(append([]T(nil), items[shift:]...), items[:shift]...))
+	}
+	return out
+}
+
+func TestSortIssues_PriorityTiesAreStable(t *testing.T) {
+	issues := []api.Issue{
+		{Identifier: "ENG-10", Priority: 2},
+		{Identifier: "ENG-9", Priority: 2},
+		{Identifier: "OPS-1", Priority: 1},
+		{Identifier: "ENG-3", Priority: 0},
+		{Identifier: "ENG-2", Priority: 4},
+		{Identifier: "API-7", Priority: 2},
+	}
+	want := "OPS-1,API-7,ENG-9,ENG-10,ENG-2,ENG-3"
+	for _, in := range rotations(issues) {
+		sortIssues(in, "priority")
+		if got := issueIdentifiers(in); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestSortIssues_StateFollowsWorkflow(t *testing.T) {
+	issues := []api.Issue{
+		{Identifier: "ENG-1", State: &api.State{Type: "completed", Name: "Done"}},
+		{Identifier: "ENG-2", State: &api.State{Type: "started", Name: "In Review", Position: 2}},
+		{Identifier: "ENG-3", State: &api.State{Type: "started", Name: "In Progress", Position: 1}},
+		{Identifier: "ENG-4", State: &api.State{Type: "backlog", Name: "Backlog"}},
+		{Identifier: "ENG-5", State: &api.State{Type: "started", Name: "In Progress", Position: 1}},
+		{Identifier: "ENG-6"},
+	}
+	want := "ENG-4,ENG-3,ENG-5,ENG-2,ENG-1,ENG-6"
+	for _, in := range rotations(issues) {
+		sortIssues(in, "state")
+		if got := issueIdentifiers(in); got != want {
+			t.Fatalf("got %s, want %s", got, want)
+		}
+	}
+}
+
+func TestSortProjects_TiesOrderedByName(t *testing.T) {
+	date := func(d string) *string { return &d }
+	projects := []api.Project{
+		{ID: "1", Name: "beta", Progress: 0.5, TargetDate: date("2025-06-01")},
+		{ID: "2", Name: "Alpha", Progress: 0.5},
+		{ID: "3", Name: "Gamma", Progress: 0.9, TargetDate: date("2025-06-01")},
+		{ID: "4", Name: "Delta", Progress: 0.5},
+	}
+	names := func(ps []api.Project) string {
+		out := make([]string, len(ps))
+		for i, p := range ps {
+			out[i] = p.Name
+		}
+		return strings.Join(out, ",")
+	}
+	for _, in := range rotations(projects) {
+		sortProjects(in, "progress")
+		if got := names(in); got != "Gamma,Alpha,beta,Delta" {
+			t.Fatalf("progress: got %s", got)
+		}
+		sortProjects(in, "target")
+		if got := names(in); got != "beta,Gamma,Alpha,Delta" {
+			t.Fatalf("target: got %s", got)
+		}
+	}
+}
+
+func TestParseIssueSort(t *testing.T) {
+	if orderBy, clientSort, err := parseIssueSort("created"); err != nil || orderBy != "createdAt" || clientSort != "" {
+		t.Fatalf("created: %q %q %v", orderBy, clientSort, err)
+	}
+	if orderBy, clientSort, err := parseIssueSort("priority"); err != nil || orderBy != "" || clientSort != "priority" {
+		t.Fatalf("priority: %q %q %v", orderBy, clientSort, err)
+	}
+	if _, _, err := parseIssueSort("bogus"); err == nil || !strings.Contains(err.Error(), "priority, state") {
+		t.Fatalf("expected the valid options in the error, got %v", err)
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"sort"
 	"strings"
 
 	"github.com/raegislabs/linctl/pkg/api"
@@ -23,7 +24,9 @@ var teamCmd = &cobra.Command{
 Examples:
   linctl team list              # List all teams
   linctl team get ENG           # Get team details
-  linctl team members ENG       # List team members`,
+  linctl team members ENG       # List team members
+  linctl team states ENG        # List workflow states
+  linctl team cycle current ENG # Show the active cycle`,
 }
 
 var teamListCmd = &cobra.Command{
@@ -39,7 +42,7 @@ var teamListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -67,10 +70,19 @@ var teamListCmd = &cobra.Command{
 		}
 
 		// Get teams
-		teams, err := client.GetTeams(context.Background(), limit, "", orderBy)
+		progress := output.NewProgress(plaintext, jsonOut)
+		nodes, hasMore, err := fetchPages(progress, limit, func(first int, after string) ([]api.Team, api.PageInfo, error) {
+			page, err := client.GetTeams(context.Background(), first, after, orderBy)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		progress.Stop()
+		teams := &api.Teams{Nodes: nodes, PageInfo: api.PageInfo{HasNextPage: hasMore}}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list teams: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -124,9 +136,7 @@ var teamListCmd = &cobra.Command{
 			}, plaintext, jsonOut)
 
 			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d teams\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(teams.Nodes))
+				output.Summary("%d teams", len(teams.Nodes))
 			}
 		}
 	},
@@ -147,7 +157,7 @@ var teamGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -157,7 +167,7 @@ var teamGetCmd = &cobra.Command{
 		team, err := client.GetTeam(context.Background(), teamKey)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -200,7 +210,11 @@ var teamGetCmd = &cobra.Command{
 var teamMembersCmd = &cobra.Command{
 	Use:   "members TEAM-KEY",
 	Short: "List team members",
-	Long:  `List all members of a specific team.`,
+	Long: `List all members of a specific team.
+
+Examples:
+  linctl team members ENG
+  linctl team members ENG --active --json`,
 	Args:  cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		plaintext := viper.GetBool("plaintext")
@@ -211,21 +225,36 @@ var teamMembersCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
 		client := api.NewClient(authHeader)
 
-		// Get team members
-		members, err := client.GetTeamMembers(context.Background(), teamKey)
+		// Get team members, following pagination for large teams
+		progress := output.NewProgress(plaintext, jsonOut)
+		nodes, _, err := fetchAllPages(progress, func(first int, after string) ([]api.User, api.PageInfo, error) {
+			page, err := client.GetTeamMembers(context.Background(), teamKey, first, after)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		progress.Stop()
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get team members: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
+		}
+		members := &api.Users{Nodes: nodes}
+		if activeOnly, _ := cmd.Flags().GetBool("active"); activeOnly {
+			members.Nodes = filterActiveUsers(members.Nodes)
 		}
 
 		// Handle output
 		if jsonOut {
+			if members.Nodes == nil {
+				members.Nodes = []api.User{}
+			}
 			output.JSON(members.Nodes)
 		} else if plaintext {
 			fmt.Println("Name\tEmail\tRole\tActive")
@@ -277,11 +306,81 @@ var teamMembersCmd = &cobra.Command{
 			}, plaintext, jsonOut)
 
 			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d members in team %s\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(members.Nodes),
-					color.New(color.FgCyan).Sprint(teamKey))
+				output.Summary("%d members in team %s", len(members.Nodes), color.New(color.FgCyan).Sprint(teamKey))
+			}
+		}
+	},
+}
+
+// filterActiveUsers drops deactivated users.
+func filterActiveUsers(users []api.User) []api.User {
+	active := make([]api.User, 0, len(users))
+	for _, u := range users {
+		if u.Active {
+			active = append(active, u)
+		}
+	}
+	return active
+}
+
+var teamStatesCmd = &cobra.Command{
+	Use:   "states TEAM-KEY",
+	Short: "List workflow states for a team",
+	Long: `List the workflow states of a team in board order.
+
+Use the exact names shown here with 'issue update --state'.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		teamKey := args[0]
+
+		// Get auth header
+		authHeader, err := auth.GetAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		// Create API client
+		client := api.NewClient(authHeader)
+
+		states, err := client.GetTeamStates(context.Background(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get team states: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		sort.SliceStable(states, func(i, j int) bool {
+			return states[i].Position < states[j].Position
+		})
+
+		// Handle output
+		if jsonOut {
+			output.JSON(states)
+		} else if plaintext {
+			fmt.Println("Name\tType\tPosition")
+			for _, state := range states {
+				fmt.Printf("%s\t%s\t%g\n", state.Name, state.Type, state.Position)
 			}
+		} else {
+			headers := []string{"Name", "Type", "Position"}
+			rows := [][]string{}
+			for _, state := range states {
+				stateColor := stateTypeColor(state.Type)
+				rows = append(rows, []string{
+					stateColor.Sprint(state.Name),
+					stateColor.Sprint(state.Type),
+					fmt.Sprintf("%g", state.Position),
+				})
+			}
+
+			output.Table(output.TableData{
+				Headers: headers,
+				Rows:    rows,
+			}, plaintext, jsonOut)
+
+			output.Summary("%d states in team %s", len(states), color.New(color.FgCyan).Sprint(teamKey))
 		}
 	},
 }
@@ -291,8 +390,12 @@ func init() {
 	teamCmd.AddCommand(teamListCmd)
 	teamCmd.AddCommand(teamGetCmd)
 	teamCmd.AddCommand(teamMembersCmd)
+	teamCmd.AddCommand(teamStatesCmd)
 
 	// List command flags
 	teamListCmd.Flags().IntP("limit", "l", 50, "Maximum number of teams to return")
 	teamListCmd.Flags().StringP("sort", "o", "linear", "Sort order: linear (default), created, updated")
+
+	// Team members flags
+	teamMembersCmd.Flags().Bool("active", false, "Only show active (non-deactivated) members")
 }
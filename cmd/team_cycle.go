@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/raegislabs/linctl/pkg/api"
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var teamCycleCmd = &cobra.Command{
+	Use:   "cycle",
+	Short: "Work with a team's cycles",
+	Long: `Work with a team's cycles (sprints).
+
+Examples:
+  linctl team cycle current ENG
+  echo "Sprint $(linctl team cycle current ENG --print-number)"`,
+}
+
+var teamCycleCurrentCmd = &cobra.Command{
+	Use:   "current TEAM-KEY",
+	Short: "Show the team's active cycle",
+	Long: `Show the cycle that is active for a team today, as scheduled in Linear.
+
+--print-number prints only the cycle number, for use in scripts and with
+--cycle. Exits with an error when the team has no active cycle.
+
+Examples:
+  linctl team cycle current ENG
+  linctl team cycle current ENG --json
+  linctl team cycle current ENG --print-number`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+		teamKey := args[0]
+
+		authHeader, err := getIssueAuthHeader()
+		if err != nil {
+			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+
+		client := newIssueClient(authHeader)
+		cycle, err := client.GetTeamActiveCycle(context.Background(), teamKey)
+		if err != nil {
+			output.Error(fmt.Sprintf("Failed to get active cycle for team %s: %v", teamKey, err), plaintext, jsonOut)
+			os.Exit(exitCode(err))
+		}
+		if cycle == nil {
+			output.Error(fmt.Sprintf("Team %s has no active cycle", teamKey), plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		if printNumber, _ := cmd.Flags().GetBool("print-number"); printNumber {
+			fmt.Println(cycle.Number)
+			return
+		}
+
+		if jsonOut {
+			output.JSON(cycle)
+		} else if plaintext {
+			fmt.Printf("Number: %d\n", cycle.Number)
+			fmt.Printf("Name: %s\n", cycleDisplayName(cycle))
+			fmt.Printf("Starts: %s\n", cycle.StartsAt)
+			fmt.Printf("Ends: %s\n", cycle.EndsAt)
+			fmt.Printf("Progress: %.0f%%\n", cycle.Progress*100)
+		} else {
+			fmt.Printf("%s %s %s\n",
+				output.Color(output.RoleHeading).Sprint("🔄 Cycle:"),
+				output.Color(output.RoleAccent).Sprint(cycleDisplayName(cycle)),
+				output.Color(output.RoleMuted).Sprintf("(%s)", teamKey))
+			fmt.Printf("%s %s → %s\n", output.Color(output.RoleLabel).Sprint("Period:"), cycle.StartsAt, cycle.EndsAt)
+			fmt.Printf("%s %.0f%%\n", output.Color(output.RoleLabel).Sprint("Progress:"), cycle.Progress*100)
+		}
+	},
+}
+
+// cycleDisplayName returns a cycle's name, or "Cycle N" for unnamed cycles.
+func cycleDisplayName(cycle *api.Cycle) string {
+	if cycle.Name != "" {
+		return cycle.Name
+	}
+	return fmt.Sprintf("Cycle %d", cycle.Number)
+}
+
+func init() {
+	teamCmd.AddCommand(teamCycleCmd)
+	teamCycleCmd.AddCommand(teamCycleCurrentCmd)
+
+	teamCycleCurrentCmd.Flags().Bool("print-number", false, "Print only the cycle number (e.g. to pass to --cycle)")
+}
@@ -0,0 +1,45 @@
+package cmd
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/spf13/viper"
+)
+
+func TestTeamCycleCurrent(t *testing.T) {
+	var teamKey any
+	withIssueMockServer(t, func(query string, vars map[string]any) any {
+		if strings.Contains(query, "TeamActiveCycle") {
+			teamKey = vars["key"]
+			return map[string]any{"team": map[string]any{"activeCycle": map[string]any{
+				"id": "cycle-8", "number": 8, "startsAt": "2025-03-03", "endsAt": "2025-03-17", "progress": 0.4,
+			}}}
+		}
+		return map[string]any{}
+	})
+	resetFlags(t, teamCycleCurrentCmd)
+	t.Cleanup(func() { resetFlags(t, teamCycleCurrentCmd) })
+
+	_ = teamCycleCurrentCmd.Flags().Set("print-number", "true")
+	out := captureStdout(t, func() { teamCycleCurrentCmd.Run(teamCycleCurrentCmd, []string{"ENG"}) })
+	if out != "8\n" || teamKey != "ENG" {
+		t.Fatalf("expected a bare cycle number for ENG, got %q (team %v)", out, teamKey)
+	}
+
+	resetFlags(t, teamCycleCurrentCmd)
+	viper.Set("plaintext", true)
+	out = captureStdout(t, func() { teamCycleCurrentCmd.Run(teamCycleCurrentCmd, []string{"ENG"}) })
+	for _, want := range []string{"Number: 8", "Name: Cycle 8", "Ends: 2025-03-17", "Progress: 40%"} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("plaintext output missing %q:\n%s", want, out)
+		}
+	}
+
+	viper.Set("plaintext", false)
+	viper.Set("json", true)
+	out = captureStdout(t, func() { teamCycleCurrentCmd.Run(teamCycleCurrentCmd, []string{"ENG"}) })
+	if !strings.Contains(out, `"number": 8`) {
+		t.Fatalf("expected the cycle as JSON, got %s", out)
+	}
+}
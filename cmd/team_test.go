@@ -0,0 +1,22 @@
+package cmd
+
+import (
+	"testing"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+func TestFilterActiveUsers(t *testing.T) {
+	users := []api.User{
+		{Name: "Ada", Active: true},
+		{Name: "Bob", Active: false},
+		{Name: "Cy", Active: true},
+	}
+	got := filterActiveUsers(users)
+	if len(got) != 2 || got[0].Name != "Ada" || got[1].Name != "Cy" {
+		t.Fatalf("unexpected active users: %+v", got)
+	}
+	if got := filterActiveUsers(nil); got == nil || len(got) != 0 {
+		t.Fatalf("expected an empty, non-nil slice, got %#v", got)
+	}
+}
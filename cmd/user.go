@@ -39,7 +39,7 @@ var userListCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -68,10 +68,19 @@ var userListCmd = &cobra.Command{
 		}
 
 		// Get users
-		users, err := client.GetUsers(context.Background(), limit, "", orderBy)
+		progress := output.NewProgress(plaintext, jsonOut)
+		nodes, hasMore, err := fetchPages(progress, limit, func(first int, after string) ([]api.User, api.PageInfo, error) {
+			page, err := client.GetUsers(context.Background(), first, after, orderBy)
+			if err != nil {
+				return nil, api.PageInfo{}, err
+			}
+			return page.Nodes, page.PageInfo, nil
+		})
+		progress.Stop()
+		users := &api.Users{Nodes: nodes, PageInfo: api.PageInfo{HasNextPage: hasMore}}
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to list users: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Filter active users if requested
@@ -139,9 +148,7 @@ var userListCmd = &cobra.Command{
 			}, plaintext, jsonOut)
 
 			if !plaintext && !jsonOut {
-				fmt.Printf("\n%s %d users\n",
-					color.New(color.FgGreen).Sprint("✓"),
-					len(filteredUsers))
+				output.Summary("%d users", len(filteredUsers))
 			}
 		}
 	},
@@ -162,7 +169,7 @@ var userGetCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -172,7 +179,7 @@ var userGetCmd = &cobra.Command{
 		user, err := client.GetUser(context.Background(), email)
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
@@ -238,7 +245,7 @@ var userMeCmd = &cobra.Command{
 		authHeader, err := auth.GetAuthHeader()
 		if err != nil {
 			output.Error(fmt.Sprintf("Authentication failed: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Create API client
@@ -248,7 +255,7 @@ var userMeCmd = &cobra.Command{
 		user, err := client.GetViewer(context.Background())
 		if err != nil {
 			output.Error(fmt.Sprintf("Failed to get current user: %v", err), plaintext, jsonOut)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		// Handle output
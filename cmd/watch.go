@@ -0,0 +1,63 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+// minWatchInterval keeps --watch from spending the API budget on polling
+// faster than anyone can read the output.
+const minWatchInterval = 2 * time.Second
+
+// issueSetDigest hashes a result set so unchanged polls can be detected.
+// Every field that can be rendered is part of the JSON, so equal digests
+// mean byte-identical output.
+func issueSetDigest(issues *api.Issues) [sha256.Size]byte {
+	data, _ := json.Marshal(issues.Nodes)
+	return sha256.Sum256(data)
+}
+
+// watchIssues calls load every interval and render only when the result set
+// differs from the one last rendered, until stop is closed (a nil stop
+// watches until the process is interrupted). An error from the first load is
+// returned; later errors go to report and the last rendered result stays up
+// until a refresh succeeds, so a network blip doesn't end the watch.
+//
+// Linear's updatedAt filter could fetch only changed issues, but issues that
+// stop matching the filter (e.g. moved to Done) would never be seen again,
+// so each poll refetches the full set and only rendering is skipped.
+func watchIssues(interval time.Duration, stop <-chan struct{}, load func() (*api.Issues, error), render func(*api.Issues), report func(error)) error {
+	var last [sha256.Size]byte
+	rendered := false
+	for {
+		issues, err := load()
+		switch {
+		case err != nil && !rendered:
+			return err
+		case err != nil:
+			report(err)
+		default:
+			if digest := issueSetDigest(issues); !rendered || digest != last {
+				render(issues)
+				last, rendered = digest, true
+			}
+		}
+		select {
+		case <-stop:
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
+
+// validateWatchInterval rejects --watch intervals below minWatchInterval.
+func validateWatchInterval(interval time.Duration) error {
+	if interval < 0 || (interval > 0 && interval < minWatchInterval) {
+		return fmt.Errorf("--watch interval must be at least %s, got %s", minWatchInterval, interval)
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package cmd
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"os/exec"
+	"os/signal"
+	"runtime"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/output"
+	"github.com/fatih/color"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// webhookSignatureHeader carries the hex HMAC-SHA256 of the raw request body.
+const webhookSignatureHeader = "Linear-Signature"
+
+// webhookTimestampTolerance is how far webhookTimestamp may drift from the
+// local clock before a delivery is rejected as a possible replay.
+const webhookTimestampTolerance = time.Minute
+
+// webhookMaxBody bounds the size of an accepted webhook payload.
+const webhookMaxBody = 1 << 20
+
+// webhookCmd represents the webhook command
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Work with Linear webhooks",
+	Long: `Receive Linear webhook deliveries locally.
+
+Examples:
+  linctl webhook serve --port 8080 --secret "$LINEAR_WEBHOOK_SECRET"
+  linctl webhook serve --secret "$S" --exec './on-event.sh'`,
+}
+
+var webhookServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run an HTTP server that receives Linear webhooks",
+	Long: `Run an HTTP server that verifies Linear's webhook signature and prints each
+received event as a line of JSON on stdout.
+
+Every request must carry a valid Linear-Signature header (HMAC-SHA256 of the
+body using the webhook's signing secret) and a webhookTimestamp within one
+minute of the local clock. Invalid deliveries are rejected with 401.
+
+With --exec, the command is run through the shell once per event instead,
+with the event JSON on stdin and LINEAR_EVENT_TYPE / LINEAR_EVENT_ACTION set
+in its environment. The secret may also be given via LINEAR_WEBHOOK_SECRET.
+
+Press Ctrl+C to stop; in-flight deliveries are allowed to finish.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		plaintext := viper.GetBool("plaintext")
+		jsonOut := viper.GetBool("json")
+
+		port, _ := cmd.Flags().GetInt("port")
+		path, _ := cmd.Flags().GetString("path")
+		secret, _ := cmd.Flags().GetString("secret")
+		execCmd, _ := cmd.Flags().GetString("exec")
+		if secret == "" {
+			secret = os.Getenv("LINEAR_WEBHOOK_SECRET")
+		}
+		if secret == "" {
+			output.Error("A signing secret is required (--secret or LINEAR_WEBHOOK_SECRET)", plaintext, jsonOut)
+			os.Exit(1)
+		}
+
+		receiver := &webhookReceiver{
+			secret: secret,
+			exec:   execCmd,
+			out:    os.Stdout,
+			now:    time.Now,
+		}
+		mux := http.NewServeMux()
+		mux.Handle(path, receiver)
+		srv := &http.Server{
+			Addr:              fmt.Sprintf(":%d", port),
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer stop()
+
+		errCh := make(chan error, 1)
+		go func() { errCh <- srv.ListenAndServe() }()
+
+		if !plaintext && !jsonOut {
+			fmt.Fprintf(os.Stderr, "%s Listening for Linear webhooks on %s%s\n",
+				color.New(color.FgGreen).Sprint("✓"), srv.Addr, path)
+		}
+
+		select {
+		case err := <-errCh:
+			if err != nil && !errors.Is(err, http.ErrServerClosed) {
+				output.Error(fmt.Sprintf("Webhook server failed: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+		case <-ctx.Done():
+			shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+			defer cancel()
+			if err := srv.Shutdown(shutdownCtx); err != nil {
+				output.Error(fmt.Sprintf("Failed to shut down webhook server: %v", err), plaintext, jsonOut)
+				os.Exit(exitCode(err))
+			}
+			if !plaintext && !jsonOut {
+				fmt.Fprintln(os.Stderr, "Webhook server stopped")
+			}
+		}
+	},
+}
+
+// webhookReceiver verifies and dispatches Linear webhook deliveries.
+type webhookReceiver struct {
+	secret string
+	exec   string
+	now    func() time.Time
+
+	mu  sync.Mutex // serializes writes to out and --exec runs
+	out io.Writer
+}
+
+func (wr *webhookReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.Header().Set("Allow", http.MethodPost)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	body, err := io.ReadAll(io.LimitReader(r.Body, webhookMaxBody+1))
+	if err != nil {
+		http.Error(w, "failed to read body", http.StatusBadRequest)
+		return
+	}
+	if len(body) > webhookMaxBody {
+		http.Error(w, "payload too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	if !verifyWebhookSignature(wr.secret, body, r.Header.Get(webhookSignatureHeader)) {
+		http.Error(w, "invalid signature", http.StatusUnauthorized)
+		return
+	}
+
+	var event map[string]interface{}
+	if err := json.Unmarshal(body, &event); err != nil {
+		http.Error(w, "invalid JSON payload", http.StatusBadRequest)
+		return
+	}
+	if err := checkWebhookTimestamp(event, wr.now()); err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	if err := wr.dispatch(r.Context(), event, body); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		http.Error(w, "event handler failed", http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// dispatch prints the event as one compact JSON line, or runs --exec with the
+// event on stdin.
+func (wr *webhookReceiver) dispatch(ctx context.Context, event map[string]interface{}, body []byte) error {
+	wr.mu.Lock()
+	defer wr.mu.Unlock()
+
+	var compact bytes.Buffer
+	if err := json.Compact(&compact, body); err != nil {
+		return err
+	}
+
+	if wr.exec == "" {
+		compact.WriteByte('\n')
+		_, err := wr.out.Write(compact.Bytes())
+		return err
+	}
+
+	shell, flag := "sh", "-c"
+	if runtime.GOOS == "windows" {
+		shell, flag = "cmd", "/C"
+	}
+	c := exec.CommandContext(ctx, shell, flag, wr.exec)
+	c.Stdin = &compact
+	c.Stdout = wr.out
+	c.Stderr = os.Stderr
+	c.Env = append(os.Environ(),
+		"LINEAR_EVENT_TYPE="+fmt.Sprint(event["type"]),
+		"LINEAR_EVENT_ACTION="+fmt.Sprint(event["action"]),
+	)
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("--exec command failed: %w", err)
+	}
+	return nil
+}
+
+// verifyWebhookSignature reports whether signature is the hex-encoded
+// HMAC-SHA256 of body keyed with secret, compared in constant time.
+func verifyWebhookSignature(secret string, body []byte, signature string) bool {
+	if secret == "" || signature == "" {
+		return false
+	}
+	got, err := hex.DecodeString(signature)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(got, mac.Sum(nil))
+}
+
+// checkWebhookTimestamp rejects deliveries whose webhookTimestamp (Unix
+// milliseconds) is missing or outside webhookTimestampTolerance of now.
+func checkWebhookTimestamp(event map[string]interface{}, now time.Time) error {
+	ts, ok := event["webhookTimestamp"].(float64)
+	if !ok {
+		return errors.New("missing webhookTimestamp")
+	}
+	sent := time.UnixMilli(int64(ts))
+	if d := now.Sub(sent); d > webhookTimestampTolerance || d < -webhookTimestampTolerance {
+		return errors.New("webhookTimestamp outside allowed window")
+	}
+	return nil
+}
+
+func init() {
+	rootCmd.AddCommand(webhookCmd)
+	webhookCmd.AddCommand(webhookServeCmd)
+
+	webhookServeCmd.Flags().Int("port", 8080, "Port to listen on")
+	webhookServeCmd.Flags().String("path", "/", "URL path to receive webhooks on")
+	webhookServeCmd.Flags().String("secret", "", "Webhook signing secret (default $LINEAR_WEBHOOK_SECRET)")
+	webhookServeCmd.Flags().String("exec", "", "Shell command to run per event, with the event JSON on stdin")
+}
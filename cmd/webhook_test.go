@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func signWebhook(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newTestReceiver(now time.Time, execCmd string) (*webhookReceiver, *bytes.Buffer) {
+	var out bytes.Buffer
+	return &webhookReceiver{
+		secret: "s3cret",
+		exec:   execCmd,
+		out:    &out,
+		now:    func() time.Time { return now },
+	}, &out
+}
+
+func deliver(t *testing.T, wr *webhookReceiver, body []byte, signature string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(body))
+	if signature != "" {
+		req.Header.Set(webhookSignatureHeader, signature)
+	}
+	rec := httptest.NewRecorder()
+	wr.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestVerifyWebhookSignature(t *testing.T) {
+	body := []byte(`{"action":"create"}`)
+	valid := signWebhook("s3cret", body)
+	cases := []struct {
+		name      string
+		secret    string
+		signature string
+		want      bool
+	}{
+		{name: "valid", secret: "s3cret", signature: valid, want: true},
+		{name: "wrong secret", secret: "other", signature: valid},
+		{name: "not hex", secret: "s3cret", signature: "zz"},
+		{name: "missing", secret: "s3cret", signature: ""},
+		{name: "empty secret", secret: "", signature: valid},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := verifyWebhookSignature(tc.secret, body, tc.signature); got != tc.want {
+				t.Fatalf("verifyWebhookSignature = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestWebhookReceiver_PrintsVerifiedEvent(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	wr, out := newTestReceiver(now, "")
+	body := []byte(fmt.Sprintf(`{
+  "action": "create",
+  "type": "Issue",
+  "webhookTimestamp": %d
+}`, now.UnixMilli()))
+
+	rec := deliver(t, wr, body, signWebhook("s3cret", body))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	want := fmt.Sprintf(`{"action":"create","type":"Issue","webhookTimestamp":%d}`+"\n", now.UnixMilli())
+	if out.String() != want {
+		t.Fatalf("unexpected event output:\n%q\nwant:\n%q", out.String(), want)
+	}
+}
+
+func TestWebhookReceiver_RejectsBadDeliveries(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	fresh := []byte(fmt.Sprintf(`{"type":"Issue","webhookTimestamp":%d}`, now.UnixMilli()))
+	stale := []byte(fmt.Sprintf(`{"type":"Issue","webhookTimestamp":%d}`, now.Add(-5*time.Minute).UnixMilli()))
+
+	cases := []struct {
+		name      string
+		body      []byte
+		signature string
+		want      int
+	}{
+		{name: "unsigned", body: fresh, want: http.StatusUnauthorized},
+		{name: "bad signature", body: fresh, signature: signWebhook("wrong", fresh), want: http.StatusUnauthorized},
+		{name: "replayed", body: stale, signature: signWebhook("s3cret", stale), want: http.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			wr, out := newTestReceiver(now, "")
+			if rec := deliver(t, wr, tc.body, tc.signature); rec.Code != tc.want {
+				t.Fatalf("expected %d, got %d", tc.want, rec.Code)
+			}
+			if out.Len() != 0 {
+				t.Fatalf("rejected delivery should not be printed, got %q", out.String())
+			}
+		})
+	}
+
+	wr, _ := newTestReceiver(now, "")
+	rec := httptest.NewRecorder()
+	wr.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Fatalf("expected 405 for GET, got %d", rec.Code)
+	}
+}
+
+func TestWebhookReceiver_ExecReceivesEvent(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("uses a POSIX shell")
+	}
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	wr, out := newTestReceiver(now, `printf '%s ' "$LINEAR_EVENT_TYPE" "$LINEAR_EVENT_ACTION"; cat`)
+	body := []byte(fmt.Sprintf(`{"action":"update","type":"Comment","webhookTimestamp":%d}`, now.UnixMilli()))
+
+	if rec := deliver(t, wr, body, signWebhook("s3cret", body)); rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+	if got := out.String(); !strings.HasPrefix(got, "Comment update {") {
+		t.Fatalf("unexpected exec output: %q", got)
+	}
+}
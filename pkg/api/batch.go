@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// BatchVariable is a variable used by a BatchQuery, with the GraphQL type
+// it is declared as.
+type BatchVariable struct {
+	Type  string
+	Value interface{}
+}
+
+// BatchQuery is one root field of a batched request, e.g.
+// `issue(id: $id) { id title }`. Variables are referenced by their own
+// names; BatchFetch renames them so queries cannot collide. The field's
+// value is unmarshalled into Result.
+type BatchQuery struct {
+	Field     string
+	Variables map[string]BatchVariable
+	Result    interface{}
+}
+
+var batchVariableRegexp = regexp.MustCompile(`\$(\w+)`)
+
+// BatchFetch runs independent queries as aliased fields of a single GraphQL
+// request, saving a round trip per query. The map keys are the aliases and
+// must be valid GraphQL names. If any field fails the whole batch returns
+// the error, as Execute does.
+func (c *Client) BatchFetch(ctx context.Context, queries map[string]BatchQuery) error {
+	if len(queries) == 0 {
+		return nil
+	}
+	aliases := make([]string, 0, len(queries))
+	for alias := range queries {
+		aliases = append(aliases, alias)
+	}
+	sort.Strings(aliases)
+
+	var decls, fields []string
+	variables := map[string]interface{}{}
+	for _, alias := range aliases {
+		q := queries[alias]
+		names := make([]string, 0, len(q.Variables))
+		for name := range q.Variables {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			v := q.Variables[name]
+			decls = append(decls, fmt.Sprintf("$%s_%s: %s", alias, name, v.Type))
+			variables[alias+"_"+name] = v.Value
+		}
+		field := batchVariableRegexp.ReplaceAllStringFunc(q.Field, func(ref string) string {
+			if _, ok := q.Variables[ref[1:]]; ok {
+				return "$" + alias + "_" + ref[1:]
+			}
+			return ref
+		})
+		fields = append(fields, alias+": "+strings.TrimSpace(field))
+	}
+
+	query := "query Batch"
+	if len(decls) > 0 {
+		query += "(" + strings.Join(decls, ", ") + ")"
+	}
+	query += " {\n" + strings.Join(fields, "\n") + "\n}"
+
+	var response map[string]json.RawMessage
+	if err := c.Execute(ctx, query, variables, &response); err != nil {
+		return err
+	}
+	for _, alias := range aliases {
+		q := queries[alias]
+		raw, ok := response[alias]
+		if q.Result == nil || !ok {
+			continue
+		}
+		if err := json.Unmarshal(raw, q.Result); err != nil {
+			return fmt.Errorf("failed to unmarshal %s: %w", alias, err)
+		}
+	}
+	return nil
+}
+
+// IssueQuery fetches an issue with the same fields as GetIssue.
+func IssueQuery(id string, result *Issue) BatchQuery {
+	return BatchQuery{
+		Field:     `issue(id: $id) {` + issueDetailFields + `}`,
+		Variables: map[string]BatchVariable{"id": {"String!", id}},
+		Result:    result,
+	}
+}
+
+// issueTeamStates decodes the team states nested under an issue.
+type issueTeamStates struct {
+	states *[]WorkflowState
+}
+
+func (r *issueTeamStates) UnmarshalJSON(data []byte) error {
+	var issue struct {
+		Team struct {
+			States struct {
+				Nodes []WorkflowState `json:"nodes"`
+			} `json:"states"`
+		} `json:"team"`
+	}
+	if err := json.Unmarshal(data, &issue); err != nil {
+		return err
+	}
+	*r.states = issue.Team.States.Nodes
+	return nil
+}
+
+// IssueTeamStatesQuery fetches the workflow states of an issue's team, so
+// they can be batched with the issue itself rather than waiting for its
+// team key.
+func IssueTeamStatesQuery(issueID string, result *[]WorkflowState) BatchQuery {
+	return BatchQuery{
+		Field: `issue(id: $id) {
+			team {
+				states {
+					nodes {
+						id
+						name
+						type
+						color
+						description
+						position
+					}
+				}
+			}
+		}`,
+		Variables: map[string]BatchVariable{"id": {"String!", issueID}},
+		Result:    &issueTeamStates{states: result},
+	}
+}
+
+// IssueLabelsQuery fetches the issue labels GetIssueLabels returns.
+func IssueLabelsQuery(result *Labels) BatchQuery {
+	return BatchQuery{
+		Field: `issueLabels {
+			nodes {
+				id
+				name
+				color
+				description
+			}
+		}`,
+		Result: result,
+	}
+}
@@ -0,0 +1,74 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBatchFetch_SingleRequest(t *testing.T) {
+	requests := 0
+	var got GraphQLRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		_ = json.NewDecoder(r.Body).Decode(&got)
+		_, _ = w.Write([]byte(`{"data":{
+			"issue": {"id": "issue-1", "identifier": "ENG-7", "team": {"key": "ENG"}},
+			"labels": {"nodes": [{"id": "l-1", "name": "bug"}]},
+			"states": {"team": {"states": {"nodes": [{"id": "st-1", "name": "Todo", "type": "unstarted"}]}}}
+		}}`))
+	}))
+	defer srv.Close()
+
+	var issue Issue
+	var states []WorkflowState
+	var labels Labels
+	err := NewClientWithURL(srv.URL, "lin_api_test").BatchFetch(context.Background(), map[string]BatchQuery{
+		"issue":  IssueQuery("ENG-7", &issue),
+		"states": IssueTeamStatesQuery("ENG-7", &states),
+		"labels": IssueLabelsQuery(&labels),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if requests != 1 {
+		t.Fatalf("expected one HTTP request, got %d", requests)
+	}
+
+	for _, want := range []string{"query Batch($issue_id: String!, $states_id: String!)", "issue: issue(id: $issue_id)", "states: issue(id: $states_id)", "labels: issueLabels"} {
+		if !strings.Contains(got.Query, want) {
+			t.Errorf("query missing %q:\n%s", want, got.Query)
+		}
+	}
+	if got.Variables["issue_id"] != "ENG-7" || got.Variables["states_id"] != "ENG-7" {
+		t.Errorf("unexpected variables: %v", got.Variables)
+	}
+
+	if issue.Identifier != "ENG-7" || issue.Team == nil || issue.Team.Key != "ENG" {
+		t.Errorf("issue not decoded: %+v", issue)
+	}
+	if len(states) != 1 || states[0].Name != "Todo" {
+		t.Errorf("states not decoded: %+v", states)
+	}
+	if len(labels.Nodes) != 1 || labels.Nodes[0].Name != "bug" {
+		t.Errorf("labels not decoded: %+v", labels)
+	}
+}
+
+func TestBatchFetch_ReturnsFieldErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"data":null,"errors":[{"message":"Entity not found: Issue","path":["issue"]}]}`))
+	}))
+	defer srv.Close()
+
+	var issue Issue
+	err := NewClientWithURL(srv.URL, "lin_api_test").BatchFetch(context.Background(), map[string]BatchQuery{
+		"issue": IssueQuery("ENG-404", &issue),
+	})
+	if err == nil || !strings.Contains(err.Error(), "Entity not found") {
+		t.Fatalf("expected the field error, got %v", err)
+	}
+}
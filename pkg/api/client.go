@@ -7,6 +7,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"regexp"
+	"strings"
 	"time"
 )
 
@@ -14,6 +16,14 @@ const (
 	BaseURL = "https://api.linear.app/graphql"
 )
 
+// debugWriter receives request/response dumps when debug logging is enabled.
+var debugWriter io.Writer
+
+// SetDebug enables GraphQL request/response logging to w. Pass nil to disable.
+func SetDebug(w io.Writer) {
+	debugWriter = w
+}
+
 type Client struct {
 	httpClient *http.Client
 	authHeader string
@@ -31,9 +41,36 @@ type GraphQLResponse struct {
 }
 
 type GraphQLError struct {
-	Message   string                 `json:"message"`
-	Locations []GraphQLErrorLocation `json:"locations,omitempty"`
-	Path      []interface{}          `json:"path,omitempty"`
+	Message    string                  `json:"message"`
+	Locations  []GraphQLErrorLocation  `json:"locations,omitempty"`
+	Path       []interface{}           `json:"path,omitempty"`
+	Extensions *GraphQLErrorExtensions `json:"extensions,omitempty"`
+}
+
+// GraphQLErrorExtensions holds the details Linear attaches to an error. For
+// rejected input, UserPresentableMessage explains the problem and
+// InvalidArgs names the offending fields when Linear knows them.
+type GraphQLErrorExtensions struct {
+	Code                   string   `json:"code,omitempty"`
+	Type                   string   `json:"type,omitempty"`
+	UserError              bool     `json:"userError,omitempty"`
+	UserPresentableMessage string   `json:"userPresentableMessage,omitempty"`
+	InvalidArgs            []string `json:"invalidArgs,omitempty"`
+}
+
+// GraphQLErrors is returned by Execute when the response carries errors, so
+// callers can inspect them with errors.As.
+type GraphQLErrors []GraphQLError
+
+func (e GraphQLErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, gqlErr := range e {
+		msgs[i] = gqlErr.Message
+		if ext := gqlErr.Extensions; ext != nil && ext.UserPresentableMessage != "" && ext.UserPresentableMessage != gqlErr.Message {
+			msgs[i] += " (" + ext.UserPresentableMessage + ")"
+		}
+	}
+	return "GraphQL errors: " + strings.Join(msgs, "; ")
 }
 
 type GraphQLErrorLocation struct {
@@ -50,15 +87,24 @@ func NewClient(authHeader string) *Client {
 func NewClientWithURL(baseURL, authHeader string) *Client {
 	return &Client{
 		httpClient: &http.Client{
-			Timeout: 30 * time.Second,
+			Timeout:   30 * time.Second,
+			Transport: NewTransport(),
 		},
 		authHeader: authHeader,
 		baseURL:    baseURL,
 	}
 }
 
-// Execute performs a GraphQL request
+// Execute performs a GraphQL request. Any error returned has the client's
+// credentials scrubbed so it is safe to print.
 func (c *Client) Execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
+	if err := c.execute(ctx, query, variables, result); err != nil {
+		return c.redactError(err)
+	}
+	return nil
+}
+
+func (c *Client) execute(ctx context.Context, query string, variables map[string]interface{}, result interface{}) error {
 	reqBody := GraphQLRequest{
 		Query:     query,
 		Variables: variables,
@@ -78,8 +124,15 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 	req.Header.Set("Authorization", c.authHeader)
 	req.Header.Set("User-Agent", "linctl/0.1.0")
 
+	c.debugf("POST %s\nAuthorization: [REDACTED]\nQuery:\n%s\nVariables: %s", c.baseURL, strings.TrimSpace(query), debugJSON(variables))
+
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.debugf("Request error after %s: %v", time.Since(start).Round(time.Millisecond), err)
+		if netErr := asNetworkError(c.baseURL, err); netErr != nil {
+			return netErr
+		}
 		return fmt.Errorf("request failed: %w", err)
 	}
 	defer func() { _ = resp.Body.Close() }()
@@ -89,6 +142,8 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 		return fmt.Errorf("failed to read response: %w", err)
 	}
 
+	c.debugf("Response %d in %s:\n%s", resp.StatusCode, time.Since(start).Round(time.Millisecond), string(body))
+
 	if resp.StatusCode != http.StatusOK {
 		return fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(body))
 	}
@@ -99,7 +154,7 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 	}
 
 	if len(gqlResp.Errors) > 0 {
-		return fmt.Errorf("GraphQL errors: %v", gqlResp.Errors)
+		return GraphQLErrors(gqlResp.Errors)
 	}
 
 	if result != nil {
@@ -111,6 +166,62 @@ func (c *Client) Execute(ctx context.Context, query string, variables map[string
 	return nil
 }
 
+// debugf writes a debug line to debugWriter, redacting the auth credentials.
+func (c *Client) debugf(format string, args ...interface{}) {
+	if debugWriter == nil {
+		return
+	}
+	msg := c.redact(fmt.Sprintf(format, args...))
+	for _, line := range strings.Split(msg, "\n") {
+		fmt.Fprintf(debugWriter, "[debug] %s\n", line)
+	}
+}
+
+// urlCredentialsRegexp matches user:password@ segments embedded in URLs.
+var urlCredentialsRegexp = regexp.MustCompile(`(://)[^/@\s]+@`)
+
+// redact replaces any occurrence of the auth header, bare API key or URL
+// credentials in s.
+func (c *Client) redact(s string) string {
+	for _, secret := range []string{c.authHeader, strings.TrimSpace(strings.TrimPrefix(c.authHeader, "Bearer "))} {
+		if len(secret) >= 8 {
+			s = strings.ReplaceAll(s, secret, "[REDACTED]")
+		}
+	}
+	return urlCredentialsRegexp.ReplaceAllString(s, "${1}[REDACTED]@")
+}
+
+// redactedError carries a scrubbed message while keeping the original error
+// available to errors.Is/As.
+type redactedError struct {
+	msg string
+	err error
+}
+
+func (e *redactedError) Error() string { return e.msg }
+func (e *redactedError) Unwrap() error { return e.err }
+
+// redactError returns err with any credentials removed from its message.
+func (c *Client) redactError(err error) error {
+	msg := err.Error()
+	clean := c.redact(msg)
+	if clean == msg {
+		return err
+	}
+	return &redactedError{msg: clean, err: err}
+}
+
+func debugJSON(v interface{}) string {
+	if v == nil {
+		return "{}"
+	}
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(data)
+}
+
 // Rate limiting helper
 func (c *Client) GetRateLimit(ctx context.Context) (*RateLimit, error) {
 	// This would query Linear's rate limiting info
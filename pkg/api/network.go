@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"syscall"
+)
+
+// NetworkError reports that the Linear API could not be reached at all:
+// DNS failures, refused or reset connections and timeouts. The underlying
+// error stays available to errors.Is/As and is logged under --debug.
+type NetworkError struct {
+	Host   string
+	Detail string
+	Err    error
+}
+
+func (e *NetworkError) Error() string {
+	return fmt.Sprintf("Could not reach Linear API at %s (check your connection, VPN or proxy): %s", e.Host, e.Detail)
+}
+
+func (e *NetworkError) Unwrap() error { return e.Err }
+
+// asNetworkError converts a transport error from http.Client.Do into a
+// NetworkError, or returns nil when err is something else (such as the
+// caller canceling ctx).
+func asNetworkError(baseURL string, err error) *NetworkError {
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	host := baseURL
+	if u, perr := url.Parse(baseURL); perr == nil && u.Host != "" {
+		host = u.Host
+	}
+
+	var dnsErr *net.DNSError
+	var netErr net.Error
+	var opErr *net.OpError
+	detail := ""
+	switch {
+	case errors.As(err, &dnsErr):
+		detail = fmt.Sprintf("DNS lookup for %s failed", dnsErr.Name)
+		if dnsErr.IsNotFound {
+			detail = fmt.Sprintf("DNS lookup for %s found no such host", dnsErr.Name)
+		}
+	case errors.Is(err, syscall.ECONNREFUSED):
+		detail = "connection refused"
+	case errors.Is(err, syscall.ECONNRESET):
+		detail = "connection reset"
+	case errors.Is(err, context.DeadlineExceeded), errors.As(err, &netErr) && netErr.Timeout():
+		detail = "request timed out"
+	case errors.As(err, &opErr):
+		detail = opErr.Err.Error()
+	default:
+		return nil
+	}
+	return &NetworkError{Host: host, Detail: detail, Err: err}
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// proxyURL, when set by SetProxy, overrides HTTPS_PROXY/HTTP_PROXY/NO_PROXY.
+var proxyURL *url.URL
+
+// SetProxy routes every API request through the proxy at rawURL, ignoring
+// the proxy environment variables. An empty rawURL restores them.
+func SetProxy(rawURL string) error {
+	if rawURL == "" {
+		proxyURL = nil
+		return nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("invalid proxy URL '%s' (expected e.g. http://proxy.example.com:8080)", rawURL)
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("unsupported proxy scheme '%s' in '%s' (use http, https or socks5)", u.Scheme, rawURL)
+	}
+	proxyURL = u
+	return nil
+}
+
+// proxyForRequest is the transport's Proxy func: the --proxy override when
+// given, otherwise the standard environment variables.
+func proxyForRequest(req *http.Request) (*url.URL, error) {
+	if proxyURL != nil {
+		return proxyURL, nil
+	}
+	return http.ProxyFromEnvironment(req)
+}
+
+// NewTransport returns a copy of the default transport that resolves its
+// proxy per request, so SetProxy applies to clients created earlier too.
+func NewTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = proxyForRequest
+	return transport
+}
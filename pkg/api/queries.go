@@ -3,7 +3,9 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -18,6 +20,15 @@ type User struct {
 	Active      bool       `json:"active"`
 	Admin       bool       `json:"admin"`
 	CreatedAt   *time.Time `json:"createdAt"`
+	// Organization is only populated by GetViewer.
+	Organization *Organization `json:"organization,omitempty"`
+}
+
+// Organization represents a Linear workspace
+type Organization struct {
+	ID     string `json:"id"`
+	Name   string `json:"name"`
+	URLKey string `json:"urlKey"`
 }
 
 // Team represents a Linear team
@@ -75,6 +86,7 @@ type Issue struct {
 	Creator               *User            `json:"creator"`
 	Subscribers           *Users           `json:"subscribers"`
 	Relations             *IssueRelations  `json:"relations"`
+	InverseRelations      *IssueRelations  `json:"inverseRelations,omitempty"` // relations other issues have to this one
 	History               *IssueHistory    `json:"history"`
 	Reactions             []Reaction       `json:"reactions"`
 	SlackIssueComments    []SlackComment   `json:"slackIssueComments"`
@@ -276,7 +288,8 @@ type IssueRelation struct {
 }
 
 type IssueHistory struct {
-	Nodes []IssueHistoryEntry `json:"nodes"`
+	Nodes    []IssueHistoryEntry `json:"nodes"`
+	PageInfo PageInfo            `json:"pageInfo"`
 }
 
 type IssueHistoryEntry struct {
@@ -330,6 +343,8 @@ type Template struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	Type        string `json:"type,omitempty"`
+	Team        *Team  `json:"team,omitempty"`
 }
 
 type Milestone struct {
@@ -354,7 +369,8 @@ type Roadmap struct {
 }
 
 type ProjectUpdates struct {
-	Nodes []ProjectUpdate `json:"nodes"`
+	Nodes    []ProjectUpdate `json:"nodes"`
+	PageInfo PageInfo        `json:"pageInfo"`
 }
 
 type ProjectUpdate struct {
@@ -408,6 +424,11 @@ func (c *Client) GetViewer(ctx context.Context) (*User, error) {
 				isMe
 				active
 				admin
+				organization {
+					id
+					name
+					urlKey
+				}
 			}
 		}
 	`
@@ -424,11 +445,101 @@ func (c *Client) GetViewer(ctx context.Context) (*User, error) {
 	return &response.Viewer, nil
 }
 
-// GetIssues returns a list of issues with optional filtering
-func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string) (*Issues, error) {
+// scopeProbeIssueID matches no issue, so the probe mutation below can never
+// change anything.
+const scopeProbeIssueID = "00000000-0000-0000-0000-000000000000"
+
+// ProbeWriteAccess reports whether the key appears to allow mutations. Linear
+// has no query for a key's scopes, so it sends an empty issueUpdate for an
+// issue that does not exist: a read-only key is refused outright, while a key
+// with write access gets past authorization and fails on the missing issue.
+// Any other answer (rate limiting, a server error, ...) says nothing about the
+// key, so it is returned as an error and the access is left unknown.
+func (c *Client) ProbeWriteAccess(ctx context.Context) (bool, error) {
+	query := `
+		mutation ScopeProbe($id: String!) {
+			issueUpdate(id: $id, input: {}) {
+				success
+			}
+		}
+	`
+	err := c.Execute(ctx, query, map[string]interface{}{"id": scopeProbeIssueID}, nil)
+	var gqlErrs GraphQLErrors
+	var netErr *NetworkError
+	switch {
+	case err == nil:
+		return true, nil
+	case errors.As(err, &netErr):
+		return false, err
+	case errors.As(err, &gqlErrs):
+		for _, gqlErr := range gqlErrs {
+			if isPermissionError(gqlErr) {
+				return false, nil
+			}
+		}
+		for _, gqlErr := range gqlErrs {
+			if isNotFoundError(gqlErr) {
+				return true, nil
+			}
+		}
+	default:
+		// Linear may answer with a non-200 status whose body carries the errors.
+		if isPermissionError(GraphQLError{Message: err.Error()}) {
+			return false, nil
+		}
+		if isNotFoundError(GraphQLError{Message: err.Error()}) {
+			return true, nil
+		}
+	}
+	return false, fmt.Errorf("could not tell whether the key can write: %w", err)
+}
+
+// isNotFoundError reports whether Linear got as far as looking up the entity
+// a request refers to, i.e. the request itself was authorized.
+func isNotFoundError(gqlErr GraphQLError) bool {
+	return strings.Contains(strings.ToLower(gqlErr.Message), "entity not found")
+}
+
+// isPermissionError reports whether Linear refused a request for lack of
+// scope or permission rather than because of its input.
+func isPermissionError(gqlErr GraphQLError) bool {
+	if ext := gqlErr.Extensions; ext != nil {
+		switch strings.ToLower(ext.Type) {
+		case "forbidden", "authorization error", "authentication error":
+			return true
+		}
+		if strings.EqualFold(ext.Code, "FORBIDDEN") {
+			return true
+		}
+	}
+	msg := strings.ToLower(gqlErr.Message)
+	for _, hint := range []string{"scope", "permission", "forbidden", "read-only", "read only", "not authorized", "status 403"} {
+		if strings.Contains(msg, hint) {
+			return true
+		}
+	}
+	return false
+}
+
+// IssueListOptions selects optional per-issue connections for GetIssues and
+// IssueSearch. Each one multiplies the query's complexity by the page size,
+// and would show up in --json output, so they are only fetched for the
+// client-side filters that need them.
+type IssueListOptions struct {
+	// Presence selects the first comment and attachment of each issue, for
+	// the --has-comments/--no-comments and attachment filters.
+	Presence bool
+	// Relations selects each issue's relations and inverse relations, for
+	// the --blocked and --blocking filters.
+	Relations bool
+}
+
+// GetIssues returns a list of issues with optional filtering. Archived issues
+// are only included when includeArchived is set.
+func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool, opts IssueListOptions) (*Issues, error) {
 	query := `
-		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
-			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy) {
+		query Issues($filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy, $includeArchived: Boolean, $withPresence: Boolean!, $withRelations: Boolean!) {
+			issues(filter: $filter, first: $first, after: $after, orderBy: $orderBy, includeArchived: $includeArchived) {
 				nodes {
 					id
 					identifier
@@ -439,6 +550,7 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 					createdAt
 					updatedAt
 					dueDate
+					archivedAt
 					url
 					state {
 						id
@@ -450,6 +562,7 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 						id
 						name
 						email
+						active
 					}
 					team {
 						id
@@ -472,6 +585,28 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 								color
 							}
 						}
+					comments(first: 1) @include(if: $withPresence) {
+						nodes {
+							id
+						}
+					}
+					attachments(first: 1) @include(if: $withPresence) {
+						nodes {
+							id
+						}
+					}
+					relations(first: 20) @include(if: $withRelations) {
+						nodes {
+							id
+							type
+						}
+					}
+					inverseRelations(first: 20) @include(if: $withRelations) {
+						nodes {
+							id
+							type
+						}
+					}
 				}
 				pageInfo {
 					hasNextPage
@@ -482,7 +617,9 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	`
 
 	variables := map[string]interface{}{
-		"first": first,
+		"first":         first,
+		"withPresence":  opts.Presence,
+		"withRelations": opts.Relations,
 	}
 	if filter != nil {
 		variables["filter"] = filter
@@ -493,6 +630,9 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	if orderBy != "" {
 		variables["orderBy"] = orderBy
 	}
+	if includeArchived {
+		variables["includeArchived"] = true
+	}
 
 	var response struct {
 		Issues Issues `json:"issues"`
@@ -506,11 +646,67 @@ func (c *Client) GetIssues(ctx context.Context, filter map[string]interface{}, f
 	return &response.Issues, nil
 }
 
-// IssueSearch returns issues that match a full-text query
-func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool) (*Issues, error) {
+// CountIssues returns the number of issues matching filter. Only issue IDs
+// are requested, paging through the results 250 at a time.
+func (c *Client) CountIssues(ctx context.Context, filter map[string]interface{}, includeArchived bool) (int, error) {
+	query := `
+		query IssueCount($filter: IssueFilter, $first: Int, $after: String, $includeArchived: Boolean) {
+			issues(filter: $filter, first: $first, after: $after, includeArchived: $includeArchived) {
+				nodes {
+					id
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	count := 0
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"first": 250,
+		}
+		if filter != nil {
+			variables["filter"] = filter
+		}
+		if after != "" {
+			variables["after"] = after
+		}
+		if includeArchived {
+			variables["includeArchived"] = true
+		}
+
+		var response struct {
+			Issues struct {
+				Nodes []struct {
+					ID string `json:"id"`
+				} `json:"nodes"`
+				PageInfo PageInfo `json:"pageInfo"`
+			} `json:"issues"`
+		}
+
+		err := c.Execute(ctx, query, variables, &response)
+		if err != nil {
+			return 0, err
+		}
+
+		count += len(response.Issues.Nodes)
+		if !response.Issues.PageInfo.HasNextPage || response.Issues.PageInfo.EndCursor == "" {
+			return count, nil
+		}
+		after = response.Issues.PageInfo.EndCursor
+	}
+}
+
+// IssueSearch returns issues that match a full-text query. Linear matches
+// titles and descriptions; includeComments also matches comment bodies.
+func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string]interface{}, first int, after string, orderBy string, includeArchived bool, includeComments bool, opts IssueListOptions) (*Issues, error) {
 	query := `
-		query IssueSearch($term: String!, $filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy, $includeArchived: Boolean) {
-			searchIssues(term: $term, filter: $filter, first: $first, after: $after, orderBy: $orderBy, includeArchived: $includeArchived) {
+		query IssueSearch($term: String!, $filter: IssueFilter, $first: Int, $after: String, $orderBy: PaginationOrderBy, $includeArchived: Boolean, $includeComments: Boolean, $withPresence: Boolean!) {
+			searchIssues(term: $term, filter: $filter, first: $first, after: $after, orderBy: $orderBy, includeArchived: $includeArchived, includeComments: $includeComments) {
 				nodes {
 					id
 					identifier
@@ -554,6 +750,16 @@ func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string
 								color
 						}
 					}
+					comments(first: 1) @include(if: $withPresence) {
+						nodes {
+							id
+						}
+					}
+					attachments(first: 1) @include(if: $withPresence) {
+						nodes {
+							id
+						}
+					}
 				}
 				pageInfo {
 					hasNextPage
@@ -567,6 +773,8 @@ func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string
 		"term":            term,
 		"first":           first,
 		"includeArchived": includeArchived,
+		"includeComments": includeComments,
+		"withPresence":    opts.Presence,
 	}
 	if filter != nil {
 		variables["filter"] = filter
@@ -596,11 +804,9 @@ func (c *Client) IssueSearch(ctx context.Context, term string, filter map[string
 	}, nil
 }
 
-// GetIssue returns a single issue by ID
-func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
-	query := `
-		query Issue($id: String!) {
-			issue(id: $id) {
+// issueDetailFields is the issue selection GetIssue makes, shared with
+// IssueQuery so a batched fetch returns the same shape.
+const issueDetailFields = `
 				id
 				identifier
 				number
@@ -678,6 +884,7 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 					id
 					identifier
 					title
+					url
 					state {
 						name
 						type
@@ -688,6 +895,7 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 						id
 						identifier
 						title
+						url
 						priority
 						createdAt
 						state {
@@ -733,6 +941,7 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 						subtitle
 						url
 						metadata
+						sourceType
 						createdAt
 						creator {
 							name
@@ -782,6 +991,7 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 							id
 							identifier
 							title
+							url
 							state {
 								name
 								type
@@ -845,7 +1055,13 @@ func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
 					email
 					avatarUrl
 				}
-			}
+`
+
+// GetIssue returns a single issue by ID
+func (c *Client) GetIssue(ctx context.Context, id string) (*Issue, error) {
+	query := `
+		query Issue($id: String!) {
+			issue(id: $id) {` + issueDetailFields + `}
 		}
 	`
 
@@ -1088,7 +1304,7 @@ func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
 						}
 					}
 				}
-				projectUpdates(first: 10) {
+				projectUpdates(first: 10, orderBy: createdAt) {
 					nodes {
 						id
 						body
@@ -1177,6 +1393,16 @@ func (c *Client) UpdateIssue(ctx context.Context, id string, input map[string]in
 						id
 						name
 					}
+					cycle {
+						id
+						number
+						name
+					}
+					parent {
+						id
+						identifier
+						title
+					}
 					labels {
 						nodes {
 							id
@@ -1217,6 +1443,7 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{})
 					id
 					identifier
 					title
+					url
 					description
 					priority
 					estimate
@@ -1273,7 +1500,8 @@ func (c *Client) CreateIssue(ctx context.Context, input map[string]interface{})
 	return &response.IssueCreate.Issue, nil
 }
 
-// GetTeam returns a single team by key; falls back to id lookup if not found
+// GetTeam returns a single team by key; falls back to id lookup, then to the
+// team's display name, if not found
 func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
 	// First, attempt lookup by team key via teams connection
 	queryByKey := `
@@ -1299,11 +1527,12 @@ func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
 		} `json:"teams"`
 	}
 
-	if err := c.Execute(ctx, queryByKey, variables, &respByKey); err == nil {
-		if len(respByKey.Teams.Nodes) > 0 {
-			t := respByKey.Teams.Nodes[0]
-			return &t, nil
-		}
+	if err := c.Execute(ctx, queryByKey, variables, &respByKey); err != nil {
+		return nil, fmt.Errorf("failed to look up team '%s': %w", key, err)
+	}
+	if len(respByKey.Teams.Nodes) > 0 {
+		t := respByKey.Teams.Nodes[0]
+		return &t, nil
 	}
 
 	// Fallback: try direct id lookup (in case caller passed an ID)
@@ -1323,11 +1552,73 @@ func (c *Client) GetTeam(ctx context.Context, key string) (*Team, error) {
 	var respByID struct {
 		Team *Team `json:"team"`
 	}
-	if err := c.Execute(ctx, queryByID, map[string]interface{}{"id": key}, &respByID); err == nil && respByID.Team != nil {
+	// Linear answers an unknown or malformed ID with GraphQL errors, which
+	// just mean "no match"; anything else (network, auth, server) is real
+	err := c.Execute(ctx, queryByID, map[string]interface{}{"id": key}, &respByID)
+	var gqlErrs GraphQLErrors
+	if err != nil && !errors.As(err, &gqlErrs) {
+		return nil, fmt.Errorf("failed to look up team '%s': %w", key, err)
+	}
+	if err == nil && respByID.Team != nil {
 		return respByID.Team, nil
 	}
 
-	return nil, fmt.Errorf("team '%s' not found", key)
+	// Last resort: people often know the display name ("Engineering") rather
+	// than the key
+	return c.getTeamByName(ctx, key)
+}
+
+// getTeamByName resolves a team by name, compared case-insensitively. Partial
+// or ambiguous matches are reported with their keys instead of guessed.
+func (c *Client) getTeamByName(ctx context.Context, name string) (*Team, error) {
+	query := `
+        query TeamsByName($name: String!) {
+            teams(filter: { name: { containsIgnoreCase: $name } }, first: 50) {
+                nodes {
+                    id
+                    key
+                    name
+                    description
+                    private
+                    issueCount
+                }
+            }
+        }
+    `
+
+	var response struct {
+		Teams struct {
+			Nodes []Team `json:"nodes"`
+		} `json:"teams"`
+	}
+	if err := c.Execute(ctx, query, map[string]interface{}{"name": name}, &response); err != nil {
+		return nil, fmt.Errorf("failed to look up team '%s': %w", name, err)
+	}
+
+	var exact []Team
+	for _, t := range response.Teams.Nodes {
+		if strings.EqualFold(t.Name, name) {
+			exact = append(exact, t)
+		}
+	}
+	if len(exact) == 1 {
+		return &exact[0], nil
+	}
+	if len(exact) > 1 {
+		return nil, fmt.Errorf("team name '%s' is ambiguous; use a key: %s", name, teamCandidates(exact))
+	}
+	if len(response.Teams.Nodes) > 0 {
+		return nil, fmt.Errorf("team '%s' not found; did you mean: %s", name, teamCandidates(response.Teams.Nodes))
+	}
+	return nil, fmt.Errorf("team '%s' not found", name)
+}
+
+func teamCandidates(teams []Team) string {
+	names := make([]string, len(teams))
+	for i, t := range teams {
+		names[i] = fmt.Sprintf("%s (%s)", t.Name, t.Key)
+	}
+	return strings.Join(names, ", ")
 }
 
 // Comment represents a Linear comment
@@ -1397,12 +1688,142 @@ func (c *Client) GetTeamStates(ctx context.Context, teamKey string) ([]WorkflowS
 	return response.Team.States.Nodes, nil
 }
 
-// GetTeamMembers returns members of a specific team
-func (c *Client) GetTeamMembers(ctx context.Context, teamKey string) (*Users, error) {
+// GetTeamActiveCycle returns the team's current cycle, or nil if none is active
+func (c *Client) GetTeamActiveCycle(ctx context.Context, teamKey string) (*Cycle, error) {
 	query := `
-		query TeamMembers($key: String!) {
+		query TeamActiveCycle($key: String!) {
 			team(id: $key) {
-				members {
+				activeCycle {
+					id
+					number
+					name
+					startsAt
+					endsAt
+					progress
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"key": teamKey,
+	}
+
+	var response struct {
+		Team struct {
+			ActiveCycle *Cycle `json:"activeCycle"`
+		} `json:"team"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Team.ActiveCycle, nil
+}
+
+// GetTeamCycleByNumber returns the team's cycle with the given number, or nil
+// if the team has no such cycle
+func (c *Client) GetTeamCycleByNumber(ctx context.Context, teamKey string, number int) (*Cycle, error) {
+	query := `
+		query TeamCycleByNumber($key: String!, $number: Float!) {
+			team(id: $key) {
+				cycles(first: 1, filter: { number: { eq: $number } }) {
+					nodes {
+						id
+						number
+						name
+						startsAt
+						endsAt
+						progress
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"key":    teamKey,
+		"number": number,
+	}
+
+	var response struct {
+		Team struct {
+			Cycles struct {
+				Nodes []Cycle `json:"nodes"`
+			} `json:"cycles"`
+		} `json:"team"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Team.Cycles.Nodes) == 0 {
+		return nil, nil
+	}
+
+	return &response.Team.Cycles.Nodes[0], nil
+}
+
+// GetCycleIssues returns a page of the issues in a cycle with the fields
+// needed to measure progress
+func (c *Client) GetCycleIssues(ctx context.Context, cycleID string, first int, after string) (*Issues, error) {
+	query := `
+		query CycleIssues($id: String!, $first: Int, $after: String) {
+			cycle(id: $id) {
+				issues(first: $first, after: $after) {
+					nodes {
+						id
+						identifier
+						title
+						estimate
+						completedAt
+						canceledAt
+						state {
+							id
+							name
+							type
+						}
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    cycleID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Cycle struct {
+			Issues Issues `json:"issues"`
+		} `json:"cycle"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Cycle.Issues, nil
+}
+
+// GetTeamMembers returns a page of members of a specific team
+func (c *Client) GetTeamMembers(ctx context.Context, teamKey string, first int, after string) (*Users, error) {
+	query := `
+		query TeamMembers($key: String!, $first: Int, $after: String) {
+			team(id: $key) {
+				members(first: $first, after: $after) {
 					nodes {
 						id
 						name
@@ -1422,7 +1843,11 @@ func (c *Client) GetTeamMembers(ctx context.Context, teamKey string) (*Users, er
 	`
 
 	variables := map[string]interface{}{
-		"key": teamKey,
+		"key":   teamKey,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
 	}
 
 	var response struct {
@@ -1515,22 +1940,61 @@ func (c *Client) GetUser(ctx context.Context, email string) (*User, error) {
 	return &response.User, nil
 }
 
-// GetIssueComments returns comments for a specific issue
-func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int, after string, orderBy string) (*Comments, error) {
+// GetUsersByIDs returns the users with the given IDs in a single request.
+// IDs that don't match a user are left out of the result.
+func (c *Client) GetUsersByIDs(ctx context.Context, ids []string) ([]User, error) {
 	query := `
-		query IssueComments($id: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
-			issue(id: $id) {
-				comments(first: $first, after: $after, orderBy: $orderBy) {
-					nodes {
-						id
-						body
-						createdAt
-						updatedAt
-						user {
+		query UsersByIDs($ids: [ID!], $first: Int) {
+			users(filter: { id: { in: $ids } }, first: $first, includeDisabled: true) {
+				nodes {
+					id
+					name
+					displayName
+					email
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"ids":   ids,
+		"first": len(ids),
+	}
+
+	var response struct {
+		Users struct {
+			Nodes []User `json:"nodes"`
+		} `json:"users"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.Users.Nodes, nil
+}
+
+// GetIssueComments returns comments for a specific issue
+func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int, after string, orderBy string) (*Comments, error) {
+	query := `
+		query IssueComments($id: String!, $first: Int, $after: String, $orderBy: PaginationOrderBy) {
+			issue(id: $id) {
+				comments(first: $first, after: $after, orderBy: $orderBy) {
+					nodes {
+						id
+						body
+						createdAt
+						updatedAt
+						editedAt
+						user {
 							id
 							name
 							email
 						}
+						parent {
+							id
+						}
 					}
 					pageInfo {
 						hasNextPage
@@ -1566,6 +2030,83 @@ func (c *Client) GetIssueComments(ctx context.Context, issueID string, first int
 	return &response.Issue.Comments, nil
 }
 
+// GetIssueHistory returns a page of history entries for a specific issue
+func (c *Client) GetIssueHistory(ctx context.Context, issueID string, first int, after string) (*IssueHistory, error) {
+	query := `
+		query IssueHistory($id: String!, $first: Int, $after: String) {
+			issue(id: $id) {
+				history(first: $first, after: $after) {
+					nodes {
+						id
+						createdAt
+						updatedAt
+						actor {
+							id
+							name
+							email
+						}
+						fromAssignee {
+							name
+						}
+						toAssignee {
+							name
+						}
+						fromState {
+							name
+						}
+						toState {
+							name
+						}
+						fromPriority
+						toPriority
+						fromTitle
+						toTitle
+						fromCycle {
+							name
+						}
+						toCycle {
+							name
+						}
+						fromProject {
+							name
+						}
+						toProject {
+							name
+						}
+						addedLabelIds
+						removedLabelIds
+					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    issueID,
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Issue struct {
+			History IssueHistory `json:"history"`
+		} `json:"issue"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Issue.History, nil
+}
+
 // CreateComment creates a new comment on an issue
 func (c *Client) CreateComment(ctx context.Context, issueID string, body string) (*Comment, error) {
 	query := `
@@ -2040,12 +2581,14 @@ func (c *Client) DeleteProjectMilestone(ctx context.Context, milestoneID string)
 	return nil
 }
 
-// ListProjectUpdates returns all updates for a specific project
+// ListProjectUpdates returns all updates for a specific project, following
+// pagination until the last page. Updates are requested in createdAt order so
+// pages are stable; callers that need newest-first should still sort.
 func (c *Client) ListProjectUpdates(ctx context.Context, projectID string) (*ProjectUpdates, error) {
 	query := `
-		query ProjectUpdates($projectId: String!) {
+		query ProjectUpdates($projectId: String!, $first: Int, $after: String) {
 			project(id: $projectId) {
-				projectUpdates {
+				projectUpdates(first: $first, after: $after, orderBy: createdAt) {
 					nodes {
 						id
 						body
@@ -2059,27 +2602,44 @@ func (c *Client) ListProjectUpdates(ctx context.Context, projectID string) (*Pro
 							email
 						}
 					}
+					pageInfo {
+						hasNextPage
+						endCursor
+					}
 				}
 			}
 		}
 	`
 
-	variables := map[string]interface{}{
-		"projectId": projectID,
-	}
+	result := &ProjectUpdates{Nodes: []ProjectUpdate{}}
+	after := ""
+	for {
+		variables := map[string]interface{}{
+			"projectId": projectID,
+			"first":     100,
+		}
+		if after != "" {
+			variables["after"] = after
+		}
 
-	var response struct {
-		Project struct {
-			ProjectUpdates ProjectUpdates `json:"projectUpdates"`
-		} `json:"project"`
-	}
+		var response struct {
+			Project struct {
+				ProjectUpdates ProjectUpdates `json:"projectUpdates"`
+			} `json:"project"`
+		}
 
-	err := c.Execute(ctx, query, variables, &response)
-	if err != nil {
-		return nil, err
-	}
+		err := c.Execute(ctx, query, variables, &response)
+		if err != nil {
+			return nil, err
+		}
 
-	return &response.Project.ProjectUpdates, nil
+		page := response.Project.ProjectUpdates
+		result.Nodes = append(result.Nodes, page.Nodes...)
+		if !page.PageInfo.HasNextPage || page.PageInfo.EndCursor == "" {
+			return result, nil
+		}
+		after = page.PageInfo.EndCursor
+	}
 }
 
 // GetProjectUpdate returns a specific project update
@@ -2158,3 +2718,359 @@ func (c *Client) CreateProjectUpdate(ctx context.Context, input map[string]inter
 
 	return &response.ProjectUpdateCreate.ProjectUpdate, nil
 }
+
+// Favorite represents an entry in the viewer's sidebar favorites
+type Favorite struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	SortOrder float64   `json:"sortOrder"`
+	CreatedAt time.Time `json:"createdAt"`
+	Issue     *Issue    `json:"issue,omitempty"`
+	Project   *Project  `json:"project,omitempty"`
+	Cycle     *Cycle    `json:"cycle,omitempty"`
+	Label     *Label    `json:"label,omitempty"`
+}
+
+// Favorites represents a paginated list of favorites
+type Favorites struct {
+	Nodes    []Favorite `json:"nodes"`
+	PageInfo PageInfo   `json:"pageInfo"`
+}
+
+// GetFavorites returns the authenticated user's favorites
+func (c *Client) GetFavorites(ctx context.Context, first int, after string) (*Favorites, error) {
+	query := `
+		query Favorites($first: Int, $after: String) {
+			favorites(first: $first, after: $after) {
+				nodes {
+					id
+					type
+					sortOrder
+					createdAt
+					issue {
+						id
+						identifier
+						title
+						url
+					}
+					project {
+						id
+						name
+						url
+					}
+					cycle {
+						id
+						number
+						name
+					}
+					label {
+						id
+						name
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Favorites Favorites `json:"favorites"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Favorites, nil
+}
+
+// CreateFavorite adds an entity to the viewer's favorites
+func (c *Client) CreateFavorite(ctx context.Context, input map[string]interface{}) (*Favorite, error) {
+	query := `
+		mutation CreateFavorite($input: FavoriteCreateInput!) {
+			favoriteCreate(input: $input) {
+				success
+				favorite {
+					id
+					type
+					createdAt
+					issue {
+						id
+						identifier
+						title
+					}
+					project {
+						id
+						name
+					}
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		FavoriteCreate struct {
+			Success  bool     `json:"success"`
+			Favorite Favorite `json:"favorite"`
+		} `json:"favoriteCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.FavoriteCreate.Favorite, nil
+}
+
+// DeleteFavorite removes a favorite by ID
+func (c *Client) DeleteFavorite(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteFavorite($id: String!) {
+			favoriteDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		FavoriteDelete struct {
+			Success bool `json:"success"`
+		} `json:"favoriteDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.FavoriteDelete.Success {
+		return fmt.Errorf("failed to delete favorite")
+	}
+
+	return nil
+}
+
+// Notification represents an inbox notification for the viewer
+type Notification struct {
+	ID        string     `json:"id"`
+	Type      string     `json:"type"`
+	CreatedAt time.Time  `json:"createdAt"`
+	ReadAt    *time.Time `json:"readAt"`
+	Actor     *User      `json:"actor"`
+	Issue     *Issue     `json:"issue,omitempty"`
+}
+
+// Notifications represents a paginated list of notifications
+type Notifications struct {
+	Nodes    []Notification `json:"nodes"`
+	PageInfo PageInfo       `json:"pageInfo"`
+}
+
+// GetNotifications returns the viewer's notifications
+func (c *Client) GetNotifications(ctx context.Context, first int, after string) (*Notifications, error) {
+	query := `
+		query Notifications($first: Int, $after: String) {
+			notifications(first: $first, after: $after) {
+				nodes {
+					id
+					type
+					createdAt
+					readAt
+					actor {
+						id
+						name
+						email
+					}
+					... on IssueNotification {
+						issue {
+							id
+							identifier
+							title
+							url
+						}
+					}
+				}
+				pageInfo {
+					hasNextPage
+					endCursor
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"first": first,
+	}
+	if after != "" {
+		variables["after"] = after
+	}
+
+	var response struct {
+		Notifications Notifications `json:"notifications"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.Notifications, nil
+}
+
+// MarkNotificationRead marks a single notification as read
+func (c *Client) MarkNotificationRead(ctx context.Context, id string) error {
+	query := `
+		mutation MarkNotificationRead($id: String!, $input: NotificationUpdateInput!) {
+			notificationUpdate(id: $id, input: $input) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id":    id,
+		"input": map[string]interface{}{"readAt": time.Now().UTC().Format(time.RFC3339)},
+	}
+
+	var response struct {
+		NotificationUpdate struct {
+			Success bool `json:"success"`
+		} `json:"notificationUpdate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.NotificationUpdate.Success {
+		return fmt.Errorf("failed to mark notification read")
+	}
+
+	return nil
+}
+
+// GetProjectTemplates returns all project templates in the workspace
+func (c *Client) GetProjectTemplates(ctx context.Context) ([]Template, error) {
+	query := `
+		query Templates {
+			templates {
+				id
+				name
+				description
+				type
+				team {
+					id
+					key
+					name
+				}
+			}
+		}
+	`
+
+	var response struct {
+		Templates []Template `json:"templates"`
+	}
+
+	err := c.Execute(ctx, query, nil, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]Template, 0, len(response.Templates))
+	for _, t := range response.Templates {
+		if t.Type == "project" {
+			templates = append(templates, t)
+		}
+	}
+
+	return templates, nil
+}
+
+// CreateAttachment links a URL to an issue
+func (c *Client) CreateAttachment(ctx context.Context, input map[string]interface{}) (*Attachment, error) {
+	query := `
+		mutation CreateAttachment($input: AttachmentCreateInput!) {
+			attachmentCreate(input: $input) {
+				success
+				attachment {
+					id
+					title
+					subtitle
+					url
+					createdAt
+				}
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"input": input,
+	}
+
+	var response struct {
+		AttachmentCreate struct {
+			Success    bool       `json:"success"`
+			Attachment Attachment `json:"attachment"`
+		} `json:"attachmentCreate"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return &response.AttachmentCreate.Attachment, nil
+}
+
+// DeleteAttachment removes an attachment by ID
+func (c *Client) DeleteAttachment(ctx context.Context, id string) error {
+	query := `
+		mutation DeleteAttachment($id: String!) {
+			attachmentDelete(id: $id) {
+				success
+			}
+		}
+	`
+
+	variables := map[string]interface{}{
+		"id": id,
+	}
+
+	var response struct {
+		AttachmentDelete struct {
+			Success bool `json:"success"`
+		} `json:"attachmentDelete"`
+	}
+
+	err := c.Execute(ctx, query, variables, &response)
+	if err != nil {
+		return err
+	}
+
+	if !response.AttachmentDelete.Success {
+		return fmt.Errorf("failed to delete attachment")
+	}
+
+	return nil
+}
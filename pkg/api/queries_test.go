@@ -3,6 +3,8 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"net"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -85,6 +87,108 @@ func TestGetTeamFallbackByID(t *testing.T) {
 	}
 }
 
+func TestGetTeamFallbackByName(t *testing.T) {
+	srv := newMockGraphQLServer(t, func(query string, w http.ResponseWriter) {
+		nodes := []any{}
+		switch {
+		case strings.Contains(query, "TeamsByName"):
+			nodes = []any{
+				map[string]any{"id": "team-1", "key": "ENG", "name": "Engineering"},
+				map[string]any{"id": "team-2", "key": "PLAT", "name": "Platform Engineering"},
+			}
+		case strings.Contains(query, "team("):
+			_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"team": nil}})
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"teams": map[string]any{"nodes": nodes}}})
+	})
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "Bearer test")
+	got, err := c.GetTeam(context.Background(), "engineering")
+	if err != nil {
+		t.Fatalf("GetTeam returned error: %v", err)
+	}
+	if got.Key != "ENG" {
+		t.Fatalf("expected the exact name match ENG, got %+v", got)
+	}
+
+	_, err = c.GetTeam(context.Background(), "Eng")
+	if err == nil || !strings.Contains(err.Error(), "Engineering (ENG)") || !strings.Contains(err.Error(), "Platform Engineering (PLAT)") {
+		t.Fatalf("expected candidates with their keys, got %v", err)
+	}
+}
+
+// failingTransport forwards requests to a test server, except those whose
+// query contains failQuery, which fail as if the API host could not be found.
+type failingTransport struct {
+	failQuery string
+}
+
+func (f failingTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	var body struct {
+		Query string `json:"query"`
+	}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	if strings.Contains(body.Query, f.failQuery) {
+		return nil, &net.DNSError{Err: "no such host", Name: "api.linear.app", IsNotFound: true}
+	}
+	data := map[string]any{"teams": map[string]any{"nodes": []any{}}}
+	if strings.Contains(body.Query, "TeamByID") {
+		data = map[string]any{"team": nil}
+	}
+	rec := httptest.NewRecorder()
+	_ = json.NewEncoder(rec).Encode(map[string]any{"data": data})
+	return rec.Result(), nil
+}
+
+func TestGetTeam_LookupFailuresAreNotReportedAsNotFound(t *testing.T) {
+	for _, failing := range []string{"TeamByKey", "TeamByID", "TeamsByName"} {
+		c := NewClientWithURL(BaseURL, "Bearer test")
+		c.httpClient = &http.Client{Transport: failingTransport{failQuery: failing}}
+
+		_, err := c.GetTeam(context.Background(), "Engineering")
+		var netErr *NetworkError
+		if !errors.As(err, &netErr) {
+			t.Fatalf("%s failing: expected a NetworkError, got %T: %v", failing, err, err)
+		}
+		if strings.Contains(err.Error(), "team 'Engineering' not found") {
+			t.Fatalf("%s failing: network error reported as not found: %v", failing, err)
+		}
+	}
+}
+
+func TestListProjectUpdates_OrderedAndPaginated(t *testing.T) {
+	calls := 0
+	srv := newMockGraphQLServer(t, func(query string, w http.ResponseWriter) {
+		calls++
+		if !strings.Contains(query, "orderBy: createdAt") {
+			t.Errorf("expected updates to be requested in createdAt order, got %s", query)
+		}
+		page := map[string]any{
+			"nodes":    []any{map[string]any{"id": "u2", "createdAt": "2025-05-08T00:00:00Z"}},
+			"pageInfo": map[string]any{"hasNextPage": true, "endCursor": "c1"},
+		}
+		if calls == 2 {
+			page = map[string]any{
+				"nodes":    []any{map[string]any{"id": "u1", "createdAt": "2025-05-01T00:00:00Z"}},
+				"pageInfo": map[string]any{"hasNextPage": false},
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"project": map[string]any{"projectUpdates": page}}})
+	})
+	defer srv.Close()
+
+	c := NewClientWithURL(srv.URL, "Bearer test")
+	got, err := c.ListProjectUpdates(context.Background(), "proj-1")
+	if err != nil {
+		t.Fatalf("ListProjectUpdates returned error: %v", err)
+	}
+	if calls != 2 || len(got.Nodes) != 2 || got.Nodes[0].ID != "u2" || got.Nodes[1].ID != "u1" {
+		t.Fatalf("expected both pages in server order after %d calls, got %+v", calls, got.Nodes)
+	}
+}
+
 func TestCreateArchiveAndGetProject(t *testing.T) {
 	srv := newMockGraphQLServer(t, func(query string, w http.ResponseWriter) {
 		switch {
@@ -140,3 +244,29 @@ func TestCreateArchiveAndGetProject(t *testing.T) {
 		t.Fatalf("unexpected GetProject: %+v", got)
 	}
 }
+
+func TestDebugLoggingRedactsAPIKey(t *testing.T) {
+	const key = "lin_api_supersecretkey123"
+	srv := newMockGraphQLServer(t, func(query string, w http.ResponseWriter) {
+		_ = json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"viewer": map[string]any{"id": "u1", "name": "Me"}}})
+	})
+	defer srv.Close()
+
+	var buf strings.Builder
+	SetDebug(&buf)
+	defer SetDebug(nil)
+
+	c := NewClientWithURL(srv.URL, key)
+	if err := c.Execute(context.Background(), "query Viewer { viewer { id } }", map[string]any{"token": key}, nil); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	if strings.Contains(out, key) {
+		t.Fatalf("debug output leaked API key:\n%s", out)
+	}
+	for _, want := range []string{"query Viewer", "Response 200", `"viewer"`} {
+		if !strings.Contains(out, want) {
+			t.Fatalf("debug output missing %q:\n%s", want, out)
+		}
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/raegislabs/linctl/pkg/api"
 	"github.com/fatih/color"
@@ -22,6 +23,40 @@ type User struct {
 
 type AuthConfig struct {
 	APIKey string `json:"api_key,omitempty"`
+	// OAuth credentials from `auth login --oauth`, used instead of APIKey.
+	AccessToken       string `json:"access_token,omitempty"`
+	RefreshToken      string `json:"refresh_token,omitempty"`
+	ExpiresAt         string `json:"expires_at,omitempty"` // RFC 3339
+	Scopes            string `json:"scopes,omitempty"`
+	OAuthClientID     string `json:"oauth_client_id,omitempty"`
+	OAuthClientSecret string `json:"oauth_client_secret,omitempty"`
+	// What login learned about the key; informational only.
+	TokenType   string `json:"token_type,omitempty"`   // "personal" or "oauth"
+	WriteAccess *bool  `json:"write_access,omitempty"` // nil when it could not be checked
+	Note        string `json:"note,omitempty"`
+	CheckedAt   string `json:"checked_at,omitempty"`
+}
+
+// TokenType classifies a stored credential: OAuth access tokens are sent as
+// Bearer tokens or carry Linear's lin_oauth_ prefix; anything else is a
+// personal API key.
+func TokenType(key string) string {
+	if strings.HasPrefix(key, "Bearer ") || strings.HasPrefix(key, "lin_oauth_") {
+		return "oauth"
+	}
+	return "personal"
+}
+
+// accessNote explains the write-access check for the auth file.
+func accessNote(writeAccess *bool) string {
+	switch {
+	case writeAccess == nil:
+		return "write access could not be checked at login"
+	case *writeAccess:
+		return "key appears to allow creating and updating data"
+	default:
+		return "key appears to be read-only: creating or updating issues, comments and projects will fail"
+	}
 }
 
 // getConfigPath returns the path to the auth config file
@@ -33,6 +68,17 @@ func getConfigPath() (string, error) {
 	return filepath.Join(homeDir, ".linctl-auth.json"), nil
 }
 
+// ConfigPath returns the path of the file credentials are stored in.
+func ConfigPath() (string, error) {
+	return getConfigPath()
+}
+
+// LoadConfig reads the stored credentials as they are on disk, without
+// refreshing an expired OAuth token.
+func LoadConfig() (*AuthConfig, error) {
+	return loadAuth()
+}
+
 // saveAuth saves authentication credentials
 func saveAuth(config AuthConfig) error {
 	configPath, err := getConfigPath()
@@ -79,6 +125,15 @@ func GetAuthHeader() (string, error) {
 		return "", err
 	}
 
+	if config.AccessToken != "" {
+		if oauthTokenExpired(config, time.Now()) {
+			if err := refreshOAuthToken(config); err != nil {
+				return "", err
+			}
+		}
+		return "Bearer " + config.AccessToken, nil
+	}
+
 	if config.APIKey != "" {
 		return config.APIKey, nil
 	}
@@ -86,13 +141,14 @@ func GetAuthHeader() (string, error) {
 	return "", fmt.Errorf("no valid authentication found")
 }
 
-// Login handles the authentication flow
-func Login(plaintext, jsonOut bool) error {
+// Login handles the authentication flow and returns what was stored,
+// including the detected token type and write access.
+func Login(plaintext, jsonOut bool) (*AuthConfig, error) {
 	return loginWithAPIKey(plaintext, jsonOut)
 }
 
 // loginWithAPIKey handles Personal API Key authentication
-func loginWithAPIKey(plaintext, jsonOut bool) error {
+func loginWithAPIKey(plaintext, jsonOut bool) (*AuthConfig, error) {
 	if !plaintext && !jsonOut {
 		fmt.Println("\n" + color.New(color.FgYellow).Sprint("📝 Personal API Key Authentication"))
 		fmt.Println("Get your API key from: https://linear.app/settings/api")
@@ -106,28 +162,39 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 	reader := bufio.NewReader(os.Stdin)
 	apiKey, err := reader.ReadString('\n')
 	if err != nil {
-		return err
+		return nil, err
 	}
 	apiKey = strings.TrimSpace(apiKey)
 
 	if apiKey == "" {
-		return fmt.Errorf("API key cannot be empty")
+		return nil, fmt.Errorf("API key cannot be empty")
 	}
 
 	// Test the API key
 	client := api.NewClient(apiKey)
 	user, err := client.GetViewer(context.Background())
 	if err != nil {
-		return fmt.Errorf("invalid API key: %v", err)
+		return nil, fmt.Errorf("invalid API key: %v", err)
+	}
+
+	// Check whether the key can write, so a read-only key is reported now
+	// rather than on the first failed mutation.
+	var writeAccess *bool
+	if writable, err := client.ProbeWriteAccess(context.Background()); err == nil {
+		writeAccess = &writable
 	}
 
 	// Save the API key
 	config := AuthConfig{
-		APIKey: apiKey,
+		APIKey:      apiKey,
+		TokenType:   TokenType(apiKey),
+		WriteAccess: writeAccess,
+		Note:        accessNote(writeAccess),
+		CheckedAt:   time.Now().UTC().Format(time.RFC3339),
 	}
 	err = saveAuth(config)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if !plaintext && !jsonOut {
@@ -137,7 +204,7 @@ func loginWithAPIKey(plaintext, jsonOut bool) error {
 			color.New(color.FgCyan).Sprint(user.Email))
 	}
 
-	return nil
+	return &config, nil
 }
 
 // GetCurrentUser returns the current authenticated user
@@ -0,0 +1,272 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+// Linear's OAuth endpoints and API client; tests point them at stub servers.
+var (
+	oauthAuthorizeURL = "https://linear.app/oauth/authorize"
+	oauthTokenURL     = "https://api.linear.app/oauth/token"
+	newAPIClient      = api.NewClient
+)
+
+const (
+	// DefaultOAuthScopes is requested when --scopes is not given.
+	DefaultOAuthScopes = "read,write"
+	// DefaultOAuthPort is the local callback port. The OAuth application's
+	// redirect URI must be http://localhost:<port>/callback.
+	DefaultOAuthPort = 8484
+)
+
+// OAuthOptions configures LoginWithOAuth.
+type OAuthOptions struct {
+	ClientID     string
+	ClientSecret string // optional: the flow uses PKCE
+	Scopes       string // comma-separated, e.g. read,write
+	Port         int    // local callback port; 0 picks a free one
+	Timeout      time.Duration
+	// OpenBrowser opens the authorization URL; failures are ignored since
+	// the URL is printed as well.
+	OpenBrowser func(url string) error
+}
+
+// oauthToken is the token endpoint's response.
+type oauthToken struct {
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int    `json:"expires_in"`
+	Scope        string `json:"scope"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// LoginWithOAuth runs Linear's authorization-code flow with PKCE: it serves
+// a callback on localhost, sends the user to Linear to approve access,
+// exchanges the returned code for a token and stores it.
+func LoginWithOAuth(ctx context.Context, opts OAuthOptions) (*AuthConfig, error) {
+	if opts.ClientID == "" {
+		return nil, fmt.Errorf("--client-id is required for OAuth login")
+	}
+	if opts.Scopes == "" {
+		opts.Scopes = DefaultOAuthScopes
+	}
+	if opts.Timeout == 0 {
+		opts.Timeout = 5 * time.Minute
+	}
+
+	listener, err := net.Listen("tcp", fmt.Sprintf("127.0.0.1:%d", opts.Port))
+	if err != nil {
+		return nil, fmt.Errorf("cannot listen for the OAuth callback on port %d: %w", opts.Port, err)
+	}
+	redirectURI := fmt.Sprintf("http://localhost:%d/callback", listener.Addr().(*net.TCPAddr).Port)
+
+	state, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	verifier, err := randomToken()
+	if err != nil {
+		return nil, err
+	}
+	challenge := sha256.Sum256([]byte(verifier))
+
+	authURL := oauthAuthorizeURL + "?" + url.Values{
+		"client_id":             {opts.ClientID},
+		"redirect_uri":          {redirectURI},
+		"response_type":         {"code"},
+		"scope":                 {opts.Scopes},
+		"state":                 {state},
+		"code_challenge":        {base64.RawURLEncoding.EncodeToString(challenge[:])},
+		"code_challenge_method": {"S256"},
+		"prompt":                {"consent"},
+	}.Encode()
+
+	type callbackResult struct {
+		code string
+		err  error
+	}
+	results := make(chan callbackResult, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/callback", func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		var res callbackResult
+		switch {
+		case q.Get("state") != state:
+			res.err = fmt.Errorf("OAuth callback state mismatch; try logging in again")
+		case q.Get("error") != "":
+			res.err = fmt.Errorf("authorization denied: %s", strings.TrimSpace(q.Get("error")+" "+q.Get("error_description")))
+		case q.Get("code") == "":
+			res.err = fmt.Errorf("OAuth callback did not include a code")
+		default:
+			res.code = q.Get("code")
+		}
+		if res.err != nil {
+			http.Error(w, "linctl: "+res.err.Error(), http.StatusBadRequest)
+		} else {
+			fmt.Fprintln(w, "linctl: authorization complete. You can close this tab.")
+		}
+		select {
+		case results <- res:
+		default:
+		}
+	})
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() { _ = server.Serve(listener) }()
+	defer func() { _ = server.Close() }()
+
+	// Prompts go to stderr so --json output on stdout stays parseable.
+	fmt.Fprintf(os.Stderr, "Open this URL to authorize linctl (redirect URI %s):\n%s\n", redirectURI, authURL)
+	if opts.OpenBrowser != nil {
+		_ = opts.OpenBrowser(authURL)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, opts.Timeout)
+	defer cancel()
+	var code string
+	select {
+	case res := <-results:
+		if res.err != nil {
+			return nil, res.err
+		}
+		code = res.code
+	case <-ctx.Done():
+		return nil, fmt.Errorf("timed out after %s waiting for authorization", opts.Timeout)
+	}
+
+	token, err := requestToken(ctx, url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {code},
+		"redirect_uri":  {redirectURI},
+		"client_id":     {opts.ClientID},
+		"client_secret": {opts.ClientSecret},
+		"code_verifier": {verifier},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := newAPIClient("Bearer " + token.AccessToken).GetViewer(ctx); err != nil {
+		return nil, fmt.Errorf("token was issued but could not be used: %v", err)
+	}
+
+	config := AuthConfig{
+		OAuthClientID:     opts.ClientID,
+		OAuthClientSecret: opts.ClientSecret,
+		CheckedAt:         time.Now().UTC().Format(time.RFC3339),
+	}
+	applyToken(&config, token)
+	if err := saveAuth(config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// applyToken copies a token response into config, keeping the previous
+// refresh token when the server does not rotate it.
+func applyToken(config *AuthConfig, token *oauthToken) {
+	config.AccessToken = token.AccessToken
+	if token.RefreshToken != "" {
+		config.RefreshToken = token.RefreshToken
+	}
+	config.ExpiresAt = ""
+	if token.ExpiresIn > 0 {
+		config.ExpiresAt = time.Now().Add(time.Duration(token.ExpiresIn) * time.Second).UTC().Format(time.RFC3339)
+	}
+	if token.Scope != "" {
+		config.Scopes = token.Scope
+	}
+	config.TokenType = "oauth"
+	writable := false
+	for _, scope := range strings.FieldsFunc(config.Scopes, func(r rune) bool { return r == ',' || r == ' ' }) {
+		if scope == "write" || scope == "admin" {
+			writable = true
+		}
+	}
+	config.WriteAccess = &writable
+	config.Note = accessNote(config.WriteAccess)
+}
+
+// refreshOAuthToken renews an expired access token and saves the result.
+func refreshOAuthToken(config *AuthConfig) error {
+	if config.RefreshToken == "" {
+		return fmt.Errorf("OAuth token expired; run 'linctl auth login --oauth' again")
+	}
+	token, err := requestToken(context.Background(), url.Values{
+		"grant_type":    {"refresh_token"},
+		"refresh_token": {config.RefreshToken},
+		"client_id":     {config.OAuthClientID},
+		"client_secret": {config.OAuthClientSecret},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to refresh OAuth token (run 'linctl auth login --oauth' again): %w", err)
+	}
+	applyToken(config, token)
+	return saveAuth(*config)
+}
+
+// oauthTokenExpired reports whether the stored token is expired or about to.
+func oauthTokenExpired(config *AuthConfig, now time.Time) bool {
+	if config.ExpiresAt == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, config.ExpiresAt)
+	return err == nil && now.After(expiresAt.Add(-time.Minute))
+}
+
+func requestToken(ctx context.Context, form url.Values) (*oauthToken, error) {
+	for key, values := range form {
+		if len(values) == 1 && values[0] == "" {
+			form.Del(key)
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, oauthTokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := (&http.Client{Timeout: 30 * time.Second, Transport: api.NewTransport()}).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("token request failed: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read token response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("token request failed with status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	var token oauthToken
+	if err := json.Unmarshal(body, &token); err != nil {
+		return nil, fmt.Errorf("failed to parse token response: %w", err)
+	}
+	if token.AccessToken == "" {
+		return nil, errors.New("token response did not include an access token")
+	}
+	return &token, nil
+}
+
+// randomToken returns 32 random bytes, base64url-encoded; long enough for
+// both the state parameter and a PKCE code verifier.
+func randomToken() (string, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
@@ -0,0 +1,111 @@
+package auth
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/raegislabs/linctl/pkg/api"
+)
+
+// withOAuthStubs points the token endpoint and API client at stub servers
+// and isolates the auth file in a temporary home directory.
+func withOAuthStubs(t *testing.T, token http.HandlerFunc) {
+	t.Helper()
+	t.Setenv("HOME", t.TempDir())
+	tokenSrv := httptest.NewServer(token)
+	apiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer access-1" && r.Header.Get("Authorization") != "Bearer access-2" {
+			t.Errorf("unexpected Authorization header %q", r.Header.Get("Authorization"))
+		}
+		_, _ = w.Write([]byte(`{"data":{"viewer":{"id":"u1","name":"Ann"}}}`))
+	}))
+	origToken, origClient := oauthTokenURL, newAPIClient
+	oauthTokenURL = tokenSrv.URL
+	newAPIClient = func(authHeader string) *api.Client { return api.NewClientWithURL(apiSrv.URL, authHeader) }
+	t.Cleanup(func() {
+		oauthTokenURL, newAPIClient = origToken, origClient
+		tokenSrv.Close()
+		apiSrv.Close()
+	})
+}
+
+func TestLoginWithOAuth_ExchangesCodeWithPKCE(t *testing.T) {
+	var challenge string
+	withOAuthStubs(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		sum := sha256.Sum256([]byte(r.PostForm.Get("code_verifier")))
+		if r.PostForm.Get("code") != "code-1" || base64.RawURLEncoding.EncodeToString(sum[:]) != challenge {
+			http.Error(w, `{"error":"invalid_grant"}`, http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-1", "token_type": "Bearer", "expires_in": 3600,
+			"scope": "read,write", "refresh_token": "refresh-1",
+		})
+	})
+
+	// The "browser" approves immediately by following the redirect.
+	browser := func(authURL string) error {
+		u, err := url.Parse(authURL)
+		if err != nil {
+			return err
+		}
+		q := u.Query()
+		challenge = q.Get("code_challenge")
+		callback := q.Get("redirect_uri") + "?" + url.Values{"code": {"code-1"}, "state": {q.Get("state")}}.Encode()
+		go func() {
+			if resp, err := http.Get(callback); err == nil {
+				_ = resp.Body.Close()
+			}
+		}()
+		return nil
+	}
+
+	config, err := LoginWithOAuth(context.Background(), OAuthOptions{ClientID: "client-1", Timeout: 5 * time.Second, OpenBrowser: browser})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.TokenType != "oauth" || config.WriteAccess == nil || !*config.WriteAccess {
+		t.Fatalf("unexpected token details: %+v", config)
+	}
+	header, err := GetAuthHeader()
+	if err != nil || header != "Bearer access-1" {
+		t.Fatalf("GetAuthHeader = %q, %v", header, err)
+	}
+}
+
+func TestGetAuthHeader_RefreshesExpiredOAuthToken(t *testing.T) {
+	withOAuthStubs(t, func(w http.ResponseWriter, r *http.Request) {
+		_ = r.ParseForm()
+		if r.PostForm.Get("grant_type") != "refresh_token" || r.PostForm.Get("refresh_token") != "refresh-1" {
+			http.Error(w, "bad refresh", http.StatusBadRequest)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "access-2", "expires_in": 3600})
+	})
+	if err := saveAuth(AuthConfig{
+		AccessToken: "access-1", RefreshToken: "refresh-1", OAuthClientID: "client-1",
+		ExpiresAt: time.Now().Add(-time.Hour).UTC().Format(time.RFC3339),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	header, err := GetAuthHeader()
+	if err != nil || header != "Bearer access-2" {
+		t.Fatalf("GetAuthHeader = %q, %v", header, err)
+	}
+	stored, err := loadAuth()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.AccessToken != "access-2" || stored.RefreshToken != "refresh-1" || oauthTokenExpired(stored, time.Now()) {
+		t.Fatalf("refreshed token not saved as expected: %+v", stored)
+	}
+}
@@ -0,0 +1,37 @@
+package output
+
+import (
+	"encoding/csv"
+	"os"
+
+	"github.com/fatih/color"
+)
+
+// csvMode makes Table render CSV instead of a rich or plaintext table.
+var csvMode bool
+
+// SetCSV enables or disables CSV table output. Enabling it also disables
+// ANSI colors so cells contain plain text.
+func SetCSV(enabled bool) {
+	csvMode = enabled
+	if enabled {
+		color.NoColor = true
+	}
+}
+
+// CSVMode reports whether CSV table output was requested.
+func CSVMode() bool {
+	return csvMode
+}
+
+// CSV writes data to stdout as CSV, header row first.
+func CSV(data TableData) {
+	w := csv.NewWriter(os.Stdout)
+	if len(data.Headers) > 0 {
+		_ = w.Write(data.Headers)
+	}
+	for _, row := range data.Rows {
+		_ = w.Write(row)
+	}
+	w.Flush()
+}
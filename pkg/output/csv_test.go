@@ -0,0 +1,34 @@
+package output
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestTableRendersCSVInCSVMode(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() {
+		SetCSV(false)
+		color.NoColor = origNoColor
+	})
+	SetCSV(true)
+
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	Table(TableData{
+		Headers: []string{"ID", "Title"},
+		Rows:    [][]string{{"ENG-1", "Fix a, b"}, {"ENG-2", `Say "hi"`}},
+	}, false, false)
+	_ = w.Close()
+	os.Stdout = stdout
+	out, _ := io.ReadAll(r)
+
+	want := "ID,Title\nENG-1,\"Fix a, b\"\nENG-2,\"Say \"\"hi\"\"\"\n"
+	if string(out) != want {
+		t.Fatalf("unexpected CSV output:\n%s\nwant:\n%s", out, want)
+	}
+}
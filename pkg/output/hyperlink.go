@@ -0,0 +1,39 @@
+package output
+
+import (
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Hyperlink wraps text in an OSC 8 escape sequence so terminals that support
+// it show text as a link to url. It returns text unchanged when url is empty.
+func Hyperlink(text, url string) string {
+	if url == "" {
+		return text
+	}
+	return "\x1b]8;;" + url + "\x1b\\" + text + "\x1b]8;;\x1b\\"
+}
+
+// TerminalSupportsHyperlinks guesses from the environment whether the
+// terminal renders OSC 8 links. Unknown terminals are assumed not to, since
+// some print the escape sequence as garbage.
+func TerminalSupportsHyperlinks() bool {
+	if os.Getenv("TERM") == "dumb" {
+		return false
+	}
+	switch os.Getenv("TERM_PROGRAM") {
+	case "iTerm.app", "WezTerm", "vscode", "ghostty", "Hyper", "Tabby", "rio":
+		return true
+	}
+	for _, env := range []string{"WT_SESSION", "KITTY_WINDOW_ID", "KONSOLE_VERSION", "WEZTERM_EXECUTABLE"} {
+		if os.Getenv(env) != "" {
+			return true
+		}
+	}
+	if v, err := strconv.Atoi(os.Getenv("VTE_VERSION")); err == nil && v >= 5000 {
+		return true
+	}
+	term := os.Getenv("TERM")
+	return strings.HasPrefix(term, "xterm-kitty") || strings.HasPrefix(term, "alacritty") || strings.HasPrefix(term, "foot")
+}
@@ -0,0 +1,30 @@
+package output
+
+import "testing"
+
+func TestHyperlink(t *testing.T) {
+	if got, want := Hyperlink("ENG-1", "https://linear.app/acme/issue/ENG-1"), "\x1b]8;;https://linear.app/acme/issue/ENG-1\x1b\\ENG-1\x1b]8;;\x1b\\"; got != want {
+		t.Fatalf("Hyperlink = %q, want %q", got, want)
+	}
+	if got := Hyperlink("ENG-1", ""); got != "ENG-1" {
+		t.Fatalf("expected plain text without a URL, got %q", got)
+	}
+}
+
+func TestTerminalSupportsHyperlinks(t *testing.T) {
+	for _, env := range []string{"TERM_PROGRAM", "WT_SESSION", "KITTY_WINDOW_ID", "KONSOLE_VERSION", "WEZTERM_EXECUTABLE", "VTE_VERSION"} {
+		t.Setenv(env, "")
+	}
+	t.Setenv("TERM", "xterm-256color")
+	if TerminalSupportsHyperlinks() {
+		t.Fatal("a plain xterm should not be assumed to support hyperlinks")
+	}
+	t.Setenv("TERM_PROGRAM", "iTerm.app")
+	if !TerminalSupportsHyperlinks() {
+		t.Fatal("iTerm supports hyperlinks")
+	}
+	t.Setenv("TERM", "dumb")
+	if TerminalSupportsHyperlinks() {
+		t.Fatal("TERM=dumb never gets hyperlinks")
+	}
+}
@@ -0,0 +1,163 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+)
+
+// markdownMode makes Table render Markdown instead of a rich or plaintext table.
+var markdownMode bool
+
+// SetMarkdown enables or disables Markdown table output. Markdown output is
+// never colored, so enabling it also disables ANSI colors.
+func SetMarkdown(enabled bool) {
+	markdownMode = enabled
+	if enabled {
+		color.NoColor = true
+	}
+}
+
+// MarkdownMode reports whether Markdown table output was requested.
+func MarkdownMode() bool {
+	return markdownMode
+}
+
+// Markdown renders data as a GitHub-flavored Markdown table with aligned
+// columns, suitable for pasting into PRs, docs and comments.
+func Markdown(data TableData) {
+	fmt.Print(MarkdownString(data))
+}
+
+// MarkdownString returns data formatted as a GitHub-flavored Markdown table.
+// Pipes are escaped and newlines collapsed so each row stays on one line.
+func MarkdownString(data TableData) string {
+	cols := len(data.Headers)
+	for _, row := range data.Rows {
+		if len(row) > cols {
+			cols = len(row)
+		}
+	}
+	if cols == 0 {
+		return ""
+	}
+
+	cell := func(row []string, i int) string {
+		if i < len(row) {
+			return EscapeMarkdownCell(row[i])
+		}
+		return ""
+	}
+
+	widths := make([]int, cols)
+	for i := range widths {
+		widths[i] = 3 // minimum for the "---" separator
+		if w := utf8.RuneCountInString(cell(data.Headers, i)); w > widths[i] {
+			widths[i] = w
+		}
+		for _, row := range data.Rows {
+			if w := utf8.RuneCountInString(cell(row, i)); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+
+	var b strings.Builder
+	writeRow := func(row []string) {
+		b.WriteString("|")
+		for i := 0; i < cols; i++ {
+			c := cell(row, i)
+			b.WriteString(" ")
+			b.WriteString(c)
+			b.WriteString(strings.Repeat(" ", widths[i]-utf8.RuneCountInString(c)))
+			b.WriteString(" |")
+		}
+		b.WriteString("\n")
+	}
+
+	writeRow(data.Headers)
+	b.WriteString("|")
+	for _, w := range widths {
+		b.WriteString(" ")
+		b.WriteString(strings.Repeat("-", w))
+		b.WriteString(" |")
+	}
+	b.WriteString("\n")
+	for _, row := range data.Rows {
+		writeRow(row)
+	}
+	return b.String()
+}
+
+// EscapeMarkdownCell makes s safe to place in a Markdown table cell.
+func EscapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "\r\n", " ")
+	s = strings.ReplaceAll(s, "\n", " ")
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.TrimSpace(s)
+}
+
+// EscapeMarkdownInline makes user text such as a title safe to embed in a
+// Markdown heading or list item: line breaks become spaces, and characters
+// that would start emphasis, code, links, HTML or a new block are escaped.
+// Underscores inside words (snake_case) are left alone.
+func EscapeMarkdownInline(s string) string {
+	s = strings.Join(strings.Fields(s), " ")
+	runes := []rune(s)
+	var b strings.Builder
+	for i, r := range runes {
+		switch r {
+		case '\\', '`', '*', '[', ']', '<', '>', '|':
+			b.WriteRune('\\')
+		case '_':
+			if i == 0 || i == len(runes)-1 || !isWordRune(runes[i-1]) || !isWordRune(runes[i+1]) {
+				b.WriteRune('\\')
+			}
+		case '#', '-', '+':
+			// Only at the start, where they would open a heading or list
+			if i == 0 {
+				b.WriteRune('\\')
+			}
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r >= '0' && r <= '9' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r > utf8.RuneSelf
+}
+
+// NestMarkdown prepares a user-written Markdown body for embedding below a
+// heading of the given level: the body's own ATX headings are pushed down
+// by that many levels (at most 6) so they cannot end the enclosing section.
+// Fenced code blocks are left untouched.
+func NestMarkdown(body string, level int) string {
+	lines := strings.Split(body, "\n")
+	fence := ""
+	for i, line := range lines {
+		trimmed := strings.TrimLeft(line, " ")
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+		if len(line)-len(trimmed) > 3 {
+			continue // indented code
+		}
+		hashes := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		if hashes == 0 || hashes > 6 || (len(trimmed) > hashes && trimmed[hashes] != ' ' && trimmed[hashes] != '\t') {
+			continue
+		}
+		lines[i] = strings.Repeat("#", min(hashes+level, 6)) + trimmed[hashes:]
+	}
+	return strings.Join(lines, "\n")
+}
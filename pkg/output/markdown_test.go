@@ -0,0 +1,81 @@
+package output
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestMarkdownString(t *testing.T) {
+	got := MarkdownString(TableData{
+		Headers: []string{"ID", "Title"},
+		Rows: [][]string{
+			{"ENG-1", "Fix a|b parsing"},
+			{"ENG-12", "Multi\nline"},
+		},
+	})
+	want := "| ID     | Title            |\n" +
+		"| ------ | ---------------- |\n" +
+		"| ENG-1  | Fix a\\|b parsing |\n" +
+		"| ENG-12 | Multi line       |\n"
+	if got != want {
+		t.Fatalf("unexpected markdown table:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestMarkdownStringEmpty(t *testing.T) {
+	if got := MarkdownString(TableData{}); got != "" {
+		t.Fatalf("expected empty output for empty table, got %q", got)
+	}
+}
+
+func TestTableRendersMarkdownInMarkdownMode(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() {
+		SetMarkdown(false)
+		color.NoColor = origNoColor
+	})
+	SetMarkdown(true)
+
+	r, w, _ := os.Pipe()
+	stdout := os.Stdout
+	os.Stdout = w
+	Table(TableData{Headers: []string{"Name"}, Rows: [][]string{{"Alpha"}}}, true, false)
+	_ = w.Close()
+	os.Stdout = stdout
+	out, _ := io.ReadAll(r)
+
+	want := "| Name  |\n| ----- |\n| Alpha |\n"
+	if string(out) != want {
+		t.Fatalf("expected markdown table even with plaintext, got:\n%s", out)
+	}
+}
+
+func TestEscapeMarkdownInline(t *testing.T) {
+	cases := map[string]string{
+		"Fix login redirect":         "Fix login redirect",
+		"# Not a heading":            `\# Not a heading`,
+		"- not a list":               `\- not a list`,
+		"Use **bold** and `code`":    "Use \\*\\*bold\\*\\* and \\`code\\`",
+		"a | b":                      `a \| b`,
+		"[link](http://x) <b>":       `\[link\](http://x) \<b\>`,
+		"rename user_id to _id_":     `rename user_id to \_id\_`,
+		"PR #118 - fix\nsecond line": "PR #118 - fix second line",
+		`C:\temp`:                    `C:\\temp`,
+	}
+	for in, want := range cases {
+		if got := EscapeMarkdownInline(in); got != want {
+			t.Errorf("EscapeMarkdownInline(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestNestMarkdown(t *testing.T) {
+	body := "# Goals\nText with # inside\n#hashtag\n```\n# comment in code\n```\n###### Deep"
+	want := "### Goals\nText with # inside\n#hashtag\n```\n# comment in code\n```\n###### Deep"
+	if got := NestMarkdown(body, 2); got != want {
+		t.Fatalf("NestMarkdown:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -28,6 +28,7 @@ func JSON(data interface{}) {
 
 // Error outputs an error message
 func Error(message string, plaintext, jsonOut bool) {
+	stopActiveProgress()
 	if jsonOut {
 		JSON(map[string]interface{}{
 			"error": message,
@@ -35,7 +36,7 @@ func Error(message string, plaintext, jsonOut bool) {
 	} else if plaintext {
 		fmt.Fprintf(os.Stderr, "Error: %s\n", message)
 	} else {
-		fmt.Fprintf(os.Stderr, "%s %s\n", color.New(color.FgRed).Sprint("❌"), message)
+		fmt.Fprintf(os.Stderr, "%s %s\n", Color(RoleError).Sprint("❌"), message)
 	}
 }
 
@@ -49,10 +50,27 @@ func Success(message string, plaintext, jsonOut bool) {
 	} else if plaintext {
 		fmt.Println(message)
 	} else {
-		fmt.Printf("%s %s\n", color.New(color.FgGreen).Sprint("✅"), message)
+		fmt.Printf("%s %s\n", Color(RoleSuccess).Sprint("✅"), message)
 	}
 }
 
+// emptyCell is shown in table columns that have no value, so every column
+// reads the same way when scanning.
+var emptyCell = "-"
+
+// SetEmptyCell sets the placeholder used for empty table cells.
+func SetEmptyCell(placeholder string) {
+	emptyCell = placeholder
+}
+
+// Cell returns value, or the empty-cell placeholder when value is empty.
+func Cell(value string) string {
+	if value == "" {
+		return emptyCell
+	}
+	return value
+}
+
 // Table outputs data in table format
 func Table(data TableData, plaintext, jsonOut bool) {
 	if jsonOut {
@@ -71,6 +89,16 @@ func Table(data TableData, plaintext, jsonOut bool) {
 		return
 	}
 
+	if markdownMode {
+		Markdown(data)
+		return
+	}
+
+	if csvMode {
+		CSV(data)
+		return
+	}
+
 	if plaintext {
 		// Simple plaintext output
 		if len(data.Headers) > 0 {
@@ -100,7 +128,7 @@ func Table(data TableData, plaintext, jsonOut bool) {
 	// Add color to headers
 	coloredHeaders := make([]string, len(data.Headers))
 	for i, header := range data.Headers {
-		coloredHeaders[i] = color.New(color.FgCyan, color.Bold).Sprint(header)
+		coloredHeaders[i] = Color(RoleTableHeader).Sprint(header)
 	}
 	table.SetHeader(coloredHeaders)
 
@@ -122,3 +150,14 @@ func Info(message string, plaintext, jsonOut bool) {
 		fmt.Printf("%s %s\n", color.New(color.FgBlue).Sprint("ℹ️"), message)
 	}
 }
+
+// Summary prints a "✓ ..." footer after a listing. It goes to stderr so
+// redirecting stdout captures only the data.
+func Summary(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "\n%s %s\n", Color(RoleSuccess).Sprint("✓"), fmt.Sprintf(format, args...))
+}
+
+// Hint prints a follow-up suggestion, such as a pagination hint, to stderr.
+func Hint(message string) {
+	fmt.Fprintf(os.Stderr, "%s %s\n", Color(RoleWarning).Sprint("ℹ️"), message)
+}
@@ -0,0 +1,144 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+)
+
+// quiet suppresses progress indicators regardless of output mode.
+var quiet bool
+
+// SetQuiet enables or disables quiet mode for progress indicators.
+func SetQuiet(q bool) {
+	quiet = q
+}
+
+// progressWriter is where progress indicators are drawn. Overridable in tests.
+var progressWriter io.Writer = os.Stderr
+
+// progressIsTTY reports whether stderr is an interactive terminal. Overridable in tests.
+var progressIsTTY = func() bool {
+	fd := os.Stderr.Fd()
+	return isatty.IsTerminal(fd) || isatty.IsCygwinTerminal(fd)
+}
+
+// active is the currently running progress indicator, if any, so error
+// output can clear the spinner line before printing.
+var (
+	activeMu sync.Mutex
+	active   *Progress
+)
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Progress is a lightweight spinner that shows the current step of a slow,
+// multi-request operation on stderr. It is a no-op when stderr is not a
+// terminal or when plaintext, JSON or quiet output is requested, so callers
+// can use it unconditionally.
+type Progress struct {
+	enabled bool
+	mu      sync.Mutex
+	message string
+	done    chan struct{}
+	stopped chan struct{}
+	width   int
+}
+
+// NewProgress returns a progress indicator for the given output mode.
+func NewProgress(plaintext, jsonOut bool) *Progress {
+	return &Progress{
+		enabled: !plaintext && !jsonOut && !quiet && progressIsTTY(),
+	}
+}
+
+// Step updates the message shown next to the spinner, starting the spinner
+// on first use.
+func (p *Progress) Step(format string, args ...interface{}) {
+	if p == nil || !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	p.message = fmt.Sprintf(format, args...)
+	if p.done == nil {
+		p.done = make(chan struct{})
+		p.stopped = make(chan struct{})
+		go p.run(p.done, p.stopped)
+		activeMu.Lock()
+		active = p
+		activeMu.Unlock()
+	}
+	p.mu.Unlock()
+}
+
+// Stop clears the spinner line. It is safe to call multiple times.
+func (p *Progress) Stop() {
+	if p == nil || !p.enabled {
+		return
+	}
+	p.mu.Lock()
+	done, stopped := p.done, p.stopped
+	p.done = nil
+	p.mu.Unlock()
+	if done == nil {
+		return
+	}
+	close(done)
+	<-stopped
+	activeMu.Lock()
+	if active == p {
+		active = nil
+	}
+	activeMu.Unlock()
+}
+
+// stopActiveProgress stops any running progress indicator.
+func stopActiveProgress() {
+	activeMu.Lock()
+	p := active
+	activeMu.Unlock()
+	p.Stop()
+}
+
+func (p *Progress) run(done, stopped chan struct{}) {
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+
+	frame := 0
+	for {
+		p.draw(frame)
+		frame = (frame + 1) % len(spinnerFrames)
+		select {
+		case <-done:
+			p.clear()
+			close(stopped)
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *Progress) draw(frame int) {
+	p.mu.Lock()
+	msg := p.message
+	p.mu.Unlock()
+	line := fmt.Sprintf("%s %s", color.New(color.FgCyan).Sprint(spinnerFrames[frame]), msg)
+	pad := ""
+	if n := utf8.RuneCountInString(msg) + 2; n < p.width {
+		pad = strings.Repeat(" ", p.width-n)
+	} else {
+		p.width = n
+	}
+	fmt.Fprintf(progressWriter, "\r%s%s", line, pad)
+}
+
+func (p *Progress) clear() {
+	fmt.Fprintf(progressWriter, "\r%s\r", strings.Repeat(" ", p.width))
+}
@@ -0,0 +1,79 @@
+package output
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func withProgressTTY(t *testing.T, tty bool) *bytes.Buffer {
+	t.Helper()
+	var buf bytes.Buffer
+	origWriter, origTTY, origQuiet := progressWriter, progressIsTTY, quiet
+	progressWriter = &buf
+	progressIsTTY = func() bool { return tty }
+	t.Cleanup(func() {
+		progressWriter, progressIsTTY, quiet = origWriter, origTTY, origQuiet
+	})
+	return &buf
+}
+
+func TestProgressSuppressed(t *testing.T) {
+	cases := []struct {
+		name      string
+		tty       bool
+		quiet     bool
+		plaintext bool
+		jsonOut   bool
+	}{
+		{name: "not a tty", tty: false},
+		{name: "quiet", tty: true, quiet: true},
+		{name: "plaintext", tty: true, plaintext: true},
+		{name: "json", tty: true, jsonOut: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			buf := withProgressTTY(t, tc.tty)
+			SetQuiet(tc.quiet)
+			p := NewProgress(tc.plaintext, tc.jsonOut)
+			p.Step("Resolving labels…")
+			p.Stop()
+			if buf.Len() != 0 {
+				t.Fatalf("expected no progress output, got %q", buf.String())
+			}
+		})
+	}
+}
+
+func TestProgressDrawsAndClears(t *testing.T) {
+	buf := withProgressTTY(t, true)
+	SetQuiet(false)
+	p := NewProgress(false, false)
+	p.Step("Fetching page %d…", 3)
+	p.Stop()
+	p.Stop() // idempotent
+
+	out := buf.String()
+	if !strings.Contains(out, "Fetching page 3…") {
+		t.Fatalf("expected step message in output, got %q", out)
+	}
+	if !strings.HasSuffix(out, "\r") {
+		t.Fatalf("expected spinner line to be cleared, got %q", out)
+	}
+}
+
+func TestProgressPadsByRuneCount(t *testing.T) {
+	buf := withProgressTTY(t, true)
+	p := NewProgress(false, false)
+	p.message = "Équipe…"
+	p.draw(0)
+	buf.Reset()
+
+	// As many runes as the last message, so no padding is needed; padding
+	// by bytes would add four spaces for "É" and "…"
+	p.message = "Teams 1"
+	p.draw(0)
+	if out := buf.String(); !strings.HasSuffix(out, "Teams 1") {
+		t.Fatalf("expected no padding, got %q", out)
+	}
+}
@@ -0,0 +1,105 @@
+package output
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+var (
+	mdHeading     = regexp.MustCompile(`^(#{1,6})\s+(.*?)\s*#*\s*$`)
+	mdBullet      = regexp.MustCompile(`^(\s*)[-*+]\s+(.*)$`)
+	mdTask        = regexp.MustCompile(`^\[([ xX])\]\s+(.*)$`)
+	mdOrdered     = regexp.MustCompile(`^(\s*)(\d+[.)])\s+(.*)$`)
+	mdQuote       = regexp.MustCompile(`^\s*>\s?(.*)$`)
+	mdRule        = regexp.MustCompile(`^\s*(?:(?:-\s*){3,}|(?:\*\s*){3,}|(?:_\s*){3,})$`)
+	mdInlineCode  = regexp.MustCompile("`([^`]+)`")
+	mdBold        = regexp.MustCompile(`\*\*([^*]+)\*\*|__([^_]+)__`)
+	mdItalic      = regexp.MustCompile(`(^|[^\w*])[*_]([^*_\s][^*_]*?)[*_]([^\w*]|$)`)
+	mdLink        = regexp.MustCompile(`\[([^\]]+)\]\(([^)\s]+)\)`)
+	mdPlaceholder = regexp.MustCompile("\x00(\\d+)\x00")
+)
+
+// RenderMarkdown renders Markdown for a terminal: headings, lists, task
+// lists, block quotes, rules and fenced code blocks, plus bold, italic,
+// inline code and links. It only styles what it recognizes and leaves
+// everything else as written, so unusual Markdown degrades to the raw text.
+func RenderMarkdown(src string) string {
+	lines := strings.Split(strings.TrimRight(src, "\n"), "\n")
+	out := make([]string, 0, len(lines))
+	code := Color(RoleAccent)
+	inFence := false
+
+	for _, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if inFence {
+			out = append(out, "    "+code.Sprint(line))
+			continue
+		}
+
+		switch {
+		case mdHeading.MatchString(line):
+			m := mdHeading.FindStringSubmatch(line)
+			out = append(out, Color(RoleHeading).Sprint(renderInline(m[2])))
+		case mdRule.MatchString(line):
+			out = append(out, Color(RoleMuted).Sprint(strings.Repeat("─", 40)))
+		case mdBullet.MatchString(line):
+			m := mdBullet.FindStringSubmatch(line)
+			marker, text := "•", m[2]
+			if t := mdTask.FindStringSubmatch(text); t != nil {
+				marker, text = "☐", t[2]
+				if t[1] != " " {
+					marker = "☑"
+				}
+			}
+			out = append(out, m[1]+marker+" "+renderInline(text))
+		case mdOrdered.MatchString(line):
+			m := mdOrdered.FindStringSubmatch(line)
+			out = append(out, m[1]+m[2]+" "+renderInline(m[3]))
+		case mdQuote.MatchString(line):
+			m := mdQuote.FindStringSubmatch(line)
+			out = append(out, Color(RoleMuted).Sprint("│ ")+color.New(color.Italic).Sprint(renderInline(m[1])))
+		default:
+			out = append(out, renderInline(line))
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// renderInline styles inline code, links, bold and italic text. Code spans
+// are set aside first so their contents are never styled.
+func renderInline(text string) string {
+	var spans []string
+	text = mdInlineCode.ReplaceAllStringFunc(text, func(s string) string {
+		spans = append(spans, Color(RoleAccent).Sprint(mdInlineCode.FindStringSubmatch(s)[1]))
+		return "\x00" + strconv.Itoa(len(spans)-1) + "\x00"
+	})
+	text = mdLink.ReplaceAllStringFunc(text, func(s string) string {
+		m := mdLink.FindStringSubmatch(s)
+		if m[1] == m[2] {
+			return Color(RoleLink).Sprint(m[2])
+		}
+		return m[1] + " (" + Color(RoleLink).Sprint(m[2]) + ")"
+	})
+	text = mdBold.ReplaceAllStringFunc(text, func(s string) string {
+		m := mdBold.FindStringSubmatch(s)
+		return color.New(color.Bold).Sprint(m[1] + m[2])
+	})
+	text = mdItalic.ReplaceAllStringFunc(text, func(s string) string {
+		m := mdItalic.FindStringSubmatch(s)
+		return m[1] + color.New(color.Italic).Sprint(m[2]) + m[3]
+	})
+	return mdPlaceholder.ReplaceAllStringFunc(text, func(s string) string {
+		i, err := strconv.Atoi(strings.Trim(s, "\x00"))
+		if err != nil || i >= len(spans) {
+			return s
+		}
+		return spans[i]
+	})
+}
@@ -0,0 +1,53 @@
+package output
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestRenderMarkdown(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() { color.NoColor = origNoColor })
+	color.NoColor = true
+
+	src := strings.Join([]string{
+		"## Steps",
+		"1. Open **settings**",
+		"- [x] call `api_v2_call()` first",
+		"- see [docs](https://example.com/a_b)",
+		"> keep snake_case_names intact",
+		"```",
+		"**not bold** in code",
+		"```",
+		"---",
+	}, "\n")
+	want := strings.Join([]string{
+		"Steps",
+		"1. Open settings",
+		"☑ call api_v2_call() first",
+		"• see docs (https://example.com/a_b)",
+		"│ keep snake_case_names intact",
+		"    **not bold** in code",
+		strings.Repeat("─", 40),
+	}, "\n")
+	if got := RenderMarkdown(src); got != want {
+		t.Fatalf("RenderMarkdown:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRenderMarkdown_StylesInline(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() { color.NoColor = origNoColor })
+	color.NoColor = false
+
+	bold := color.New(color.Bold).Sprint("now")
+	if got := RenderMarkdown("fix it **now**"); got != "fix it "+bold {
+		t.Fatalf("expected bold styling, got %q", got)
+	}
+	code := Color(RoleAccent).Sprint("**x**")
+	if got := RenderMarkdown("run `**x**`"); got != "run "+code {
+		t.Fatalf("inline code should not be styled further, got %q", got)
+	}
+}
@@ -0,0 +1,187 @@
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/fatih/color"
+)
+
+// Role names what a piece of colored output means, so renderers ask for a
+// meaning and the active theme decides how it looks.
+type Role int
+
+const (
+	RoleSuccess     Role = iota // check marks, completed work
+	RoleError                   // failures, missing values
+	RoleWarning                 // due dates, unassigned-but-fine notices
+	RoleHeading                 // section headings in detail views
+	RoleIdentifier              // issue identifiers, team keys
+	RoleTitle                   // issue and project titles
+	RoleName                    // people and referenced identifiers
+	RoleMuted                   // IDs, timestamps, secondary details
+	RoleLink                    // URLs
+	RoleAccent                  // teams, cycles and labels
+	RoleProject                 // project names
+	RoleLabel                   // field labels in detail views
+	RoleTableHeader             // table column headers
+)
+
+// Theme maps roles and workflow state types to colors. Markers are prefixed
+// to state names so state stays readable without relying on hue.
+type Theme struct {
+	Name    string
+	Roles   map[Role][]color.Attribute
+	States  map[string][]color.Attribute
+	Markers map[string]string
+}
+
+// Themes lists the built-in themes by name.
+var Themes = map[string]Theme{
+	"default": {
+		Name: "default",
+		Roles: map[Role][]color.Attribute{
+			RoleSuccess:     {color.FgGreen},
+			RoleError:       {color.FgRed},
+			RoleWarning:     {color.FgYellow},
+			RoleHeading:     {color.FgYellow},
+			RoleIdentifier:  {color.FgCyan, color.Bold},
+			RoleTitle:       {color.FgWhite, color.Bold},
+			RoleName:        {color.FgCyan},
+			RoleMuted:       {color.FgWhite, color.Faint},
+			RoleLink:        {color.FgBlue, color.Underline},
+			RoleAccent:      {color.FgMagenta},
+			RoleProject:     {color.FgBlue},
+			RoleLabel:       {color.Bold},
+			RoleTableHeader: {color.FgCyan, color.Bold},
+		},
+		States: map[string][]color.Attribute{
+			"triage":    {color.FgMagenta},
+			"backlog":   {color.FgCyan},
+			"unstarted": {color.FgWhite},
+			"started":   {color.FgBlue},
+			"completed": {color.FgGreen},
+			"canceled":  {color.FgRed},
+			"":          {color.FgWhite},
+		},
+	},
+	// colorblind avoids red/green pairs: success is blue, failure stays red,
+	// and state names carry a marker in addition to their hue
+	"colorblind": {
+		Name: "colorblind",
+		Roles: map[Role][]color.Attribute{
+			RoleSuccess:     {color.FgHiBlue, color.Bold},
+			RoleError:       {color.FgRed, color.Bold},
+			RoleWarning:     {color.FgYellow},
+			RoleHeading:     {color.FgYellow, color.Bold},
+			RoleIdentifier:  {color.FgCyan, color.Bold},
+			RoleTitle:       {color.FgWhite, color.Bold},
+			RoleName:        {color.FgCyan},
+			RoleMuted:       {color.FgWhite, color.Faint},
+			RoleLink:        {color.FgHiBlue, color.Underline},
+			RoleAccent:      {color.FgMagenta},
+			RoleProject:     {color.FgHiBlue},
+			RoleLabel:       {color.Bold},
+			RoleTableHeader: {color.FgCyan, color.Bold},
+		},
+		States: map[string][]color.Attribute{
+			"triage":    {color.FgMagenta},
+			"backlog":   {color.FgWhite, color.Faint},
+			"unstarted": {color.FgWhite},
+			"started":   {color.FgYellow},
+			"completed": {color.FgHiBlue},
+			"canceled":  {color.FgRed},
+			"":          {color.FgWhite},
+		},
+		Markers: stateMarkers,
+	},
+	// mono uses only bold, faint and underline, plus state markers
+	"mono": {
+		Name: "mono",
+		Roles: map[Role][]color.Attribute{
+			RoleSuccess:     {color.Bold},
+			RoleError:       {color.Bold},
+			RoleWarning:     {color.Bold},
+			RoleHeading:     {color.Bold, color.Underline},
+			RoleIdentifier:  {color.Bold},
+			RoleTitle:       {color.Bold},
+			RoleMuted:       {color.Faint},
+			RoleLink:        {color.Underline},
+			RoleLabel:       {color.Bold},
+			RoleTableHeader: {color.Bold},
+		},
+		States: map[string][]color.Attribute{
+			"backlog":   {color.Faint},
+			"completed": {color.Bold},
+			"canceled":  {color.Faint},
+		},
+		Markers: stateMarkers,
+	},
+}
+
+var stateMarkers = map[string]string{
+	"triage":    "◇",
+	"backlog":   "◌",
+	"unstarted": "○",
+	"started":   "◐",
+	"completed": "✓",
+	"canceled":  "✗",
+}
+
+var activeTheme = Themes["default"]
+
+// SetTheme selects the theme used by Color, StateColor and StateLabel. An
+// empty name selects the default theme.
+func SetTheme(name string) error {
+	if name == "" {
+		name = "default"
+	}
+	theme, ok := Themes[strings.ToLower(name)]
+	if !ok {
+		return fmt.Errorf("unknown theme '%s' (use %s)", name, strings.Join(ThemeNames(), ", "))
+	}
+	activeTheme = theme
+	return nil
+}
+
+// ThemeName returns the name of the active theme.
+func ThemeName() string {
+	return activeTheme.Name
+}
+
+// ThemeNames returns the built-in theme names, default first.
+func ThemeNames() []string {
+	return []string{"default", "colorblind", "mono"}
+}
+
+// Color returns the active theme's color for role. Roles a theme leaves out
+// are printed without styling.
+func Color(role Role) *color.Color {
+	return newColor(activeTheme.Roles[role])
+}
+
+// StateColor returns the active theme's color for a workflow state type.
+// Unknown types use the theme's "" entry.
+func StateColor(stateType string) *color.Color {
+	attrs, ok := activeTheme.States[stateType]
+	if !ok {
+		attrs = activeTheme.States[""]
+	}
+	return newColor(attrs)
+}
+
+// StateLabel colors a state name for its type, prefixed with the theme's
+// marker when it has one.
+func StateLabel(stateType, name string) string {
+	if marker := activeTheme.Markers[stateType]; marker != "" {
+		name = marker + " " + name
+	}
+	return StateColor(stateType).Sprint(name)
+}
+
+func newColor(attrs []color.Attribute) *color.Color {
+	if len(attrs) == 0 {
+		return color.New(color.Reset)
+	}
+	return color.New(attrs...)
+}
@@ -0,0 +1,54 @@
+package output
+
+import (
+	"testing"
+
+	"github.com/fatih/color"
+)
+
+func TestThemes(t *testing.T) {
+	origNoColor := color.NoColor
+	t.Cleanup(func() {
+		_ = SetTheme("default")
+		color.NoColor = origNoColor
+	})
+	color.NoColor = false
+
+	if err := SetTheme(""); err != nil || ThemeName() != "default" {
+		t.Fatalf("empty theme should select default, got %q (%v)", ThemeName(), err)
+	}
+	if got, want := Color(RoleSuccess).Sprint("ok"), color.New(color.FgGreen).Sprint("ok"); got != want {
+		t.Fatalf("default success color changed: %q, want %q", got, want)
+	}
+	if got, want := StateLabel("started", "In Progress"), color.New(color.FgBlue).Sprint("In Progress"); got != want {
+		t.Fatalf("default state label should have no marker: %q, want %q", got, want)
+	}
+
+	if err := SetTheme("Colorblind"); err != nil {
+		t.Fatalf("SetTheme(Colorblind): %v", err)
+	}
+	if Color(RoleSuccess).Sprint("ok") == color.New(color.FgGreen).Sprint("ok") {
+		t.Fatal("colorblind theme should not use green for success")
+	}
+	if got, want := StateLabel("completed", "Done"), StateColor("completed").Sprint("✓ Done"); got != want {
+		t.Fatalf("colorblind state label: %q, want %q", got, want)
+	}
+
+	if err := SetTheme("mono"); err != nil {
+		t.Fatalf("SetTheme(mono): %v", err)
+	}
+	for _, attrs := range Themes["mono"].Roles {
+		for _, a := range attrs {
+			if a >= color.FgBlack && a <= color.FgHiWhite {
+				t.Fatalf("mono theme uses a foreground color: %v", attrs)
+			}
+		}
+	}
+
+	if err := SetTheme("neon"); err == nil {
+		t.Fatal("expected an error for an unknown theme")
+	}
+	if ThemeName() != "mono" {
+		t.Fatalf("failed SetTheme should keep the active theme, got %q", ThemeName())
+	}
+}
@@ -0,0 +1,71 @@
+package utils
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNoDesktop is returned when no browser or clipboard tool is available,
+// e.g. over SSH or in a container.
+var ErrNoDesktop = errors.New("no desktop environment available")
+
+// OpenURL opens url in the default browser.
+func OpenURL(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		if !hasDisplay() {
+			return ErrNoDesktop
+		}
+		path, err := exec.LookPath("xdg-open")
+		if err != nil {
+			return ErrNoDesktop
+		}
+		cmd = exec.Command(path, url)
+	}
+	return cmd.Start()
+}
+
+// CopyToClipboard writes text to the system clipboard using pbcopy, clip,
+// wl-copy, xclip or xsel, whichever the platform provides.
+func CopyToClipboard(text string) error {
+	var candidates [][]string
+	switch runtime.GOOS {
+	case "darwin":
+		candidates = [][]string{{"pbcopy"}}
+	case "windows":
+		candidates = [][]string{{"clip"}}
+	default:
+		if os.Getenv("WAYLAND_DISPLAY") != "" {
+			candidates = append(candidates, []string{"wl-copy"})
+		}
+		if os.Getenv("DISPLAY") != "" {
+			candidates = append(candidates,
+				[]string{"xclip", "-selection", "clipboard"},
+				[]string{"xsel", "--clipboard", "--input"})
+		}
+	}
+
+	for _, c := range candidates {
+		path, err := exec.LookPath(c[0])
+		if err != nil {
+			continue
+		}
+		cmd := exec.Command(path, c[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		return cmd.Run()
+	}
+	return ErrNoDesktop
+}
+
+// hasDisplay reports whether an X11 or Wayland session is available.
+func hasDisplay() bool {
+	return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+}
@@ -7,10 +7,40 @@ import (
 	"time"
 )
 
+// TimeExpressionHelp describes every form ParseTimeExpression accepts. It is
+// shown by --help-time and appended to parse errors.
+const TimeExpressionHelp = `Supported time expressions (for --newer-than):
+  N_minutes_ago, N_hours_ago, N_days_ago,    relative to now, e.g. 30_minutes_ago,
+  N_weeks_ago, N_months_ago, N_years_ago     3_days_ago, 2_weeks_ago, 1_year_ago
+                                             (singular units work too: 1_week_ago)
+  YYYY-MM-DD                                 midnight UTC on that date, e.g. 2025-01-15
+  ISO-8601 timestamp                         e.g. 2025-01-15T10:30:00Z, 2025-01-15T10:30:00+02:00,
+                                             2025-01-15T10:30 (no zone means UTC)
+  all_time                                   no date filter
+The default is 6_months_ago.`
+
+// timeExpressionForms is the one-line summary used in parse errors.
+const timeExpressionForms = "use N_minutes_ago, N_hours_ago, N_days_ago, N_weeks_ago, N_months_ago, N_years_ago, YYYY-MM-DD, an ISO-8601 timestamp or all_time"
+
+// absoluteTimeLayouts are the absolute forms ParseTimeExpression accepts.
+// Layouts without a zone are interpreted as UTC.
+var absoluteTimeLayouts = []string{
+	"2006-01-02",
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+}
+
 // ParseTimeExpression converts time expressions like "3_weeks_ago" into ISO8601 datetime strings
+// Absolute dates (YYYY-MM-DD) and ISO-8601 timestamps are accepted too and
+// normalized to RFC3339 in UTC
 // Returns empty string for "all_time"
 // Default is "6_months_ago" if empty string is provided
 func ParseTimeExpression(expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+
 	// Handle empty input - use default
 	if expr == "" {
 		expr = "6_months_ago"
@@ -21,26 +51,23 @@ func ParseTimeExpression(expr string) (string, error) {
 		return "", nil
 	}
 
-	// Try to parse as a date first (YYYY-MM-DD)
-	if _, err := time.Parse("2006-01-02", expr); err == nil {
-		return expr + "T00:00:00Z", nil
-	}
-
-	// Try to parse as ISO8601
-	if _, err := time.Parse(time.RFC3339, expr); err == nil {
-		return expr, nil
+	// Absolute dates and timestamps
+	for _, layout := range absoluteTimeLayouts {
+		if t, err := time.Parse(layout, expr); err == nil {
+			return t.UTC().Format(time.RFC3339), nil
+		}
 	}
 
 	// Parse relative time expressions
 	parts := strings.Split(expr, "_")
 	if len(parts) < 3 || parts[len(parts)-1] != "ago" {
-		return "", fmt.Errorf("invalid time expression: %s (expected format like '3_weeks_ago' or 'all_time')", expr)
+		return "", fmt.Errorf("invalid time expression '%s' (%s)", expr, timeExpressionForms)
 	}
 
 	// Get the number
 	num, err := strconv.Atoi(parts[0])
-	if err != nil {
-		return "", fmt.Errorf("invalid number in time expression: %s", parts[0])
+	if err != nil || num < 0 {
+		return "", fmt.Errorf("invalid number '%s' in time expression '%s' (%s)", parts[0], expr, timeExpressionForms)
 	}
 
 	// Get the unit (handle both singular and plural)
@@ -64,9 +91,46 @@ func ParseTimeExpression(expr string) (string, error) {
 	case "year":
 		targetTime = now.AddDate(-num, 0, 0)
 	default:
-		return "", fmt.Errorf("invalid time unit: %s (valid units: minute, hour, day, week, month, year)", unit)
+		return "", fmt.Errorf("invalid time unit '%s' in time expression '%s' (%s)", unit, expr, timeExpressionForms)
 	}
 
 	// Return as ISO8601 string
-	return targetTime.Format(time.RFC3339), nil
+	return targetTime.UTC().Format(time.RFC3339), nil
+}
+
+// ParseDueDate validates a due date and normalizes it to YYYY-MM-DD.
+// Accepts YYYY-MM-DD or a full RFC3339 timestamp.
+func ParseDueDate(expr string) (string, error) {
+	expr = strings.TrimSpace(expr)
+	if t, err := time.Parse("2006-01-02", expr); err == nil {
+		return t.Format("2006-01-02"), nil
+	}
+	if t, err := time.Parse(time.RFC3339, expr); err == nil {
+		return t.Format("2006-01-02"), nil
+	}
+	return "", fmt.Errorf("invalid due date: %s (expected YYYY-MM-DD)", expr)
+}
+
+// FormatAge renders how long ago t was relative to now in a compact form such
+// as "45m", "3h", "3d", "2w", "5mo" or "1y". Future times are shown as "0m".
+func FormatAge(t, now time.Time) string {
+	d := now.Sub(t)
+	if d < 0 {
+		d = 0
+	}
+	days := int(d.Hours() / 24)
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	case days < 14:
+		return fmt.Sprintf("%dd", days)
+	case days < 60:
+		return fmt.Sprintf("%dw", days/7)
+	case days < 365:
+		return fmt.Sprintf("%dmo", days/30)
+	default:
+		return fmt.Sprintf("%dy", days/365)
+	}
 }
@@ -0,0 +1,117 @@
+package utils
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseDueDate(t *testing.T) {
+	cases := []struct {
+		in      string
+		want    string
+		wantErr bool
+	}{
+		{in: "2025-03-14", want: "2025-03-14"},
+		{in: " 2025-03-14 ", want: "2025-03-14"},
+		{in: "2025-03-14T10:00:00Z", want: "2025-03-14"},
+		{in: "14/03/2025", wantErr: true},
+		{in: "2025-02-30", wantErr: true},
+		{in: "", wantErr: true},
+	}
+	for _, tc := range cases {
+		got, err := ParseDueDate(tc.in)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("ParseDueDate(%q) = %q, want error", tc.in, got)
+			}
+			continue
+		}
+		if err != nil || got != tc.want {
+			t.Errorf("ParseDueDate(%q) = %q, %v; want %q", tc.in, got, err, tc.want)
+		}
+	}
+}
+
+func TestParseTimeExpression_Absolute(t *testing.T) {
+	cases := []struct {
+		in   string
+		want string
+	}{
+		{in: "2024-01-15", want: "2024-01-15T00:00:00Z"},
+		{in: " 2024-01-15 ", want: "2024-01-15T00:00:00Z"},
+		{in: "2024-01-15T10:30:00Z", want: "2024-01-15T10:30:00Z"},
+		{in: "2024-01-15T10:30:00+02:00", want: "2024-01-15T08:30:00Z"},
+		{in: "2024-01-15T10:30:00.123Z", want: "2024-01-15T10:30:00Z"},
+		{in: "2024-01-15T10:30:00", want: "2024-01-15T10:30:00Z"},
+		{in: "2024-01-15T10:30", want: "2024-01-15T10:30:00Z"},
+		{in: "2024-01-15 10:30:00", want: "2024-01-15T10:30:00Z"},
+		{in: "all_time", want: ""},
+	}
+	for _, tc := range cases {
+		got, err := ParseTimeExpression(tc.in)
+		if err != nil || got != tc.want {
+			t.Errorf("ParseTimeExpression(%q) = %q, %v; want %q", tc.in, got, err, tc.want)
+		}
+	}
+}
+
+func TestParseTimeExpression_Relative(t *testing.T) {
+	before := time.Now().UTC()
+	got, err := ParseTimeExpression("2_weeks_ago")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	ts, err := time.Parse(time.RFC3339, got)
+	if err != nil {
+		t.Fatalf("expected an RFC3339 timestamp, got %q", got)
+	}
+	if d := before.Sub(ts); d < 14*24*time.Hour-time.Minute || d > 14*24*time.Hour+time.Minute {
+		t.Fatalf("2_weeks_ago resolved to %s, %s before now", got, d)
+	}
+
+	if got, err := ParseTimeExpression(""); err != nil || got == "" {
+		t.Fatalf("expected the 6_months_ago default for empty input, got %q, %v", got, err)
+	}
+	for _, in := range []string{"1_day_ago", "3_months_ago", "1_year_ago", "90_minutes_ago", "12_hours_ago"} {
+		if _, err := ParseTimeExpression(in); err != nil {
+			t.Errorf("ParseTimeExpression(%q) returned %v", in, err)
+		}
+	}
+}
+
+func TestParseTimeExpression_Invalid(t *testing.T) {
+	for _, in := range []string{"yesterday", "2024-13-01", "15/01/2024", "three_days_ago", "-3_days_ago", "3_fortnights_ago", "3_days"} {
+		got, err := ParseTimeExpression(in)
+		if err == nil {
+			t.Errorf("ParseTimeExpression(%q) = %q, want error", in, got)
+			continue
+		}
+		for _, form := range []string{"N_days_ago", "N_weeks_ago", "YYYY-MM-DD", "all_time"} {
+			if !strings.Contains(err.Error(), form) {
+				t.Errorf("error for %q should list %s: %v", in, form, err)
+			}
+		}
+	}
+}
+
+func TestFormatAge(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+	cases := []struct {
+		ago  time.Duration
+		want string
+	}{
+		{ago: -time.Hour, want: "0m"},
+		{ago: 45 * time.Minute, want: "45m"},
+		{ago: 3 * time.Hour, want: "3h"},
+		{ago: 3 * 24 * time.Hour, want: "3d"},
+		{ago: 15 * 24 * time.Hour, want: "2w"},
+		{ago: 100 * 24 * time.Hour, want: "3mo"},
+		{ago: 400 * 24 * time.Hour, want: "1y"},
+	}
+	for _, tc := range cases {
+		if got := FormatAge(now.Add(-tc.ago), now); got != tc.want {
+			t.Errorf("FormatAge(%v ago) = %q, want %q", tc.ago, got, tc.want)
+		}
+	}
+}